@@ -6,6 +6,7 @@ import (
 	"math/rand"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"syscall"
@@ -18,6 +19,7 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/libs/ntptime"
 	"github.com/wavesplatform/gowaves/pkg/libs/runner"
 	"github.com/wavesplatform/gowaves/pkg/miner"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
 	scheduler2 "github.com/wavesplatform/gowaves/pkg/miner/scheduler"
 	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
 	"github.com/wavesplatform/gowaves/pkg/node"
@@ -25,10 +27,14 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/node/messages"
 	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/ng"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
+	"github.com/wavesplatform/gowaves/pkg/p2p/capture"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
 	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/util/common"
 	"github.com/wavesplatform/gowaves/pkg/wallet"
@@ -55,9 +61,22 @@ var (
 	walletPath        = flag.String("wallet-path", "", "Path to wallet, or ~/.waves by default")
 	walletPassword    = flag.String("wallet-password", "", "Pass password for wallet. Extremely insecure")
 	limitConnectionsS = flag.String("limit-connections", "30", "N incoming and outgoing connections")
+	limitPerIP        = flag.Int("limit-connections-per-ip", peer_manager.DefaultConnectionLimits.PerIP, "Max connections with the same IP address, 0 is unlimited")
+	limitPerSubnet    = flag.Int("limit-connections-per-subnet", peer_manager.DefaultConnectionLimits.PerSubnet, "Max connections with the same /24 IPv4 or /48 IPv6 subnet, 0 is unlimited")
 	minPeersMining    = flag.Int("min-peers-mining", 1, "Minimum connected peers for allow mining")
+	packingStrategy   = flag.String("packing-strategy", miner.FeeGreedyPacking, "Order of packing transactions into blocks: fee, fifo or sender-fair")
+	devMiningInterval = flag.Duration("dev-mining-interval", 0, "Interval of generating blocks in dev mining mode, enabled by 'dev_mining' in blockchain settings. If zero, blocks are generated only when transactions arrive or on API call")
+	signerAddress     = flag.String("signer", "", "Address of external signer: path to Unix socket prefixed with 'unix://' or HTTP URL. If empty, keys of wallet are used")
+	microDelay        = flag.Duration("micro-delay", microblock.DefaultSettings.MinDelay, "Delay between mined key block and the first microblock on top of it")
+	microInterval     = flag.Duration("micro-interval", microblock.DefaultSettings.Interval, "Interval between mined microblocks")
+	microMaxTxs       = flag.Int("micro-max-txs", microblock.DefaultSettings.MaxTransactions, "Max number of transactions in mined microblock, up to 255")
+	inboundLimit      = flag.Int("inbound-limit", 0, "Max number of bytes per second received from each peer, 0 is unlimited")
+	capturePath       = flag.String("capture", "", "Path to file to record all network messages of node for replay. Recording is disabled if empty")
+	persistUtx        = flag.Bool("persist-utx", false, "Save UTX pool to the state directory and restore it on start")
 )
 
+const utxSnapshotInterval = time.Minute
+
 func init() {
 	common.SetupLogger(*logLevel)
 }
@@ -91,12 +110,28 @@ func main() {
 		return
 	}
 
-	reward, err := miner.ParseReward(*reward)
+	minerReward, err := miner.ParseReward(*reward)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+
+	packing, err := miner.NewPackingStrategy(*packingStrategy)
 	if err != nil {
 		zap.S().Error(err)
 		return
 	}
 
+	microSettings := microblock.Settings{
+		MinDelay:        *microDelay,
+		Interval:        *microInterval,
+		MaxTransactions: *microMaxTxs,
+	}
+	if err := microSettings.Validate(); err != nil {
+		zap.S().Error(err)
+		return
+	}
+
 	zap.S().Info("conf", conf)
 
 	err = conf.Validate()
@@ -113,6 +148,15 @@ func main() {
 			return
 		}
 	}
+	var sgn signer.Signer = signer.NewLocalSigner(wal, custom.AddressSchemeCharacter)
+	if *signerAddress != "" {
+		remote, err := signer.NewRemoteSigner(*signerAddress, custom.AddressSchemeCharacter)
+		if err != nil {
+			zap.S().Error(err)
+			return
+		}
+		sgn = remote
+	}
 
 	limitConnections, err := strconv.ParseUint(*limitConnectionsS, 10, 64)
 	if err != nil {
@@ -171,7 +215,13 @@ func main() {
 		return
 	}
 
-	features, err = miner.ValidateFeatures(state, features)
+	initialVotes := miner.InitialVotes{
+		Features:    features,
+		Reward:      minerReward,
+		FeaturesSet: *minerVoteFeatures != "",
+		RewardSet:   *reward != "",
+	}
+	voting, err := miner.NewVoting(state, initialVotes, filepath.Join(path, miner.VotesFileName))
 	if err != nil {
 		cancel()
 		zap.S().Error(err)
@@ -185,21 +235,63 @@ func main() {
 
 	parent := peer.NewParent()
 
-	peerSpawnerImpl := peer_manager.NewPeerSpawner(btsPool, parent, conf.WavesNetwork, declAddr, "gowaves", uint64(rand.Int()), version)
+	var (
+		capt     peer.Capture
+		recorder *capture.Recorder
+	)
+	if *capturePath != "" {
+		recorder, err = capture.NewRecorder(*capturePath)
+		if err != nil {
+			zap.S().Error(err)
+			cancel()
+			return
+		}
+		capt = recorder
+		zap.S().Infof("Recording network messages to '%s'", *capturePath)
+	}
+
+	peerSpawnerImpl := peer_manager.NewPeerSpawner(btsPool, parent, conf.WavesNetwork, declAddr, "gowaves", uint64(rand.Int()), version, capt, conn.Options{InboundLimit: *inboundLimit})
 
-	peerManager := peer_manager.NewPeerManager(peerSpawnerImpl, state, int(limitConnections))
+	peerStorage, err := peer_manager.NewJsonFileStorage(path)
+	if err != nil {
+		zap.S().Error(err)
+		cancel()
+		return
+	}
+
+	peerManager := peer_manager.NewPeerManager(peerSpawnerImpl, peerStorage, int(limitConnections))
+	peerManager.SetConnectionLimits(peer_manager.ConnectionLimits{PerIP: *limitPerIP, PerSubnet: *limitPerSubnet})
 	go peerManager.Run(ctx)
 
-	scheduler := scheduler2.NewScheduler(
-		state,
-		wal,
-		custom,
-		ntptm,
-		scheduler2.NewMinerConsensus(peerManager, *minPeersMining),
-		proto.NewTimestampFromUSeconds(outdateSeconds),
-	)
+	var scheduler *scheduler2.SchedulerImpl
+	if custom.DevMining {
+		scheduler, err = scheduler2.NewDevScheduler(state, sgn, custom, ntptm)
+		if err != nil {
+			cancel()
+			zap.S().Error(err)
+			return
+		}
+		zap.S().Warn("Dev mining is enabled, blocks are generated without PoS delays")
+	} else {
+		scheduler = scheduler2.NewScheduler(
+			state,
+			sgn,
+			custom,
+			ntptm,
+			scheduler2.NewMinerConsensus(peerManager, *minPeersMining),
+			proto.NewTimestampFromUSeconds(outdateSeconds),
+		)
+	}
 
-	utx := utxpool.New(10000, utxpool.NewValidator(state, ntptm), custom)
+	utxValidator := utxpool.NewValidator(state, ntptm)
+	utx := utxpool.New(10000, utxValidator, state, custom)
+	utxSnapshot := filepath.Join(path, utxpool.SnapshotFileName)
+	if *persistUtx {
+		// Snapshot is restored when node catches up with the network, transactions can't be validated before that.
+		go utx.RunSnapshots(ctx, utxSnapshot, utxSnapshotInterval, func() bool {
+			return !utxValidator.Outdated()
+		})
+	}
 
 	blockApplier := blocks_applier.NewBlocksApplier()
 
@@ -209,22 +301,31 @@ func main() {
 	InternalCh := messages.NewInternalChannel()
 
 	services := services.Services{
-		State:           state,
-		Peers:           peerManager,
-		Scheduler:       scheduler,
-		BlocksApplier:   blockApplier,
-		UtxPool:         utx,
-		Scheme:          custom.AddressSchemeCharacter,
-		InvRequester:    ng.NewInvRequester(),
-		LoggableRunner:  logRunner,
-		MicroBlockCache: microblock_cache.NewMicroblockCache(),
-		InternalChannel: InternalCh,
-		Time:            ntptm,
-	}
-
-	Miner := miner.NewMicroblockMiner(services, features, reward)
+		State:              state,
+		Peers:              peerManager,
+		Scheduler:          scheduler,
+		BlocksApplier:      blockApplier,
+		UtxPool:            utx,
+		PackingStrategy:    packing,
+		MicroblockSettings: microSettings,
+		MicroblockStats:    microblock.NewStats(),
+		Signer:             sgn,
+		Scheme:             custom.AddressSchemeCharacter,
+		InvRequester:       ng.NewInvRequester(),
+		LoggableRunner:     logRunner,
+		MicroBlockCache:    microblock_cache.NewMicroblockCache(),
+		InternalChannel:    InternalCh,
+		Time:               ntptm,
+		Voting:             voting,
+		FsmStatus:          status.NewTracker(status.DefaultHistorySize),
+	}
+
+	Miner := miner.NewMicroblockMiner(services)
 	go miner.Run(ctx, Miner, scheduler, InternalCh)
 	go scheduler.Reschedule()
+	if custom.DevMining {
+		go miner.RunDevMining(ctx, scheduler, utx, *devMiningInterval)
+	}
 
 	n := node.NewNode(services, declAddr, declAddr, proto.NewTimestampFromUSeconds(outdateSeconds))
 
@@ -255,7 +356,7 @@ func main() {
 	}()
 
 	if *enableGrpcApi {
-		grpcServer, err := server.NewServer(services)
+		grpcServer, err := server.NewServer(services, "integration-test-rest-api")
 		if err != nil {
 			zap.S().Errorf("Failed to create gRPC server: %v", err)
 		}
@@ -275,6 +376,16 @@ func main() {
 	zap.S().Infow("Caught signal, stopping", "signal", sig)
 	cancel()
 	n.Close()
+	if recorder != nil {
+		if err := recorder.Close(); err != nil {
+			zap.S().Errorf("Failed to close capture file: %v", err)
+		}
+	}
+	if *persistUtx && utx.SnapshotRestored() {
+		if err := utx.SaveSnapshot(utxSnapshot); err != nil {
+			zap.S().Errorf("Failed to save UTX snapshot: %v", err)
+		}
+	}
 	<-time.After(1 * time.Second)
 }
 
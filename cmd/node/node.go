@@ -2,6 +2,7 @@ package main
 
 import (
 	"context"
+	"expvar"
 	"flag"
 	"math/rand"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	_ "net/http/pprof"
 	"os"
 	"os/signal"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"syscall"
@@ -21,16 +23,21 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/libs/ntptime"
 	"github.com/wavesplatform/gowaves/pkg/libs/runner"
 	"github.com/wavesplatform/gowaves/pkg/miner"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
 	"github.com/wavesplatform/gowaves/pkg/miner/scheduler"
 	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
 	"github.com/wavesplatform/gowaves/pkg/node"
 	"github.com/wavesplatform/gowaves/pkg/node/blocks_applier"
 	"github.com/wavesplatform/gowaves/pkg/node/messages"
 	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
+	"github.com/wavesplatform/gowaves/pkg/p2p/capture"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
 	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/util/common"
 	"github.com/wavesplatform/gowaves/pkg/wallet"
@@ -60,10 +67,22 @@ var (
 	walletPath                 = flag.String("wallet-path", "", "Path to wallet, or ~/.waves by default")
 	walletPassword             = flag.String("wallet-password", "", "Pass password for wallet. Extremely insecure")
 	limitConnectionsS          = flag.String("limit-connections", "30", "N incoming and outgoing connections")
+	limitPerIP                 = flag.Int("limit-connections-per-ip", peer_manager.DefaultConnectionLimits.PerIP, "Max connections with the same IP address, 0 is unlimited")
+	limitPerSubnet             = flag.Int("limit-connections-per-subnet", peer_manager.DefaultConnectionLimits.PerSubnet, "Max connections with the same /24 IPv4 or /48 IPv6 subnet, 0 is unlimited")
 	minPeersMining             = flag.Int("min-peers-mining", 1, "Minimum connected peers for allow mining")
-	profiler                   = flag.Bool("profiler", false, "Start built-in profiler on 'http://localhost:6060/debug/pprof/'")
+	profiler                   = flag.Bool("profiler", false, "Start built-in profiler on 'http://localhost:6060/debug/pprof/', network traffic counters are available on 'http://localhost:6060/debug/vars'")
+	persistUtx                 = flag.Bool("persist-utx", false, "Save UTX pool to the state directory and restore it on start")
+	packingStrategy            = flag.String("packing-strategy", miner.FeeGreedyPacking, "Order of packing transactions into blocks: fee, fifo or sender-fair")
+	signerAddress              = flag.String("signer", "", "Address of external signer: path to Unix socket prefixed with 'unix://' or HTTP URL. If empty, keys of wallet are used")
+	microDelay                 = flag.Duration("micro-delay", microblock.DefaultSettings.MinDelay, "Delay between mined key block and the first microblock on top of it")
+	microInterval              = flag.Duration("micro-interval", microblock.DefaultSettings.Interval, "Interval between mined microblocks")
+	microMaxTxs                = flag.Int("micro-max-txs", microblock.DefaultSettings.MaxTransactions, "Max number of transactions in mined microblock, up to 255")
+	inboundLimit               = flag.Int("inbound-limit", 0, "Max number of bytes per second received from each peer, 0 is unlimited")
+	capturePath                = flag.String("capture", "", "Path to file to record all network messages of node for replay. Recording is disabled if empty")
 )
 
+const utxSnapshotInterval = time.Minute
+
 var defaultPeers = map[string]string{
 	"mainnet":  "35.156.19.4:6868,52.50.69.247:6868,52.52.46.76:6868,52.57.147.71:6868,52.214.55.18:6868,54.176.190.226:6868",
 	"testnet":  "52.51.92.182:6863,52.231.205.53:6863,52.30.47.67:6863,52.28.66.217:6863",
@@ -91,6 +110,9 @@ func debugCommandLineParameters() {
 	zap.S().Debugf("wallet-password: %s", *walletPassword)
 	zap.S().Debugf("limit-connections: %s", *limitConnectionsS)
 	zap.S().Debugf("profiler: %v", *profiler)
+	zap.S().Debugf("persist-utx: %v", *persistUtx)
+	zap.S().Debugf("packing-strategy: %s", *packingStrategy)
+	zap.S().Debugf("signer: %s", *signerAddress)
 }
 
 func main() {
@@ -137,6 +159,15 @@ func main() {
 			return
 		}
 	}
+	var sgn signer.Signer = signer.NewLocalSigner(wal, cfg.AddressSchemeCharacter)
+	if *signerAddress != "" {
+		remote, err := signer.NewRemoteSigner(*signerAddress, cfg.AddressSchemeCharacter)
+		if err != nil {
+			zap.S().Error(err)
+			return
+		}
+		sgn = remote
+	}
 
 	limitConnections, err := strconv.ParseUint(*limitConnectionsS, 10, 64)
 	if err != nil {
@@ -153,12 +184,28 @@ func main() {
 		}
 	}
 
-	reward, err := miner.ParseReward(*reward)
+	minerReward, err := miner.ParseReward(*reward)
 	if err != nil {
 		zap.S().Error(err)
 		return
 	}
 
+	packing, err := miner.NewPackingStrategy(*packingStrategy)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+
+	microSettings := microblock.Settings{
+		MinDelay:        *microDelay,
+		Interval:        *microInterval,
+		MaxTransactions: *microMaxTxs,
+	}
+	if err := microSettings.Validate(); err != nil {
+		zap.S().Error(err)
+		return
+	}
+
 	ntptm, err := ntptime.TryNew("pool.ntp.org", 10)
 	if err != nil {
 		zap.S().Error(err)
@@ -194,7 +241,13 @@ func main() {
 		return
 	}
 
-	features, err = miner.ValidateFeatures(state, features)
+	initialVotes := miner.InitialVotes{
+		Features:    features,
+		Reward:      minerReward,
+		FeaturesSet: *minerVoteFeatures != "",
+		RewardSet:   *reward != "",
+	}
+	voting, err := miner.NewVoting(state, initialVotes, filepath.Join(path, miner.VotesFileName))
 	if err != nil {
 		cancel()
 		zap.S().Error(err)
@@ -217,11 +270,39 @@ func main() {
 	mb := 1024 * 1014
 	pool := bytespool.NewBytesPool(64, mb+(mb/2))
 
-	utx := utxpool.New(uint64(1024*mb), utxpool.NewValidator(state, ntptm), cfg)
+	utxValidator := utxpool.NewValidator(state, ntptm)
+	utx := utxpool.New(uint64(1024*mb), utxValidator, state, cfg)
+	utxSnapshot := filepath.Join(path, utxpool.SnapshotFileName)
+	if *persistUtx {
+		// Snapshot is restored when node catches up with the network, transactions can't be validated before that.
+		go utx.RunSnapshots(ctx, utxSnapshot, utxSnapshotInterval, func() bool {
+			return !utxValidator.Outdated()
+		})
+	}
 
 	parent := peer.NewParent()
 
-	peerSpawnerImpl := peer_manager.NewPeerSpawner(pool, parent, conf.WavesNetwork, declAddr, "gowaves", uint64(rand.Int()), version)
+	var (
+		capt     peer.Capture
+		recorder *capture.Recorder
+	)
+	if *capturePath != "" {
+		recorder, err = capture.NewRecorder(*capturePath)
+		if err != nil {
+			zap.S().Error(err)
+			cancel()
+			return
+		}
+		capt = recorder
+		zap.S().Infof("Recording network messages to '%s'", *capturePath)
+	}
+
+	traffic := conn.NewStats(nil)
+	expvar.Publish("p2p_traffic", expvar.Func(func() interface{} {
+		return traffic.Traffic()
+	}))
+	connOptions := conn.Options{Totals: traffic, InboundLimit: *inboundLimit}
+	peerSpawnerImpl := peer_manager.NewPeerSpawner(pool, parent, conf.WavesNetwork, declAddr, "gowaves", uint64(rand.Int()), version, capt, connOptions)
 
 	peerStorage, err := peer_manager.NewJsonFileStorage(path)
 	if err != nil {
@@ -235,11 +316,12 @@ func main() {
 		peerStorage,
 		int(limitConnections),
 	)
+	peerManager.SetConnectionLimits(peer_manager.ConnectionLimits{PerIP: *limitPerIP, PerSubnet: *limitPerSubnet})
 	go peerManager.Run(ctx)
 
 	scheduler := scheduler.NewScheduler(
 		state,
-		wal,
+		sgn,
 		cfg,
 		ntptm,
 		scheduler.NewMinerConsensus(peerManager, *minPeersMining),
@@ -248,20 +330,27 @@ func main() {
 	blockApplier := blocks_applier.NewBlocksApplier()
 
 	services := services.Services{
-		State:           state,
-		Peers:           peerManager,
-		Scheduler:       scheduler,
-		BlocksApplier:   blockApplier,
-		UtxPool:         utx,
-		Scheme:          cfg.AddressSchemeCharacter,
-		LoggableRunner:  logRunner,
-		Time:            ntptm,
-		Wallet:          wal,
-		MicroBlockCache: microblock_cache.NewMicroblockCache(),
-		InternalChannel: messages.NewInternalChannel(),
+		State:              state,
+		Peers:              peerManager,
+		Scheduler:          scheduler,
+		BlocksApplier:      blockApplier,
+		UtxPool:            utx,
+		PackingStrategy:    packing,
+		MicroblockSettings: microSettings,
+		MicroblockStats:    microblock.NewStats(),
+		Signer:             sgn,
+		UtxMaintainer:      utxpool.NewMaintainer(state, utx, ntptm, cfg, utxpool.DefaultMaintenanceSettings),
+		Scheme:             cfg.AddressSchemeCharacter,
+		LoggableRunner:     logRunner,
+		Time:               ntptm,
+		Wallet:             wal,
+		MicroBlockCache:    microblock_cache.NewMicroblockCache(),
+		InternalChannel:    messages.NewInternalChannel(),
+		Voting:             voting,
+		FsmStatus:          status.NewTracker(status.DefaultHistorySize),
 	}
 
-	mine := miner.NewMicroblockMiner(services, features, reward)
+	mine := miner.NewMicroblockMiner(services)
 	peerManager.SetConnectPeers(!*disableOutgoingConnections)
 	go miner.Run(ctx, mine, scheduler, services.InternalChannel)
 
@@ -293,7 +382,7 @@ func main() {
 	}()
 
 	if *enableGrpcApi {
-		grpcServer, err := server.NewServer(services)
+		grpcServer, err := server.NewServer(services, *apiKey)
 		if err != nil {
 			zap.S().Errorf("Failed to create gRPC server: %v", err)
 		}
@@ -313,6 +402,16 @@ func main() {
 	zap.S().Infow("Caught signal, stopping", "signal", sig)
 	cancel()
 	n.Close()
+	if recorder != nil {
+		if err := recorder.Close(); err != nil {
+			zap.S().Errorf("Failed to close capture file: %v", err)
+		}
+	}
+	if *persistUtx && utx.SnapshotRestored() {
+		if err := utx.SaveSnapshot(utxSnapshot); err != nil {
+			zap.S().Errorf("Failed to save UTX snapshot: %v", err)
+		}
+	}
 	<-time.After(1 * time.Second)
 }
 
@@ -0,0 +1,130 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/libs/microblock_cache"
+	"github.com/wavesplatform/gowaves/pkg/libs/runner"
+	"github.com/wavesplatform/gowaves/pkg/miner"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
+	"github.com/wavesplatform/gowaves/pkg/miner/scheduler"
+	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
+	"github.com/wavesplatform/gowaves/pkg/node"
+	"github.com/wavesplatform/gowaves/pkg/node/blocks_applier"
+	"github.com/wavesplatform/gowaves/pkg/node/messages"
+	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/ng"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
+	"github.com/wavesplatform/gowaves/pkg/p2p/capture"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/services"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/util/common"
+	"github.com/wavesplatform/gowaves/pkg/wallet"
+	"go.uber.org/zap"
+)
+
+// Replays network messages recorded by node with '-capture' flag into the node with fresh state.
+// The node runs offline: it doesn't connect to anyone and doesn't mine, messages it sends are discarded.
+
+var (
+	logLevel       = flag.String("log-level", "INFO", "Logging level. Supported levels: DEBUG, INFO, WARN, ERROR, FATAL. Default logging level INFO.")
+	capturePath    = flag.String("capture", "", "Path to capture file")
+	statePath      = flag.String("state-path", "", "Path to state directory, it should be empty or contain the state of node at the start of capture")
+	blockchainType = flag.String("blockchain-type", "mainnet", "Blockchain type: mainnet/testnet/stagenet")
+	speed          = flag.Float64("speed", 0, "Speed of replay relative to the captured time, for example 2 replays twice as fast. If zero, messages are replayed without delays")
+	wait           = flag.Duration("wait", 5*time.Second, "Time to wait for node to process the last messages")
+)
+
+const outdate = 4 * 3600
+
+func main() {
+	flag.Parse()
+	common.SetupLogger(*logLevel)
+
+	if *capturePath == "" || *statePath == "" {
+		zap.S().Error("both capture and state path are required")
+		return
+	}
+	cfg, err := settings.BlockchainSettingsByTypeName(*blockchainType)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+	r, err := capture.Open(*capturePath)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+	defer r.Close()
+
+	parent := peer.NewParent()
+	replay := capture.NewReplay(parent)
+	replay.Speed = *speed
+
+	params := state.DefaultStateParams()
+	params.Time = replay
+	st, err := state.NewState(*statePath, params, cfg)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	peers := peer_manager.NewPeerManager(nil, &peer_manager.MemoryPeerStorage{}, 1000)
+	peers.SetConnectPeers(false)
+	// connections were limited by node during capture
+	peers.SetConnectionLimits(peer_manager.ConnectionLimits{})
+	// wallet is empty, so the node never mines
+	sgn := signer.NewLocalSigner(wallet.NewWallet(), cfg.AddressSchemeCharacter)
+	sch := scheduler.NewScheduler(st, sgn, cfg, replay, scheduler.NewMinerConsensus(peers, 1), proto.NewTimestampFromUSeconds(outdate))
+	voting, err := miner.NewVoting(st, miner.InitialVotes{}, "")
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+	utx := utxpool.New(10000, utxpool.NewValidator(st, replay), st, cfg)
+	internalCh := messages.NewInternalChannel()
+
+	srv := services.Services{
+		State:           st,
+		Peers:           peers,
+		Scheduler:       sch,
+		BlocksApplier:   blocks_applier.NewBlocksApplier(),
+		UtxPool:         utx,
+		MicroblockStats: microblock.NewStats(),
+		Scheme:          cfg.AddressSchemeCharacter,
+		InvRequester:    ng.NewInvRequester(),
+		LoggableRunner:  runner.NewLogRunner(runner.NewAsync()),
+		Time:            replay,
+		Signer:          sgn,
+		MicroBlockCache: microblock_cache.NewMicroblockCache(),
+		Voting:          voting,
+		FsmStatus:       status.NewTracker(status.DefaultHistorySize),
+		InternalChannel: internalCh,
+	}
+	n := node.NewNode(srv, proto.TCPAddr{}, proto.TCPAddr{}, proto.NewTimestampFromUSeconds(outdate))
+	go n.Run(ctx, parent, internalCh)
+
+	stats, err := replay.Run(ctx, r)
+	if err != nil {
+		zap.S().Errorf("Replay failed: %v", err)
+	}
+	zap.S().Infof("Replayed messages: %s", stats)
+	<-time.After(*wait)
+
+	if h, err := st.Height(); err == nil {
+		zap.S().Infof("Height %d, last block '%s'", h, st.TopBlock().BlockID().String())
+	}
+	for _, t := range srv.FsmStatus.Transitions() {
+		zap.S().Debugf("FSM %s -> %s on %s %s %s", t.From, t.To, t.Event, t.Peer, t.Error)
+	}
+	n.Close()
+}
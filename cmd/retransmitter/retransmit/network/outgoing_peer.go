@@ -124,7 +124,7 @@ func (a *OutgoingPeer) connect(ctx context.Context, wavesNetwork string, remote
 				continue
 			}
 		}
-		return conn.WrapConnection(c, a.params.Pool, remote.ToCh, remote.FromCh, remote.ErrCh, a.params.Skip), &handshake, nil
+		return conn.WrapConnection(c, a.params.Pool, remote.ToCh, remote.FromCh, remote.ErrCh, a.params.Skip, conn.Options{}), &handshake, nil
 	}
 
 	return nil, nil, errors.Errorf("can't connect 20 times")
@@ -0,0 +1,78 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+
+	"github.com/alecthomas/kong"
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/estimation"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
+	"github.com/wavesplatform/gowaves/pkg/ride/lint"
+	"go.uber.org/zap"
+)
+
+var Cli struct {
+	Lint struct {
+		File      string  `kong:"short='f',help='File with base64 encoded compiled script.',required"`
+		Estimator int     `kong:"short='e',help='Version of complexity estimator.',default='3'"`
+		Threshold float64 `kong:"short='t',help='Part of complexity limit after which the complexity of function is reported.',default='0.9'"`
+		Strict    bool    `kong:"short='s',help='Exit with non-zero code if any warnings found.'"`
+	} `kong:"cmd,help='Check compiled script for common problems and print JSON report'"`
+}
+
+func init() {
+	logger, _ := zap.NewDevelopment()
+	zap.ReplaceGlobals(logger)
+}
+
+func main() {
+	ctx := kong.Parse(&Cli)
+	switch ctx.Command() {
+	case "lint":
+		warnings, err := serveLint()
+		if err != nil {
+			zap.S().Error(err)
+			os.Exit(1)
+		}
+		if Cli.Lint.Strict && warnings > 0 {
+			os.Exit(2)
+		}
+	default:
+		zap.S().Error(ctx.Command())
+		return
+	}
+}
+
+func serveLint() (int, error) {
+	b, err := ioutil.ReadFile(Cli.Lint.File)
+	if err != nil {
+		return 0, err
+	}
+	b = bytes.TrimPrefix(bytes.TrimSpace(b), []byte("base64:"))
+	sb, err := reader.ScriptBytesFromBase64(b)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to decode script")
+	}
+	script, err := ast.BuildScript(reader.NewBytesReader(sb))
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to build ast from script bytes")
+	}
+	l, err := lint.NewLinter(estimation.NewEstimatorForScript(Cli.Lint.Estimator, script), Cli.Lint.Threshold)
+	if err != nil {
+		return 0, err
+	}
+	report, err := l.Lint(script)
+	if err != nil {
+		return 0, err
+	}
+	js, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return 0, err
+	}
+	_, _ = os.Stdout.Write(append(js, '\n'))
+	return len(report.Warnings), nil
+}
@@ -0,0 +1,16 @@
+// +build !windows
+
+package main
+
+import (
+	"net"
+	"syscall"
+)
+
+// listenUnix creates the socket accessible by the owner of signer only. Umask is set before the socket
+// is created, so there is no moment when others are allowed to connect.
+func listenUnix(path string) (net.Listener, error) {
+	old := syscall.Umask(0177)
+	defer syscall.Umask(old)
+	return net.Listen("unix", path)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"os/signal"
+	"strings"
+	"syscall"
+
+	"github.com/howeyc/gopass"
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
+	"github.com/wavesplatform/gowaves/pkg/util/common"
+	"github.com/wavesplatform/gowaves/pkg/wallet"
+	"go.uber.org/zap"
+)
+
+const unixPrefix = "unix://"
+
+var (
+	logLevel       = flag.String("log-level", "INFO", "Logging level. Supported levels: DEBUG, INFO, WARN, ERROR, FATAL. Default logging level INFO.")
+	blockchainType = flag.String("blockchain-type", "mainnet", "Blockchain type: mainnet/testnet/stagenet")
+	walletPath     = flag.String("wallet-path", "", "Path to wallet, or ~/.waves by default")
+	walletPassword = flag.String("wallet-password", "", "Pass password for wallet. Extremely insecure, password is asked if empty")
+	listen         = flag.String("listen", "unix:///tmp/gowaves-signer.sock", "Address to listen on: path to Unix socket prefixed with 'unix://' or loopback host:port")
+	allow          = flag.String("allow", "mining", "Comma separated list of objects allowed to sign: block, microblock, microblock-inv, generation-signature, transaction. 'mining' allows everything required for mining, 'all' allows everything")
+)
+
+func main() {
+	flag.Parse()
+
+	common.SetupLogger(*logLevel)
+
+	cfg, err := settings.BlockchainSettingsByTypeName(*blockchainType)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+	policy, err := signer.ParsePolicy(*allow)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+
+	password := []byte(*walletPassword)
+	if len(password) == 0 {
+		fmt.Print("Enter password: ")
+		password, err = gopass.GetPasswd()
+		if err != nil {
+			zap.S().Error(err)
+			return
+		}
+	}
+	wal := wallet.NewEmbeddedWallet(wallet.NewLoader(*walletPath), wallet.NewWallet(), cfg.AddressSchemeCharacter)
+	if err := wal.Load(password); err != nil {
+		zap.S().Error(err)
+		return
+	}
+
+	l, err := newListener(*listen)
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+	srv := &http.Server{Handler: signer.NewServer(signer.NewLocalSigner(wal, cfg.AddressSchemeCharacter), cfg.AddressSchemeCharacter, policy)}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		sig := make(chan os.Signal, 1)
+		signal.Notify(sig, os.Interrupt, syscall.SIGTERM)
+		<-sig
+		zap.S().Info("Shutting down signer...")
+		_ = srv.Shutdown(ctx)
+	}()
+
+	zap.S().Infof("Signer is listening on %s", *listen)
+	if err := srv.Serve(l); err != nil && err != http.ErrServerClosed {
+		zap.S().Error(err)
+	}
+}
+
+func newListener(address string) (net.Listener, error) {
+	if strings.HasPrefix(address, unixPrefix) {
+		path := strings.TrimPrefix(address, unixPrefix)
+		// remove the socket left after previous run
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return nil, err
+		}
+		return listenUnix(path)
+	}
+	// there is neither authentication nor TLS, so signer is not reachable from other hosts
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, err
+	}
+	if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+		return nil, errors.Errorf("signer listens on loopback address only, got '%s'", address)
+	}
+	return net.Listen("tcp", address)
+}
@@ -98,11 +98,11 @@ func (a *App) LoadKeys(apiKey string, password []byte) error {
 
 func (a *App) Accounts() ([]account, error) {
 	r := make([]account, 0)
-	for _, s := range a.services.Wallet.Seeds() {
-		_, pk, err := crypto.GenerateKeyPair(s)
-		if err != nil {
-			return nil, err
-		}
+	pks, err := a.publicKeys()
+	if err != nil {
+		return nil, err
+	}
+	for _, pk := range pks {
 		a, err := proto.NewAddressFromPublicKey(a.services.Scheme, pk)
 		if err != nil {
 			return nil, err
@@ -112,6 +112,22 @@ func (a *App) Accounts() ([]account, error) {
 	return r, nil
 }
 
+// publicKeys returns the keys of signer if any, otherwise the keys of embedded wallet.
+func (a *App) publicKeys() ([]crypto.PublicKey, error) {
+	if a.services.Signer != nil {
+		return a.services.Signer.PublicKeys()
+	}
+	var out []crypto.PublicKey
+	for _, s := range a.services.Wallet.Seeds() {
+		_, pk, err := crypto.GenerateKeyPair(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pk)
+	}
+	return out, nil
+}
+
 func (a *App) checkAuth(key string) error {
 	if !a.apiKeyEnabled {
 		return &AuthError{errors.New("api key disabled")}
@@ -0,0 +1,66 @@
+package api
+
+import (
+	"strconv"
+
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
+	"github.com/wavesplatform/gowaves/pkg/state"
+)
+
+type CallableArgument struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type DAppMeta struct {
+	Version           string                        `json:"version"`
+	CallableFuncTypes map[string][]CallableArgument `json:"callableFuncTypes"`
+}
+
+// ScriptMeta is the response of /addresses/scriptInfo/{address}/meta, meta is omitted for accounts without DApp.
+type ScriptMeta struct {
+	Address string    `json:"address"`
+	Meta    *DAppMeta `json:"meta,omitempty"`
+}
+
+func (a *App) AddressesScriptMeta(addr proto.Address) (*ScriptMeta, error) {
+	rs := &ScriptMeta{Address: addr.String()}
+	info, err := a.state.ScriptInfoByAccount(proto.NewRecipientFromAddress(addr))
+	if err != nil {
+		if state.IsNotFound(err) {
+			return rs, nil
+		}
+		return nil, &InternalError{err}
+	}
+	if len(info.Bytes) == 0 {
+		return rs, nil
+	}
+	script, err := ast.BuildScript(reader.NewBytesReader(info.Bytes))
+	if err != nil {
+		return nil, &InternalError{err}
+	}
+	if !script.IsDapp() {
+		return rs, nil
+	}
+	meta, err := script.Meta()
+	if err != nil {
+		return nil, &InternalError{err}
+	}
+	if meta.Version == 0 {
+		return rs, nil
+	}
+	rs.Meta = &DAppMeta{
+		Version:           strconv.Itoa(int(meta.Version)),
+		CallableFuncTypes: make(map[string][]CallableArgument, len(meta.Functions)),
+	}
+	for _, f := range meta.Functions {
+		args := make([]CallableArgument, len(f.Arguments))
+		for i, arg := range f.Arguments {
+			args[i] = CallableArgument{Name: arg.Name, Type: arg.Type.String()}
+		}
+		rs.Meta.CallableFuncTypes[f.Name] = args
+	}
+	return rs, nil
+}
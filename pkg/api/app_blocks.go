@@ -3,6 +3,7 @@ package api
 import (
 	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
 )
 
 type Score struct {
@@ -73,3 +74,7 @@ func (a *App) BlocksGenerators() (Generators, error) {
 
 	return out, nil
 }
+
+func (a *App) BlocksCheckpoints() []settings.Checkpoint {
+	return a.state.Checkpoints()
+}
@@ -1,5 +1,26 @@
 package api
 
+import "github.com/pkg/errors"
+
 func (a *App) DebugSyncEnabled(enabled bool) {
 	a.sync.SetEnabled(enabled)
 }
+
+type onDemandMiner interface {
+	MineNow() error
+}
+
+// Mine forces generation of block right now, it works only in dev mining mode.
+func (a *App) Mine(apiKey string) error {
+	if err := a.checkAuth(apiKey); err != nil {
+		return err
+	}
+	m, ok := a.scheduler.(onDemandMiner)
+	if !ok {
+		return &BadRequestError{errors.New("mining on demand is not supported")}
+	}
+	if err := m.MineNow(); err != nil {
+		return &BadRequestError{err}
+	}
+	return nil
+}
@@ -3,7 +3,12 @@ package api
 import (
 	"time"
 
+	"github.com/pkg/errors"
 	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/miner"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
 )
 
 type Scheduler struct {
@@ -12,11 +17,42 @@ type Scheduler struct {
 }
 type Next struct {
 	PublicKey crypto.PublicKey `json:"public_key"`
+	Address   proto.Address    `json:"address"`
 	Time      time.Time        `json:"time"`
+	// Forecast details: the hit of account, the base target of parent block and generating balance of account
+	// that give the time of generation, and the base target of the block to be generated.
+	Hit               string `json:"hit,omitempty"`
+	ParentTarget      uint64 `json:"parent_target"`
+	BaseTarget        uint64 `json:"base_target"`
+	GeneratingBalance uint64 `json:"generating_balance"`
+}
+
+// Voting is the current votes of miner and the progress of features voting in the current voting period.
+type Voting struct {
+	Reward          int64         `json:"reward"`
+	Features        []FeatureVote `json:"features"`
+	Height          uint64        `json:"height"`
+	VotingInterval  uint64        `json:"voting_interval"`
+	VotingThreshold uint64        `json:"voting_threshold"`
+	NextCheck       uint64        `json:"next_check"`
+}
+
+type FeatureVote struct {
+	ID          int16  `json:"id"`
+	Description string `json:"description"`
+	// Blocks voted for the feature in the current voting period
+	Votes uint64 `json:"votes"`
+}
+
+// VotingRequest changes the votes of miner, absent fields are left unchanged.
+type VotingRequest struct {
+	Reward   *int64   `json:"reward"`
+	Features *[]int16 `json:"features"`
 }
 
 type MinerInfo struct {
 	Scheduler Scheduler
+	Voting    *Voting `json:"Voting,omitempty"`
 }
 
 func (a *App) Miner() (*MinerInfo, error) {
@@ -24,16 +60,145 @@ func (a *App) Miner() (*MinerInfo, error) {
 
 	next := make([]Next, 0)
 	for _, row := range e {
-		next = append(next, Next{
-			PublicKey: row.KeyPair.Public,
-			Time:      time.Unix(int64(row.Timestamp/1000), 0).Add(time.Duration(row.Timestamp%1000) * time.Millisecond),
-		})
+		addr, err := proto.NewAddressFromPublicKey(a.services.Scheme, row.PublicKey)
+		if err != nil {
+			return nil, err
+		}
+		n := Next{
+			PublicKey:         row.PublicKey,
+			Address:           addr,
+			Time:              time.Unix(int64(row.Timestamp/1000), 0).Add(time.Duration(row.Timestamp%1000) * time.Millisecond),
+			ParentTarget:      row.ParentTarget,
+			BaseTarget:        row.BaseTarget,
+			GeneratingBalance: row.GeneratingBalance,
+		}
+		if row.Hit != nil {
+			n.Hit = row.Hit.String()
+		}
+		next = append(next, n)
 	}
 
-	return &MinerInfo{
+	info := &MinerInfo{
 		Scheduler: Scheduler{
 			TimeNow: time.Now(),
 			Next:    next,
 		},
-	}, nil
+	}
+	if a.services.Voting != nil {
+		v, err := a.voting()
+		if err != nil {
+			return nil, err
+		}
+		info.Voting = v
+	}
+	return info, nil
+}
+
+// MinerVoting returns the desired reward and features the miner votes for.
+func (a *App) MinerVoting(apiKey string) (*Voting, error) {
+	if err := a.checkAuth(apiKey); err != nil {
+		return nil, err
+	}
+	if a.services.Voting == nil {
+		return nil, &BadRequestError{errors.New("miner voting is not available")}
+	}
+	return a.voting()
+}
+
+// SetMinerVoting changes the votes of miner, the changes are saved and survive the restart of node.
+func (a *App) SetMinerVoting(apiKey string, req VotingRequest) (*Voting, error) {
+	if err := a.checkAuth(apiKey); err != nil {
+		return nil, err
+	}
+	if a.services.Voting == nil {
+		return nil, &BadRequestError{errors.New("miner voting is not available")}
+	}
+	if err := a.services.Voting.SetVotes(req.Features, req.Reward); err != nil {
+		return nil, &BadRequestError{err}
+	}
+	return a.voting()
+}
+
+func (a *App) voting() (*Voting, error) {
+	votes := a.services.Voting.Votes()
+	v := &Voting{
+		Reward:   votes.Reward,
+		Features: make([]FeatureVote, 0, len(votes.Features)),
+	}
+	if a.state == nil {
+		for _, id := range votes.Features {
+			v.Features = append(v.Features, FeatureVote{ID: id, Description: featureDescription(id)})
+		}
+		return v, nil
+	}
+	height, err := a.state.Height()
+	if err != nil {
+		return nil, err
+	}
+	sets, err := a.state.BlockchainSettings()
+	if err != nil {
+		return nil, err
+	}
+	v.Height = height
+	v.VotingInterval = sets.ActivationWindowSize(height)
+	v.VotingThreshold = sets.VotesForFeatureElection(height)
+	if v.VotingInterval > 0 {
+		v.NextCheck = height - height%v.VotingInterval + v.VotingInterval
+	}
+	for _, id := range votes.Features {
+		n, err := a.state.VotesNum(id)
+		if err != nil {
+			return nil, err
+		}
+		v.Features = append(v.Features, FeatureVote{ID: id, Description: featureDescription(id), Votes: n})
+	}
+	return v, nil
+}
+
+func featureDescription(id int16) string {
+	if info, ok := settings.FeaturesInfo[settings.Feature(id)]; ok {
+		return info.Description
+	}
+	return "Unknown feature"
+}
+
+// Microblocks holds the microblock mining parameters in milliseconds and the statistics of mining.
+type Microblocks struct {
+	MinDelay        uint64                `json:"min_delay"`
+	Interval        uint64                `json:"interval"`
+	MaxTransactions int                   `json:"max_transactions"`
+	Statistics      microblock.Statistics `json:"statistics"`
+}
+
+// MinerMicroblocks returns the microblock mining parameters and statistics, statistics are empty if not collected.
+func (a *App) MinerMicroblocks() Microblocks {
+	conf := a.services.MicroblockSettings
+	if conf == (microblock.Settings{}) {
+		conf = microblock.DefaultSettings
+	}
+	rs := Microblocks{
+		MinDelay:        uint64(conf.MinDelay / time.Millisecond),
+		Interval:        uint64(conf.Interval / time.Millisecond),
+		MaxTransactions: conf.MaxTransactions,
+	}
+	if a.services.MicroblockStats != nil {
+		rs.Statistics = a.services.MicroblockStats.Statistics()
+	}
+	return rs
+}
+
+// MinerTemplate returns the block the node would mine from UTX right now.
+func (a *App) MinerTemplate() (*miner.Template, error) {
+	if a.state == nil || a.utx == nil {
+		return nil, errors.New("miner template is not available")
+	}
+	strategy := a.services.PackingStrategy
+	if strategy == nil {
+		strategy = miner.FeeGreedyStrategy{}
+	}
+	conf := a.services.MicroblockSettings
+	if conf == (microblock.Settings{}) {
+		conf = microblock.DefaultSettings
+	}
+	return miner.BuildTemplate(a.state, a.utx, strategy, conf, a.services.Scheme, proto.NewTimestampFromTime(time.Now()))
 }
@@ -2,9 +2,16 @@ package api
 
 import (
 	"encoding/json"
-	"github.com/stretchr/testify/require"
+	"math/big"
 	"testing"
 	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/miner/scheduler"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/services"
+	"github.com/wavesplatform/gowaves/pkg/types"
 )
 
 func TestApp_Miner(t *testing.T) {
@@ -17,3 +24,91 @@ func TestApp_Miner(t *testing.T) {
 
 	require.Contains(t, string(bts), "2019-06-03T")
 }
+
+type emits []scheduler.Emit
+
+func (a emits) Emits() []scheduler.Emit {
+	return a
+}
+
+func TestApp_MinerForecast(t *testing.T) {
+	kp, err := proto.NewKeyPair([]byte("test"))
+	require.NoError(t, err)
+	e := emits{{
+		Timestamp:         1559565012000,
+		PublicKey:         kp.Public,
+		BaseTarget:        120,
+		Hit:               big.NewInt(12345),
+		ParentTarget:      100,
+		GeneratingBalance: 1000,
+	}}
+	app, err := NewApp("", e, services.Services{Scheme: proto.MainNetScheme})
+	require.NoError(t, err)
+
+	info, err := app.Miner()
+	require.NoError(t, err)
+	require.Len(t, info.Scheduler.Next, 1)
+	next := info.Scheduler.Next[0]
+	addr, err := proto.NewAddressFromPublicKey(proto.MainNetScheme, kp.Public)
+	require.NoError(t, err)
+	require.Equal(t, addr, next.Address)
+	require.Equal(t, "12345", next.Hit)
+	require.EqualValues(t, 100, next.ParentTarget)
+	require.EqualValues(t, 120, next.BaseTarget)
+	require.EqualValues(t, 1000, next.GeneratingBalance)
+}
+
+type voting struct {
+	votes types.MinerVotes
+}
+
+func (a *voting) Votes() types.MinerVotes {
+	return a.votes
+}
+
+func (a *voting) SetReward(reward int64) error {
+	a.votes.Reward = reward
+	return nil
+}
+
+func (a *voting) SetFeatures(features []int16) error {
+	return a.SetVotes(&features, nil)
+}
+
+func (a *voting) SetVotes(features *[]int16, reward *int64) error {
+	if features != nil && len(*features) > 1 {
+		return errors.New("too many features")
+	}
+	if features != nil {
+		a.votes.Features = *features
+	}
+	if reward != nil {
+		a.votes.Reward = *reward
+	}
+	return nil
+}
+
+func TestApp_SetMinerVoting(t *testing.T) {
+	v := &voting{votes: types.MinerVotes{Reward: 600000000, Features: []int16{14}}}
+	app, err := NewApp("apiKey", emits{}, services.Services{Voting: v})
+	require.NoError(t, err)
+
+	_, err = app.MinerVoting("wrong")
+	require.Error(t, err)
+
+	reward := int64(700000000)
+	rs, err := app.SetMinerVoting("apiKey", VotingRequest{Reward: &reward})
+	require.NoError(t, err)
+	require.EqualValues(t, 700000000, rs.Reward)
+	require.Equal(t, []FeatureVote{{ID: 14, Description: "Block Reward and Community Driven Monetary Policy"}}, rs.Features)
+
+	// valid reward is not applied together with invalid features
+	features := []int16{15, 17}
+	otherReward := int64(800000000)
+	_, err = app.SetMinerVoting("apiKey", VotingRequest{Features: &features, Reward: &otherReward})
+	require.IsType(t, &BadRequestError{}, err)
+
+	info, err := app.Miner()
+	require.NoError(t, err)
+	require.EqualValues(t, 700000000, info.Voting.Reward)
+}
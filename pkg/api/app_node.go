@@ -1,5 +1,52 @@
 package api
 
+import (
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+var errNoFsmStatus = errors.New("FSM status is not tracked")
+
 func (a *App) NodeProcesses() map[string]int {
 	return a.services.LoggableRunner.Running()
 }
+
+type NodeStatus struct {
+	BlockchainHeight proto.Height  `json:"blockchainHeight"`
+	UpdatedTimestamp uint64        `json:"updatedTimestamp"`
+	Fsm              status.Status `json:"fsm"`
+}
+
+func (a *App) NodeStatus() (*NodeStatus, error) {
+	if a.services.FsmStatus == nil {
+		return nil, &InternalError{errNoFsmStatus}
+	}
+	height, err := a.state.Height()
+	if err != nil {
+		return nil, &InternalError{err}
+	}
+	return &NodeStatus{
+		BlockchainHeight: height,
+		UpdatedTimestamp: a.state.TopBlock().Timestamp,
+		Fsm:              a.services.FsmStatus.Status(),
+	}, nil
+}
+
+type FsmDebug struct {
+	Status      status.Status       `json:"status"`
+	Transitions []status.Transition `json:"transitions"`
+}
+
+func (a *App) DebugFsm(apiKey string) (*FsmDebug, error) {
+	if err := a.checkAuth(apiKey); err != nil {
+		return nil, err
+	}
+	if a.services.FsmStatus == nil {
+		return nil, &InternalError{errNoFsmStatus}
+	}
+	return &FsmDebug{
+		Status:      a.services.FsmStatus.Status(),
+		Transitions: a.services.FsmStatus.Transitions(),
+	}, nil
+}
@@ -0,0 +1,47 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/services"
+)
+
+func TestApp_NodeStatus(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	s := mock.NewMockState(ctrl)
+	s.EXPECT().Height().Return(proto.Height(10), nil)
+	s.EXPECT().TopBlock().Return(&proto.Block{BlockHeader: proto.BlockHeader{Timestamp: 1000}})
+
+	tracker := status.NewTracker(status.DefaultHistorySize)
+	now := time.Now()
+	tracker.Update("Idle", nil, "Start", "", nil, now)
+
+	app, err := NewApp("api-key", nil, services.Services{State: s, FsmStatus: tracker})
+	require.NoError(t, err)
+	rs, err := app.NodeStatus()
+	require.NoError(t, err)
+	require.Equal(t, &NodeStatus{
+		BlockchainHeight: 10,
+		UpdatedTimestamp: 1000,
+		Fsm:              status.Status{State: "Idle", Since: proto.NewTimestampFromTime(now)},
+	}, rs)
+
+	_, err = app.DebugFsm("wrong")
+	require.IsType(t, &AuthError{}, err)
+	rs2, err := app.DebugFsm("api-key")
+	require.NoError(t, err)
+	require.Len(t, rs2.Transitions, 1)
+
+	app, err = NewApp("api-key", nil, services.Services{State: s})
+	require.NoError(t, err)
+	_, err = app.DebugFsm("api-key")
+	require.Error(t, err)
+}
@@ -2,33 +2,65 @@ package api
 
 import (
 	"context"
+	"net"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"go.uber.org/zap"
 )
 
+// Peer is the entry of address book, time fields are zero if the event never happened.
 type Peer struct {
-	Address  string `json:"address"`
-	LastSeen uint64 `json:"lastSeen"`
+	Address string `json:"address"`
+	// Time of the last successful connection
+	LastSeen    uint64 `json:"lastSeen"`
+	FirstSeen   uint64 `json:"firstSeen"`
+	LastAttempt uint64 `json:"lastAttempt"`
+	// Failed connection attempts since the last successful connection
+	Failures           int    `json:"failures"`
+	Source             string `json:"source"`
+	DeclaredAddress    string `json:"declaredAddress"`
+	ObservedAddress    string `json:"observedAddress"`
+	ApplicationVersion string `json:"applicationVersion"`
 }
 
 type PeersAll struct {
 	Peers []Peer `json:"peers"`
 }
 
-func (a *App) PeersAll() (*PeersAll, error) {
-	peers, err := a.state.Peers()
-	if err != nil {
-		return nil, &InternalError{err}
+func timestampOrZero(t time.Time) uint64 {
+	if t.IsZero() {
+		return 0
 	}
+	return proto.NewTimestampFromTime(t)
+}
 
-	var out []Peer
-	for _, row := range peers {
-		out = append(out, Peer{Address: row.String()})
+func addrOrEmpty(addr proto.TCPAddr) string {
+	if addr.Empty() {
+		return ""
 	}
+	return addr.String()
+}
 
+func (a *App) PeersAll() (*PeersAll, error) {
+	known := a.peers.AddressBook()
+	out := make([]Peer, 0, len(known))
+	for _, row := range known {
+		out = append(out, Peer{
+			Address:            row.Addr.String(),
+			LastSeen:           timestampOrZero(row.LastConnected),
+			FirstSeen:          timestampOrZero(row.FirstSeen),
+			LastAttempt:        timestampOrZero(row.LastAttempt),
+			Failures:           row.Failures,
+			Source:             row.Source,
+			DeclaredAddress:    addrOrEmpty(row.Declared),
+			ObservedAddress:    addrOrEmpty(row.Observed),
+			ApplicationVersion: row.Version,
+		})
+	}
 	return &PeersAll{Peers: out}, nil
 }
 
@@ -71,6 +103,8 @@ type PeersConnectedRow struct {
 	PeerNonce          uint64 `json:"peerNonce"`
 	ApplicationName    string `json:"applicationName"`
 	ApplicationVersion string `json:"applicationVersion"`
+	// Traffic of connection since it was established, absent if not counted
+	Traffic *conn.Traffic `json:"traffic,omitempty"`
 }
 
 func (a *App) PeersConnected() (*PeersConnectedResponse, error) {
@@ -85,6 +119,10 @@ func (a *App) PeersConnected() (*PeersConnectedResponse, error) {
 			ApplicationName:    peer.Handshake().AppName,
 			ApplicationVersion: peer.Handshake().Version.String(),
 		}
+		if c := peer.Connection(); c != nil && c.Stats() != nil {
+			traffic := c.Stats().Traffic()
+			v.Traffic = &traffic
+		}
 
 		out = append(out, v)
 
@@ -104,6 +142,83 @@ func (a *App) PeersSuspended() (*PeersSuspendedResponse, error) {
 	return &PeersSuspendedResponse{peers}, nil
 }
 
+type PeersBlacklistedRow struct {
+	Hostname  string `json:"hostname"`
+	Timestamp uint64 `json:"timestamp"`
+	// Time the ban expires, zero for permanent ban
+	Until  uint64 `json:"until"`
+	Reason string `json:"reason"`
+}
+
+func (a *App) PeersBlacklisted(apiKey string) ([]PeersBlacklistedRow, error) {
+	if err := a.checkAuth(apiKey); err != nil {
+		return nil, err
+	}
+	bans := a.peers.Banned()
+	out := make([]PeersBlacklistedRow, 0, len(bans))
+	for _, b := range bans {
+		row := PeersBlacklistedRow{
+			Hostname:  b.IP.String(),
+			Timestamp: proto.NewTimestampFromTime(b.Since),
+			Reason:    b.Reason,
+		}
+		if !b.Permanent() {
+			row.Until = proto.NewTimestampFromTime(b.Until)
+		}
+		out = append(out, row)
+	}
+	return out, nil
+}
+
+type PeersBanRequest struct {
+	Host string `json:"host"`
+	// Duration of ban in seconds, zero for permanent ban
+	Duration uint64 `json:"duration"`
+	Reason   string `json:"reason"`
+}
+
+func (a *App) PeersBan(apiKey string, req PeersBanRequest) error {
+	if err := a.checkAuth(apiKey); err != nil {
+		return err
+	}
+	ip := net.ParseIP(req.Host)
+	if ip == nil {
+		return &BadRequestError{errors.New("invalid host")}
+	}
+	reason := req.Reason
+	if reason == "" {
+		reason = "banned via API"
+	}
+	if err := a.peers.Ban(ip, time.Duration(req.Duration)*time.Second, reason); err != nil {
+		return &BadRequestError{err}
+	}
+	return nil
+}
+
+func (a *App) PeersUnban(apiKey string, host string) error {
+	if err := a.checkAuth(apiKey); err != nil {
+		return err
+	}
+	ip := net.ParseIP(host)
+	if ip == nil {
+		return &BadRequestError{errors.New("invalid host")}
+	}
+	if err := a.peers.Unban(ip); err != nil {
+		return &BadRequestError{err}
+	}
+	return nil
+}
+
+func (a *App) PeersClearBlacklist(apiKey string) error {
+	if err := a.checkAuth(apiKey); err != nil {
+		return err
+	}
+	if err := a.peers.ClearBans(); err != nil {
+		return &InternalError{err}
+	}
+	return nil
+}
+
 type PeersSpawnedResponse struct {
 	Peers []proto.IpPort
 }
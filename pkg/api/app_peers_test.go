@@ -1,11 +1,16 @@
 package api
 
 import (
+	"net"
 	"testing"
+	"time"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
 )
@@ -14,13 +19,96 @@ func TestApp_PeersAll(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	s := mock.NewMockState(ctrl)
-	s.EXPECT().Peers().Return([]proto.TCPAddr{proto.NewTCPAddrFromString("127.0.0.1:6868")}, nil)
+	now := time.Unix(1540460047, 0)
+	peers := mock.NewMockPeerManager(ctrl)
+	peers.EXPECT().AddressBook().Return([]peer_manager.KnownPeer{
+		{
+			Addr:          proto.NewTCPAddrFromString("127.0.0.1:6868"),
+			FirstSeen:     now,
+			LastConnected: now.Add(time.Minute),
+			Source:        peer_manager.SourceManual,
+			Observed:      proto.NewTCPAddrFromString("127.0.0.1:6868"),
+			Version:       "1.2.3",
+		},
+	})
 
-	app, err := NewApp("key", nil, services.Services{State: s})
+	app, err := NewApp("key", nil, services.Services{Peers: peers})
 	require.NoError(t, err)
 
 	rs2, err := app.PeersAll()
 	require.NoError(t, err)
-	require.Len(t, rs2.Peers, 1)
+	require.Equal(t, []Peer{{
+		Address:            "127.0.0.1:6868",
+		LastSeen:           1540460107000,
+		FirstSeen:          1540460047000,
+		Source:             "manual",
+		ObservedAddress:    "127.0.0.1:6868",
+		ApplicationVersion: "1.2.3",
+	}}, rs2.Peers)
+}
+
+func TestApp_PeersBlacklisted(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	since := time.Unix(1540460047, 0)
+	peers := mock.NewMockPeerManager(ctrl)
+	peers.EXPECT().Banned().Return([]peer_manager.Ban{
+		{IP: net.IPv4(127, 0, 0, 1), Since: since, Until: since.Add(time.Hour), Reason: "invalid block"},
+		{IP: net.IPv4(127, 0, 0, 2), Since: since, Reason: "permanent"},
+	})
+	peers.EXPECT().Ban(net.ParseIP("127.0.0.3"), time.Minute, "banned via API").Return(nil)
+
+	app, err := NewApp("key", nil, services.Services{Peers: peers})
+	require.NoError(t, err)
+
+	_, err = app.PeersBlacklisted("wrong")
+	require.Error(t, err)
+
+	rs, err := app.PeersBlacklisted("key")
+	require.NoError(t, err)
+	require.Equal(t, []PeersBlacklistedRow{
+		{Hostname: "127.0.0.1", Timestamp: 1540460047000, Until: 1540463647000, Reason: "invalid block"},
+		{Hostname: "127.0.0.2", Timestamp: 1540460047000, Reason: "permanent"},
+	}, rs)
+
+	require.NoError(t, app.PeersBan("key", PeersBanRequest{Host: "127.0.0.3", Duration: 60}))
+	require.IsType(t, &BadRequestError{}, app.PeersBan("key", PeersBanRequest{Host: "localhost"}))
+}
+
+func TestApp_PeersConnected(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stats := conn.NewStats(nil)
+	stats.AddDropped()
+	p := mock.NewMockPeer(ctrl)
+	p.EXPECT().RemoteAddr().Return(proto.NewTCPAddrFromString("127.0.0.1:6868"))
+	p.EXPECT().Handshake().Return(proto.Handshake{AppName: "wavesW", NodeName: "node", Version: proto.NewVersion(1, 2, 3)}).AnyTimes()
+	p.EXPECT().Connection().Return(&statsConnection{stats: stats}).AnyTimes()
+	peers := mock.NewMockPeerManager(ctrl)
+	peers.EXPECT().EachConnected(gomock.Any()).Do(func(f func(peer.Peer, *proto.Score)) {
+		f(p, nil)
+	})
+
+	app, err := NewApp("key", nil, services.Services{Peers: peers})
+	require.NoError(t, err)
+
+	rs, err := app.PeersConnected()
+	require.NoError(t, err)
+	require.Len(t, rs.Peers, 1)
+	require.Equal(t, "/127.0.0.1:6868", rs.Peers[0].Address)
+	require.Equal(t, "1.2.3", rs.Peers[0].ApplicationVersion)
+	require.NotNil(t, rs.Peers[0].Traffic)
+	require.Equal(t, uint64(1), rs.Peers[0].Traffic.Dropped)
+}
+
+// statsConnection provides only the traffic counters.
+type statsConnection struct {
+	conn.Connection
+	stats *conn.Stats
+}
+
+func (a *statsConnection) Stats() *conn.Stats {
+	return a.stats
 }
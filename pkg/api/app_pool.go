@@ -1,5 +1,15 @@
 package api
 
+import "github.com/wavesplatform/gowaves/pkg/miner/utxpool"
+
 func (a *App) PoolTransactions() int {
 	return a.utx.Count()
 }
+
+// PoolStats returns the counters of background pool maintenance, all zeros if maintenance is off.
+func (a *App) PoolStats() utxpool.MaintenanceStats {
+	if a.services.UtxMaintainer == nil {
+		return utxpool.MaintenanceStats{}
+	}
+	return a.services.UtxMaintainer.Stats()
+}
@@ -12,3 +12,26 @@ func TestAppAuth(t *testing.T) {
 	require.Error(t, app.checkAuth("bla"))
 	require.NoError(t, app.checkAuth("apiKey"))
 }
+
+type onDemand struct {
+	emits
+	mined int
+}
+
+func (a *onDemand) MineNow() error {
+	a.mined++
+	return nil
+}
+
+func TestApp_Mine(t *testing.T) {
+	m := &onDemand{}
+	app, err := NewApp("apiKey", m, services.Services{})
+	require.NoError(t, err)
+	require.IsType(t, &AuthError{}, app.Mine("bla"))
+	require.NoError(t, app.Mine("apiKey"))
+	require.Equal(t, 1, m.mined)
+
+	app, err = NewApp("apiKey", emits{}, services.Services{})
+	require.NoError(t, err)
+	require.IsType(t, &BadRequestError{}, app.Mine("apiKey"))
+}
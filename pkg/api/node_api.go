@@ -267,6 +267,53 @@ func (a *NodeApi) PeersSuspended(w http.ResponseWriter, _ *http.Request) {
 	sendJson(w, rs)
 }
 
+func (a *NodeApi) peersBlacklisted(w http.ResponseWriter, r *http.Request) {
+	rs, err := a.app.PeersBlacklisted(r.Header.Get(apiKey))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
+func (a *NodeApi) peersBan(w http.ResponseWriter, r *http.Request) {
+	req := PeersBanRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		handleError(w, &BadRequestError{err})
+		return
+	}
+	if err := a.app.PeersBan(r.Header.Get(apiKey), req); err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, map[string]string{"result": "peer banned"})
+}
+
+type peersUnbanRequest struct {
+	Host string `json:"host"`
+}
+
+func (a *NodeApi) peersUnban(w http.ResponseWriter, r *http.Request) {
+	req := peersUnbanRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		handleError(w, &BadRequestError{err})
+		return
+	}
+	if err := a.app.PeersUnban(r.Header.Get(apiKey), req.Host); err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, map[string]string{"result": "peer unbanned"})
+}
+
+func (a *NodeApi) peersClearBlacklist(w http.ResponseWriter, r *http.Request) {
+	if err := a.app.PeersClearBlacklist(r.Header.Get(apiKey)); err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, map[string]string{"result": "blacklist cleared"})
+}
+
 func (a *NodeApi) BlocksGenerators(w http.ResponseWriter, _ *http.Request) {
 	rs, err := a.app.BlocksGenerators()
 	if err != nil {
@@ -276,11 +323,20 @@ func (a *NodeApi) BlocksGenerators(w http.ResponseWriter, _ *http.Request) {
 	sendJson(w, rs)
 }
 
+func (a *NodeApi) blocksCheckpoints(w http.ResponseWriter, _ *http.Request) {
+	sendJson(w, a.app.BlocksCheckpoints())
+}
+
 func (a *NodeApi) poolTransactions(w http.ResponseWriter, _ *http.Request) {
 	rs := a.app.PoolTransactions()
 	sendJson(w, rs)
 }
 
+func (a *NodeApi) poolStats(w http.ResponseWriter, _ *http.Request) {
+	rs := a.app.PoolStats()
+	sendJson(w, rs)
+}
+
 type rollbackRequest struct {
 	Height uint64 `json:"height"`
 }
@@ -351,11 +407,80 @@ func (a *NodeApi) MinerInfo(w http.ResponseWriter, _ *http.Request) {
 	sendJson(w, rs)
 }
 
+func (a *NodeApi) minerVoting(w http.ResponseWriter, r *http.Request) {
+	rs, err := a.app.MinerVoting(r.Header.Get(apiKey))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
+func (a *NodeApi) setMinerVoting(w http.ResponseWriter, r *http.Request) {
+	req := VotingRequest{}
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		handleError(w, &BadRequestError{err})
+		return
+	}
+	rs, err := a.app.SetMinerVoting(r.Header.Get(apiKey), req)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
+func (a *NodeApi) minerMicroblocks(w http.ResponseWriter, _ *http.Request) {
+	rs := a.app.MinerMicroblocks()
+	sendJson(w, rs)
+}
+
+func (a *NodeApi) MinerTemplate(w http.ResponseWriter, _ *http.Request) {
+	rs, err := a.app.MinerTemplate()
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
+func (a *NodeApi) AddressesScriptMeta(w http.ResponseWriter, r *http.Request) {
+	addr, err := proto.NewAddressFromString(chi.URLParam(r, "address"))
+	if err != nil {
+		handleError(w, &BadRequestError{err})
+		return
+	}
+	rs, err := a.app.AddressesScriptMeta(addr)
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
 func (a *NodeApi) nodeProcesses(w http.ResponseWriter, _ *http.Request) {
 	rs := a.app.NodeProcesses()
 	sendJson(w, rs)
 }
 
+func (a *NodeApi) nodeStatus(w http.ResponseWriter, _ *http.Request) {
+	rs, err := a.app.NodeStatus()
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
+func (a *NodeApi) debugFsm(w http.ResponseWriter, r *http.Request) {
+	rs, err := a.app.DebugFsm(r.Header.Get(apiKey))
+	if err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, rs)
+}
+
 func (a *NodeApi) stateHash(w http.ResponseWriter, r *http.Request) {
 	s := chi.URLParam(r, "height")
 	height, err := strconv.ParseUint(s, 10, 64)
@@ -376,6 +501,15 @@ func (a *NodeApi) stateHash(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+func (a *NodeApi) debugMine(w http.ResponseWriter, r *http.Request) {
+	apiKey := r.Header.Get("X-API-Key")
+	if err := a.app.Mine(apiKey); err != nil {
+		handleError(w, err)
+		return
+	}
+	sendJson(w, nil)
+}
+
 func handleError(w http.ResponseWriter, err error) {
 	switch err.(type) {
 	case *AuthError:
@@ -11,23 +11,37 @@ func (a *NodeApi) routes() chi.Router {
 	r.Get("/blocks/score/at/{id:\\d+}", a.BlockScoreAt)
 	r.Get("/blocks/id/{id}", a.BlockIDAt)
 	r.Get("/blocks/generators", a.BlocksGenerators)
+	r.Get("/blocks/checkpoints", a.blocksCheckpoints)
 	r.Post("/blocks/rollback", RollbackToHeight(a.app))
 	r.Get("/pool/transactions", a.poolTransactions)
+	r.Get("/pool/stats", a.poolStats)
+	r.Get("/addresses/scriptInfo/{address}/meta", a.AddressesScriptMeta)
 	r.Route("/peers", func(r chi.Router) {
 		r.Get("/known", a.PeersAll)
 		r.Get("/connected", a.PeersConnected)
 		r.Post("/connect", a.PeersConnect)
 		r.Get("/suspended", a.PeersSuspended)
 		r.Get("/spawned", a.PeersSpawned)
+		r.Get("/blacklisted", a.peersBlacklisted)
+		r.Post("/clearblacklist", a.peersClearBlacklist)
+		r.Post("/ban", a.peersBan)
+		r.Post("/unban", a.peersUnban)
 	})
 	r.Get("/miner/info", a.MinerInfo)
+	r.Get("/miner/template", a.MinerTemplate)
+	r.Get("/miner/micro", a.minerMicroblocks)
+	r.Get("/miner/voting", a.minerVoting)
+	r.Post("/miner/voting", a.setMinerVoting)
 	r.Post("/transactions/broadcast", a.TransactionsBroadcast)
 
 	r.Post("/wallet/load", WalletLoadKeys(a.app))
 	r.Get("/wallet/accounts", a.WalletAccounts)
 
 	r.Get("/node/processes", a.nodeProcesses)
+	r.Get("/node/status", a.nodeStatus)
+	r.Get("/debug/fsm", a.debugFsm)
 	r.Get("/debug/stateHash/{height:\\d+}", a.stateHash)
+	r.Post("/debug/mine", a.debugMine)
 	// enable or disable history sync
 	//r.Get("/debug/sync/{enabled:\\d+}", a.DebugSyncEnabled)
 
@@ -43,6 +43,30 @@ type stateInfoProvider interface {
 	SaveHitSources(startHeight uint64, hs [][]byte) error
 }
 
+// headersStateProvider is the part of state required to check the headers of blocks that are not applied yet.
+type headersStateProvider interface {
+	BlockchainSettings() (*settings.BlockchainSettings, error)
+	HeaderByHeight(height uint64) (*proto.BlockHeader, error)
+	IsActiveAtHeight(featureID int16, height proto.Height) (bool, error)
+	ActivationHeight(featureID int16) (proto.Height, error)
+	HitSourceAtHeight(height uint64) ([]byte, error)
+}
+
+var errNoBalances = errors.New("balances are not available for headers check")
+
+// headersOnly makes headers state provider suitable for ConsensusValidator that never reads balances.
+type headersOnly struct {
+	headersStateProvider
+}
+
+func (headersOnly) EffectiveBalance(proto.Recipient, uint64, uint64) (uint64, error) {
+	return 0, errNoBalances
+}
+
+func (headersOnly) SaveHitSources(uint64, [][]byte) error {
+	return errNoBalances
+}
+
 type ConsensusValidator struct {
 	state       stateInfoProvider
 	settings    *settings.BlockchainSettings
@@ -51,6 +75,8 @@ type ConsensusValidator struct {
 	headers    []proto.BlockHeader
 	hitSources [][]byte
 	ntpTime    types.Time
+	// Generating balances are not validated, because they depend on transactions of the blocks being validated.
+	headersOnly bool
 }
 
 func NewConsensusValidator(state stateInfoProvider, tm types.Time) (*ConsensusValidator, error) {
@@ -66,6 +92,16 @@ func NewConsensusValidator(state stateInfoProvider, tm types.Time) (*ConsensusVa
 
 }
 
+// NewHeadersValidator creates validator that checks headers before their blocks are applied, see CheckHeaders.
+func NewHeadersValidator(state headersStateProvider, tm types.Time) (*ConsensusValidator, error) {
+	cv, err := NewConsensusValidator(headersOnly{state}, tm)
+	if err != nil {
+		return nil, err
+	}
+	cv.headersOnly = true
+	return cv, nil
+}
+
 func (cv *ConsensusValidator) smallerMinimalGeneratingBalanceActivated(height uint64) (bool, error) {
 	return cv.state.IsActiveAtHeight(int16(settings.SmallerMinimalGeneratingBalance), height)
 }
@@ -122,6 +158,36 @@ func (cv *ConsensusValidator) hitSourceByHeight(height uint64) ([]byte, error) {
 }
 
 func (cv *ConsensusValidator) ValidateHeaders(headers []proto.BlockHeader, startHeight uint64) error {
+	if err := cv.validateHeaders(headers, startHeight); err != nil {
+		return err
+	}
+	if err := cv.state.SaveHitSources(cv.startHeight, cv.hitSources); err != nil {
+		return errors.Wrap(err, "failed to update hit source")
+	}
+	return nil
+}
+
+// CheckHeaders validates the chain of headers following the block at startHeight without applying it:
+// references to parents, generation signatures, base targets, timestamps and versions of blocks.
+// Generating balances and block delays are left for ValidateHeaders, when the transactions of blocks are applied.
+// Hit sources are not saved.
+func (cv *ConsensusValidator) CheckHeaders(headers []proto.BlockHeader, startHeight uint64) error {
+	parent, err := cv.state.HeaderByHeight(startHeight)
+	if err != nil {
+		return errors.Wrap(err, "failed to retrieve last block")
+	}
+	parentID := parent.BlockID()
+	for i := range headers {
+		if headers[i].Parent != parentID {
+			return errors.Errorf("block '%s' at %d doesn't reference previous block '%s'",
+				headers[i].BlockID().String(), startHeight+uint64(i)+1, parentID.String())
+		}
+		parentID = headers[i].BlockID()
+	}
+	return cv.validateHeaders(headers, startHeight)
+}
+
+func (cv *ConsensusValidator) validateHeaders(headers []proto.BlockHeader, startHeight uint64) error {
 	cv.startHeight = startHeight
 	cv.headers = headers
 	cv.hitSources = make([][]byte, 0, len(headers))
@@ -151,9 +217,6 @@ func (cv *ConsensusValidator) ValidateHeaders(headers []proto.BlockHeader, start
 			return errors.Wrap(err, "block version validation failed")
 		}
 	}
-	if err := cv.state.SaveHitSources(cv.startHeight, cv.hitSources); err != nil {
-		return errors.Wrap(err, "failed to update hit source")
-	}
 	return nil
 }
 
@@ -351,6 +414,9 @@ func (cv *ConsensusValidator) validateGeneratorSignatureAndBlockDelay(height uin
 	if cv.settings.Type == settings.MainNet && isInvalidMainNetBlock(header.BlockID(), height) {
 		return nil
 	}
+	if cv.headersOnly {
+		return nil
+	}
 
 	parent, err := cv.headerByHeight(height)
 	if err != nil {
@@ -363,6 +429,10 @@ func (cv *ConsensusValidator) validateGeneratorSignatureAndBlockDelay(height uin
 	if err := cv.validateEffectiveBalance(header, effectiveBalance, height); err != nil {
 		return errors.Errorf("invalid generating balance at height %d: %v\n", height, err)
 	}
+	if cv.settings.DevMiningEnabled() {
+		// blocks are generated on demand, the delay is not checked
+		return nil
+	}
 	hit, err := GenHit(hitSource)
 	if err != nil {
 		return err
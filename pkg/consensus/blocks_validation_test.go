@@ -0,0 +1,83 @@
+package consensus
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/libs/ntptime"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+)
+
+type headersState struct {
+	headers []proto.BlockHeader
+}
+
+func (a headersState) BlockchainSettings() (*settings.BlockchainSettings, error) {
+	s := *settings.MainNetSettings
+	s.Type = settings.Custom
+	return &s, nil
+}
+
+func (a headersState) HeaderByHeight(height uint64) (*proto.BlockHeader, error) {
+	return &a.headers[height-1], nil
+}
+
+func (a headersState) IsActiveAtHeight(int16, proto.Height) (bool, error) {
+	return false, nil
+}
+
+func (a headersState) ActivationHeight(int16) (proto.Height, error) {
+	return 0, nil
+}
+
+func (a headersState) HitSourceAtHeight(uint64) ([]byte, error) {
+	return make([]byte, crypto.DigestSize), nil
+}
+
+func TestConsensusValidator_CheckHeaders(t *testing.T) {
+	genesis := proto.BlockHeader{
+		Version:        proto.GenesisBlockVersion,
+		Timestamp:      1000,
+		BlockSignature: crypto.Signature{1},
+		NxtConsensus: proto.NxtConsensus{
+			BaseTarget:   100,
+			GenSignature: make([]byte, crypto.DigestSize),
+		},
+	}
+	_, pk, err := crypto.GenerateKeyPair([]byte("generator"))
+	require.NoError(t, err)
+	genSig, err := (&NXTGenerationSignatureProvider{}).GenerationSignature(pk, genesis.GenSignature)
+	require.NoError(t, err)
+	baseTarget, err := (&NxtPosCalculator{}).CalculateBaseTarget(60, 1, genesis.BaseTarget, genesis.Timestamp, 0, 61000)
+	require.NoError(t, err)
+	valid := proto.BlockHeader{
+		Version:        proto.PlainBlockVersion,
+		Timestamp:      61000,
+		Parent:         genesis.BlockID(),
+		GenPublicKey:   pk,
+		BlockSignature: crypto.Signature{2},
+		NxtConsensus: proto.NxtConsensus{
+			BaseTarget:   baseTarget,
+			GenSignature: genSig,
+		},
+	}
+
+	cv, err := NewHeadersValidator(headersState{headers: []proto.BlockHeader{genesis}}, ntptime.Stub{})
+	require.NoError(t, err)
+
+	require.NoError(t, cv.CheckHeaders([]proto.BlockHeader{valid}, 1))
+
+	wrongParent := valid
+	wrongParent.Parent = proto.NewBlockIDFromSignature(crypto.Signature{3})
+	require.Error(t, cv.CheckHeaders([]proto.BlockHeader{wrongParent}, 1))
+
+	wrongTarget := valid
+	wrongTarget.BaseTarget++
+	require.Error(t, cv.CheckHeaders([]proto.BlockHeader{wrongTarget}, 1))
+
+	wrongGenSig := valid
+	wrongGenSig.GenSignature = make([]byte, crypto.DigestSize)
+	require.Error(t, cv.CheckHeaders([]proto.BlockHeader{wrongGenSig}, 1))
+}
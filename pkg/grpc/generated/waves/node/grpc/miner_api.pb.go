@@ -0,0 +1,458 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.22.0
+// 	protoc        v3.11.4
+// source: waves/node/grpc/miner_api.proto
+
+package grpc
+
+import (
+	context "context"
+	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type MinerVotingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reward   int64                      `protobuf:"varint,1,opt,name=reward,proto3" json:"reward,omitempty"`
+	Features []*FeatureActivationStatus `protobuf:"bytes,2,rep,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *MinerVotingResponse) Reset() {
+	*x = MinerVotingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waves_node_grpc_miner_api_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinerVotingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinerVotingResponse) ProtoMessage() {}
+
+func (x *MinerVotingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_waves_node_grpc_miner_api_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinerVotingResponse.ProtoReflect.Descriptor instead.
+func (*MinerVotingResponse) Descriptor() ([]byte, []int) {
+	return file_waves_node_grpc_miner_api_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *MinerVotingResponse) GetReward() int64 {
+	if x != nil {
+		return x.Reward
+	}
+	return 0
+}
+
+func (x *MinerVotingResponse) GetFeatures() []*FeatureActivationStatus {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type SetMinerVotingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Reward   *wrappers.Int64Value            `protobuf:"bytes,1,opt,name=reward,proto3" json:"reward,omitempty"`
+	Features *SetMinerVotingRequest_Features `protobuf:"bytes,2,opt,name=features,proto3" json:"features,omitempty"`
+}
+
+func (x *SetMinerVotingRequest) Reset() {
+	*x = SetMinerVotingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waves_node_grpc_miner_api_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMinerVotingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMinerVotingRequest) ProtoMessage() {}
+
+func (x *SetMinerVotingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_waves_node_grpc_miner_api_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMinerVotingRequest.ProtoReflect.Descriptor instead.
+func (*SetMinerVotingRequest) Descriptor() ([]byte, []int) {
+	return file_waves_node_grpc_miner_api_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *SetMinerVotingRequest) GetReward() *wrappers.Int64Value {
+	if x != nil {
+		return x.Reward
+	}
+	return nil
+}
+
+func (x *SetMinerVotingRequest) GetFeatures() *SetMinerVotingRequest_Features {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type SetMinerVotingRequest_Features struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Ids []int32 `protobuf:"varint,1,rep,packed,name=ids,proto3" json:"ids,omitempty"`
+}
+
+func (x *SetMinerVotingRequest_Features) Reset() {
+	*x = SetMinerVotingRequest_Features{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_waves_node_grpc_miner_api_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetMinerVotingRequest_Features) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetMinerVotingRequest_Features) ProtoMessage() {}
+
+func (x *SetMinerVotingRequest_Features) ProtoReflect() protoreflect.Message {
+	mi := &file_waves_node_grpc_miner_api_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetMinerVotingRequest_Features.ProtoReflect.Descriptor instead.
+func (*SetMinerVotingRequest_Features) Descriptor() ([]byte, []int) {
+	return file_waves_node_grpc_miner_api_proto_rawDescGZIP(), []int{1, 0}
+}
+
+func (x *SetMinerVotingRequest_Features) GetIds() []int32 {
+	if x != nil {
+		return x.Ids
+	}
+	return nil
+}
+
+var File_waves_node_grpc_miner_api_proto protoreflect.FileDescriptor
+
+var file_waves_node_grpc_miner_api_proto_rawDesc = []byte{
+	0x0a, 0x1f, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x67, 0x72, 0x70,
+	0x63, 0x2f, 0x6d, 0x69, 0x6e, 0x65, 0x72, 0x5f, 0x61, 0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x12, 0x0f, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x67, 0x72,
+	0x70, 0x63, 0x1a, 0x24, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x67,
+	0x72, 0x70, 0x63, 0x2f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x61,
+	0x70, 0x69, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x73, 0x0a, 0x13, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x72, 0x65,
+	0x77, 0x61, 0x72, 0x64, 0x12, 0x44, 0x0a, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2e, 0x6e,
+	0x6f, 0x64, 0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x52, 0x08, 0x66, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x22, 0xb7, 0x01, 0x0a, 0x15, 0x53,
+	0x65, 0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x56, 0x61, 0x6c, 0x75,
+	0x65, 0x52, 0x06, 0x72, 0x65, 0x77, 0x61, 0x72, 0x64, 0x12, 0x4b, 0x0a, 0x08, 0x66, 0x65, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x77, 0x61,
+	0x76, 0x65, 0x73, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+	0x74, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x2e, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x52, 0x08, 0x66, 0x65,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x1a, 0x1c, 0x0a, 0x08, 0x46, 0x65, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x05, 0x52,
+	0x03, 0x69, 0x64, 0x73, 0x32, 0xb0, 0x01, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x41, 0x70,
+	0x69, 0x12, 0x49, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x24, 0x2e, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2e, 0x6e,
+	0x6f, 0x64, 0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x59, 0x0a, 0x09,
+	0x53, 0x65, 0x74, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x26, 0x2e, 0x77, 0x61, 0x76, 0x65,
+	0x73, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65, 0x74, 0x4d,
+	0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2e, 0x6e, 0x6f, 0x64, 0x65, 0x2e, 0x67,
+	0x72, 0x70, 0x63, 0x2e, 0x4d, 0x69, 0x6e, 0x65, 0x72, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x73, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x77,
+	0x61, 0x76, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x67, 0x72, 0x70, 0x63, 0x5a, 0x43, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x77, 0x61, 0x76, 0x65, 0x73, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x2f,
+	0x67, 0x6f, 0x77, 0x61, 0x76, 0x65, 0x73, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x77, 0x61, 0x76, 0x65, 0x73,
+	0x2f, 0x6e, 0x6f, 0x64, 0x65, 0x2f, 0x67, 0x72, 0x70, 0x63, 0xaa, 0x02, 0x0f, 0x57, 0x61, 0x76,
+	0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_waves_node_grpc_miner_api_proto_rawDescOnce sync.Once
+	file_waves_node_grpc_miner_api_proto_rawDescData = file_waves_node_grpc_miner_api_proto_rawDesc
+)
+
+func file_waves_node_grpc_miner_api_proto_rawDescGZIP() []byte {
+	file_waves_node_grpc_miner_api_proto_rawDescOnce.Do(func() {
+		file_waves_node_grpc_miner_api_proto_rawDescData = protoimpl.X.CompressGZIP(file_waves_node_grpc_miner_api_proto_rawDescData)
+	})
+	return file_waves_node_grpc_miner_api_proto_rawDescData
+}
+
+var file_waves_node_grpc_miner_api_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_waves_node_grpc_miner_api_proto_goTypes = []interface{}{
+	(*MinerVotingResponse)(nil),            // 0: waves.node.grpc.MinerVotingResponse
+	(*SetMinerVotingRequest)(nil),          // 1: waves.node.grpc.SetMinerVotingRequest
+	(*SetMinerVotingRequest_Features)(nil), // 2: waves.node.grpc.SetMinerVotingRequest.Features
+	(*FeatureActivationStatus)(nil),        // 3: waves.node.grpc.FeatureActivationStatus
+	(*wrappers.Int64Value)(nil),            // 4: google.protobuf.Int64Value
+	(*empty.Empty)(nil),                    // 5: google.protobuf.Empty
+}
+var file_waves_node_grpc_miner_api_proto_depIdxs = []int32{
+	3, // 0: waves.node.grpc.MinerVotingResponse.features:type_name -> waves.node.grpc.FeatureActivationStatus
+	4, // 1: waves.node.grpc.SetMinerVotingRequest.reward:type_name -> google.protobuf.Int64Value
+	2, // 2: waves.node.grpc.SetMinerVotingRequest.features:type_name -> waves.node.grpc.SetMinerVotingRequest.Features
+	5, // 3: waves.node.grpc.MinerApi.GetVoting:input_type -> google.protobuf.Empty
+	1, // 4: waves.node.grpc.MinerApi.SetVoting:input_type -> waves.node.grpc.SetMinerVotingRequest
+	0, // 5: waves.node.grpc.MinerApi.GetVoting:output_type -> waves.node.grpc.MinerVotingResponse
+	0, // 6: waves.node.grpc.MinerApi.SetVoting:output_type -> waves.node.grpc.MinerVotingResponse
+	5, // [5:7] is the sub-list for method output_type
+	3, // [3:5] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
+}
+
+func init() { file_waves_node_grpc_miner_api_proto_init() }
+func file_waves_node_grpc_miner_api_proto_init() {
+	if File_waves_node_grpc_miner_api_proto != nil {
+		return
+	}
+	file_waves_node_grpc_blockchain_api_proto_init()
+	if !protoimpl.UnsafeEnabled {
+		file_waves_node_grpc_miner_api_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MinerVotingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_waves_node_grpc_miner_api_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMinerVotingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_waves_node_grpc_miner_api_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetMinerVotingRequest_Features); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_waves_node_grpc_miner_api_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   3,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_waves_node_grpc_miner_api_proto_goTypes,
+		DependencyIndexes: file_waves_node_grpc_miner_api_proto_depIdxs,
+		MessageInfos:      file_waves_node_grpc_miner_api_proto_msgTypes,
+	}.Build()
+	File_waves_node_grpc_miner_api_proto = out.File
+	file_waves_node_grpc_miner_api_proto_rawDesc = nil
+	file_waves_node_grpc_miner_api_proto_goTypes = nil
+	file_waves_node_grpc_miner_api_proto_depIdxs = nil
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// MinerApiClient is the client API for MinerApi service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MinerApiClient interface {
+	GetVoting(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MinerVotingResponse, error)
+	SetVoting(ctx context.Context, in *SetMinerVotingRequest, opts ...grpc.CallOption) (*MinerVotingResponse, error)
+}
+
+type minerApiClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMinerApiClient(cc grpc.ClientConnInterface) MinerApiClient {
+	return &minerApiClient{cc}
+}
+
+func (c *minerApiClient) GetVoting(ctx context.Context, in *empty.Empty, opts ...grpc.CallOption) (*MinerVotingResponse, error) {
+	out := new(MinerVotingResponse)
+	err := c.cc.Invoke(ctx, "/waves.node.grpc.MinerApi/GetVoting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *minerApiClient) SetVoting(ctx context.Context, in *SetMinerVotingRequest, opts ...grpc.CallOption) (*MinerVotingResponse, error) {
+	out := new(MinerVotingResponse)
+	err := c.cc.Invoke(ctx, "/waves.node.grpc.MinerApi/SetVoting", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MinerApiServer is the server API for MinerApi service.
+type MinerApiServer interface {
+	GetVoting(context.Context, *empty.Empty) (*MinerVotingResponse, error)
+	SetVoting(context.Context, *SetMinerVotingRequest) (*MinerVotingResponse, error)
+}
+
+// UnimplementedMinerApiServer can be embedded to have forward compatible implementations.
+type UnimplementedMinerApiServer struct {
+}
+
+func (*UnimplementedMinerApiServer) GetVoting(context.Context, *empty.Empty) (*MinerVotingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetVoting not implemented")
+}
+func (*UnimplementedMinerApiServer) SetVoting(context.Context, *SetMinerVotingRequest) (*MinerVotingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetVoting not implemented")
+}
+
+func RegisterMinerApiServer(s *grpc.Server, srv MinerApiServer) {
+	s.RegisterService(&_MinerApi_serviceDesc, srv)
+}
+
+func _MinerApi_GetVoting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(empty.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinerApiServer).GetVoting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/waves.node.grpc.MinerApi/GetVoting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinerApiServer).GetVoting(ctx, req.(*empty.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MinerApi_SetVoting_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetMinerVotingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MinerApiServer).SetVoting(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/waves.node.grpc.MinerApi/SetVoting",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MinerApiServer).SetVoting(ctx, req.(*SetMinerVotingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _MinerApi_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "waves.node.grpc.MinerApi",
+	HandlerType: (*MinerApiServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "GetVoting",
+			Handler:    _MinerApi_GetVoting_Handler,
+		},
+		{
+			MethodName: "SetVoting",
+			Handler:    _MinerApi_SetVoting_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "waves/node/grpc/miner_api.proto",
+}
@@ -8,6 +8,8 @@ import (
 	pb "github.com/wavesplatform/gowaves/pkg/grpc/generated/waves"
 	g "github.com/wavesplatform/gowaves/pkg/grpc/generated/waves/node/grpc"
 	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -54,7 +56,39 @@ func (s *Server) GetScript(ctx context.Context, req *g.AccountRequest) (*g.Scrip
 	if err != nil {
 		return nil, status.Errorf(codes.NotFound, err.Error())
 	}
-	return scriptInfo.ToProtobuf(), nil
+	res := scriptInfo.ToProtobuf()
+	res.Callables, err = callableSignatures(scriptInfo.Bytes)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	return res, nil
+}
+
+// callableSignatures decodes the signatures of callable functions from the meta of DApp, there are none for other scripts.
+func callableSignatures(script []byte) ([]*g.ScriptData_CallableSignature, error) {
+	if len(script) == 0 {
+		return nil, nil
+	}
+	tree, err := ast.BuildScript(reader.NewBytesReader(script))
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to parse script")
+	}
+	if !tree.IsDapp() {
+		return nil, nil
+	}
+	meta, err := tree.Meta()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to decode meta of DApp")
+	}
+	res := make([]*g.ScriptData_CallableSignature, len(meta.Functions))
+	for i, f := range meta.Functions {
+		args := make([]*g.ScriptData_Argument, len(f.Arguments))
+		for j, arg := range f.Arguments {
+			args[j] = &g.ScriptData_Argument{Name: arg.Name, Type: arg.Type.String()}
+		}
+		res[i] = &g.ScriptData_CallableSignature{Function: f.Name, Arguments: args}
+	}
+	return res, nil
 }
 
 type getActiveLeasesHandler struct {
@@ -5,6 +5,7 @@ import (
 	"net"
 
 	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	g "github.com/wavesplatform/gowaves/pkg/grpc/generated/waves/node/grpc"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
@@ -14,22 +15,42 @@ import (
 	"google.golang.org/grpc"
 )
 
+type transactionSigner interface {
+	SignTransactionWith(pk crypto.PublicKey, tx proto.Transaction) error
+}
+
 type Server struct {
 	state  state.StateInfo
 	scheme proto.Scheme
 	utx    types.UtxPool
-	wallet types.EmbeddedWallet
+	wallet transactionSigner
+	voting types.MinerVoting
+	// API key protects the methods changing the node, they are disabled if the key is empty
+	hashedApiKey  crypto.Digest
+	apiKeyEnabled bool
 }
 
-func NewServer(services services.Services) (*Server, error) {
-	s := &Server{}
-	if err := s.initServer(services.State, services.UtxPool, services.Wallet); err != nil {
+func NewServer(services services.Services, apiKey string) (*Server, error) {
+	digest, err := crypto.SecureHash([]byte(apiKey))
+	if err != nil {
+		return nil, err
+	}
+	s := &Server{
+		voting:        services.Voting,
+		hashedApiKey:  digest,
+		apiKeyEnabled: len(apiKey) > 0,
+	}
+	var signer transactionSigner = services.Wallet
+	if services.Signer != nil {
+		signer = services.Signer
+	}
+	if err := s.initServer(services.State, services.UtxPool, signer); err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
-func (s *Server) initServer(state state.StateInfo, utx types.UtxPool, sch types.EmbeddedWallet) error {
+func (s *Server) initServer(state state.StateInfo, utx types.UtxPool, sch transactionSigner) error {
 	settings, err := state.BlockchainSettings()
 	if err != nil {
 		return err
@@ -48,6 +69,7 @@ func (s *Server) Run(ctx context.Context, address string) error {
 	g.RegisterBlockchainApiServer(grpcServer, s)
 	g.RegisterBlocksApiServer(grpcServer, s)
 	g.RegisterTransactionsApiServer(grpcServer, s)
+	g.RegisterMinerApiServer(grpcServer, s)
 
 	go func() {
 		<-ctx.Done()
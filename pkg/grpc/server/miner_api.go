@@ -0,0 +1,90 @@
+package server
+
+import (
+	"context"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	g "github.com/wavesplatform/gowaves/pkg/grpc/generated/waves/node/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// apiKeyMetadata is the metadata key of API key, the same as the header of REST API.
+const apiKeyMetadata = "x-api-key"
+
+func (s *Server) checkAuth(ctx context.Context) error {
+	if !s.apiKeyEnabled {
+		return status.Errorf(codes.PermissionDenied, "api key disabled")
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	keys := md.Get(apiKeyMetadata)
+	if len(keys) == 0 {
+		return status.Errorf(codes.Unauthenticated, "api key is missing")
+	}
+	d, err := crypto.SecureHash([]byte(keys[0]))
+	if err != nil {
+		return status.Errorf(codes.Internal, err.Error())
+	}
+	if d != s.hashedApiKey {
+		return status.Errorf(codes.PermissionDenied, "invalid api key")
+	}
+	return nil
+}
+
+// GetVoting returns the desired reward and the features the miner votes for with their activation status.
+func (s *Server) GetVoting(ctx context.Context, req *empty.Empty) (*g.MinerVotingResponse, error) {
+	if err := s.checkAuth(ctx); err != nil {
+		return nil, err
+	}
+	if s.voting == nil {
+		return nil, status.Errorf(codes.Unavailable, "miner voting is not available")
+	}
+	return s.minerVoting()
+}
+
+// SetVoting changes the votes of miner, absent votes are left unchanged. Nothing is changed if any vote is invalid.
+func (s *Server) SetVoting(ctx context.Context, req *g.SetMinerVotingRequest) (*g.MinerVotingResponse, error) {
+	if err := s.checkAuth(ctx); err != nil {
+		return nil, err
+	}
+	if s.voting == nil {
+		return nil, status.Errorf(codes.Unavailable, "miner voting is not available")
+	}
+	var reward *int64
+	if req.Reward != nil {
+		reward = &req.Reward.Value
+	}
+	var features *[]int16
+	if req.Features != nil {
+		ids := make([]int16, len(req.Features.Ids))
+		for i, id := range req.Features.Ids {
+			ids[i] = int16(id)
+		}
+		features = &ids
+	}
+	if err := s.voting.SetVotes(features, reward); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+	}
+	return s.minerVoting()
+}
+
+func (s *Server) minerVoting() (*g.MinerVotingResponse, error) {
+	votes := s.voting.Votes()
+	height, err := s.state.Height()
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, err.Error())
+	}
+	res := &g.MinerVotingResponse{
+		Reward:   votes.Reward,
+		Features: make([]*g.FeatureActivationStatus, len(votes.Features)),
+	}
+	for i, id := range votes.Features {
+		res.Features[i], err = s.featureActivationStatus(id, height)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, err.Error())
+		}
+	}
+	return res, nil
+}
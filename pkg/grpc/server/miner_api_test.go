@@ -0,0 +1,107 @@
+package server
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/golang/protobuf/ptypes/empty"
+	"github.com/golang/protobuf/ptypes/wrappers"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	g "github.com/wavesplatform/gowaves/pkg/grpc/generated/waves/node/grpc"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+type votingStub struct {
+	votes types.MinerVotes
+}
+
+func (a *votingStub) Votes() types.MinerVotes {
+	return a.votes
+}
+
+func (a *votingStub) SetReward(reward int64) error {
+	return a.SetVotes(nil, &reward)
+}
+
+func (a *votingStub) SetFeatures(features []int16) error {
+	return a.SetVotes(&features, nil)
+}
+
+func (a *votingStub) SetVotes(features *[]int16, reward *int64) error {
+	if features != nil && len(*features) > 1 {
+		return errors.New("too many features")
+	}
+	if features != nil {
+		a.votes.Features = *features
+	}
+	if reward != nil {
+		a.votes.Reward = *reward
+	}
+	return nil
+}
+
+func TestMinerVoting(t *testing.T) {
+	dataDir, err := ioutil.TempDir(os.TempDir(), "dataDir")
+	require.NoError(t, err)
+	st, err := state.NewState(dataDir, defaultStateParams(), settings.MainNetSettings)
+	require.NoError(t, err)
+	err = server.initServer(st, nil, nil)
+	require.NoError(t, err)
+	server.voting = &votingStub{votes: types.MinerVotes{Reward: 600000000, Features: []int16{14}}}
+	server.hashedApiKey, err = crypto.SecureHash([]byte("apiKey"))
+	require.NoError(t, err)
+	server.apiKeyEnabled = true
+
+	conn := connect(t, grpcTestAddr)
+	defer func() {
+		server.voting = nil
+		server.apiKeyEnabled = false
+		err := conn.Close()
+		require.NoError(t, err)
+		err = st.Close()
+		require.NoError(t, err)
+		err = os.RemoveAll(dataDir)
+		require.NoError(t, err)
+	}()
+
+	cl := g.NewMinerApiClient(conn)
+	_, err = cl.GetVoting(context.Background(), &empty.Empty{})
+	assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	wrongCtx := metadata.AppendToOutgoingContext(context.Background(), apiKeyMetadata, "wrong")
+	_, err = cl.GetVoting(wrongCtx, &empty.Empty{})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	ctx := metadata.AppendToOutgoingContext(context.Background(), apiKeyMetadata, "apiKey")
+	res, err := cl.GetVoting(ctx, &empty.Empty{})
+	require.NoError(t, err)
+	assert.Equal(t, int64(600000000), res.Reward)
+	require.Len(t, res.Features, 1)
+	assert.Equal(t, int32(14), res.Features[0].Id)
+	assert.Equal(t, g.FeatureActivationStatus_UNDEFINED, res.Features[0].BlockchainStatus)
+
+	res, err = cl.SetVoting(ctx, &g.SetMinerVotingRequest{Reward: &wrappers.Int64Value{Value: 700000000}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(700000000), res.Reward)
+	require.Len(t, res.Features, 1)
+
+	_, err = cl.SetVoting(ctx, &g.SetMinerVotingRequest{
+		Reward:   &wrappers.Int64Value{Value: 800000000},
+		Features: &g.SetMinerVotingRequest_Features{Ids: []int32{15, 17}},
+	})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	res, err = cl.SetVoting(ctx, &g.SetMinerVotingRequest{Features: &g.SetMinerVotingRequest_Features{}})
+	require.NoError(t, err)
+	assert.Equal(t, int64(700000000), res.Reward)
+	assert.Empty(t, res.Features)
+}
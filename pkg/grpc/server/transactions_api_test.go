@@ -27,7 +27,7 @@ func TestGetTransactions(t *testing.T) {
 	require.NoError(t, err)
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := createWallet(ctx, st, sets)
-	err = server.initServer(st, utxpool.New(utxSize, utxpool.NewValidator(st, ntptime.Stub{}), sets), sch)
+	err = server.initServer(st, utxpool.New(utxSize, utxpool.NewValidator(st, ntptime.Stub{}), st, sets), sch)
 	require.NoError(t, err)
 
 	conn := connect(t, grpcTestAddr)
@@ -114,7 +114,7 @@ func TestGetStatuses(t *testing.T) {
 	require.NoError(t, err)
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := createWallet(ctx, st, settings.MainNetSettings)
-	utx := utxpool.New(utxSize, utxpool.NoOpValidator{}, settings.MainNetSettings)
+	utx := utxpool.New(utxSize, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
 	err = server.initServer(st, utx, sch)
 	require.NoError(t, err)
 
@@ -178,7 +178,7 @@ func TestGetUnconfirmed(t *testing.T) {
 	require.NoError(t, err)
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := createWallet(ctx, st, settings.MainNetSettings)
-	utx := utxpool.New(utxSize, utxpool.NoOpValidator{}, settings.MainNetSettings)
+	utx := utxpool.New(utxSize, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
 	err = server.initServer(st, utx, sch)
 	require.NoError(t, err)
 
@@ -326,7 +326,7 @@ func TestBroadcast(t *testing.T) {
 	require.NoError(t, err)
 	ctx, cancel := context.WithCancel(context.Background())
 	sch := createWallet(ctx, st, settings.MainNetSettings)
-	utx := utxpool.New(utxSize, utxpool.NoOpValidator{}, settings.MainNetSettings)
+	utx := utxpool.New(utxSize, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
 	err = server.initServer(st, utx, sch)
 	require.NoError(t, err)
 
@@ -50,6 +50,28 @@ func (a *OrderedBlocks) PopAll() []*proto.Block {
 	}
 }
 
+// First returns the first block of sequence if it's already received.
+func (a *OrderedBlocks) First() (*proto.Block, bool) {
+	if len(a.sigSequence) == 0 {
+		return nil, false
+	}
+	b := a.uniqBlockIDs[a.sigSequence[0]]
+	return b, b != nil
+}
+
+// Available returns the received blocks from the beginning of sequence, they stay in the sequence.
+func (a *OrderedBlocks) Available() []*proto.Block {
+	out := make([]*proto.Block, 0, a.AvailableCount())
+	for _, sig := range a.sigSequence {
+		b := a.uniqBlockIDs[sig]
+		if b == nil {
+			break
+		}
+		out = append(out, b)
+	}
+	return out
+}
+
 // true - added, false - not added
 func (a *OrderedBlocks) Add(sig proto.BlockID) bool {
 	// already contains
@@ -54,3 +54,37 @@ func TestOrderedBlocks_AvailableCount(t *testing.T) {
 	o.PopAll()
 	require.Equal(t, 0, o.AvailableCount())
 }
+
+func TestOrderedBlocks_First(t *testing.T) {
+	o := ordered_blocks.NewOrderedBlocks()
+	_, ok := o.First()
+	require.False(t, ok)
+
+	o.Add(proto.NewBlockIDFromSignature(sig1))
+	o.Add(proto.NewBlockIDFromSignature(sig2))
+	o.SetBlock(makeBlock(sig2))
+	_, ok = o.First()
+	require.False(t, ok)
+
+	o.SetBlock(makeBlock(sig1))
+	b, ok := o.First()
+	require.True(t, ok)
+	require.Equal(t, proto.NewBlockIDFromSignature(sig1), b.BlockID())
+	require.Equal(t, 2, o.AvailableCount())
+}
+
+func TestOrderedBlocks_Available(t *testing.T) {
+	o := ordered_blocks.NewOrderedBlocks()
+	require.Empty(t, o.Available())
+
+	o.Add(proto.NewBlockIDFromSignature(sig1))
+	o.Add(proto.NewBlockIDFromSignature(sig2))
+	o.SetBlock(makeBlock(sig2))
+	require.Empty(t, o.Available())
+
+	o.SetBlock(makeBlock(sig1))
+	require.Len(t, o.Available(), 2)
+	// blocks stay in sequence
+	require.Equal(t, 2, o.AvailableCount())
+	require.Len(t, o.PopAll(), 2)
+}
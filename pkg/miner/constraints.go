@@ -1,17 +1,145 @@
 package miner
 
+import (
+	"math"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/state"
+)
+
+const (
+	maxScriptRunsInBlock          = 100
+	maxScriptsComplexityInBlock   = 1000000
+	maxScriptsComplexityInBlockV5 = 2500000
+	classicAmountOfTxsInBlock     = 100
+	maxTxsSizeInBytes             = 1 * 1024 * 1024 // 1mb
+	// every transaction in block is preceded by its length
+	transactionLengthSize = 4
+)
+
+// Names of constraints that stop packing of transactions.
+const (
+	CountConstraint      = "count"
+	SizeConstraint       = "size"
+	ComplexityConstraint = "complexity"
+	ScriptRunsConstraint = "script-runs"
+)
+
 type Constraints struct {
-	MaxScriptRunsInBlock        int
-	MaxScriptsComplexityInBlock int
-	ClassicAmountOfTxsInBlock   int
-	MaxTxsSizeInBytes           int
+	MaxScriptRunsInBlock        int `json:"max_script_runs_in_block"`
+	MaxScriptsComplexityInBlock int `json:"max_scripts_complexity_in_block"`
+	ClassicAmountOfTxsInBlock   int `json:"classic_amount_of_txs_in_block"`
+	MaxTxsSizeInBytes           int `json:"max_txs_size_in_bytes"`
 }
 
 func DefaultConstraints() Constraints {
 	return Constraints{
-		MaxScriptRunsInBlock:        100,
-		MaxScriptsComplexityInBlock: 1000000,
-		ClassicAmountOfTxsInBlock:   100,
-		MaxTxsSizeInBytes:           1 * 1024 * 1024, // 1mb
+		MaxScriptRunsInBlock:        maxScriptRunsInBlock,
+		MaxScriptsComplexityInBlock: maxScriptsComplexityInBlock,
+		ClassicAmountOfTxsInBlock:   classicAmountOfTxsInBlock,
+		MaxTxsSizeInBytes:           maxTxsSizeInBytes,
+	}
+}
+
+// NewConstraints returns the constraints for the next block according to activated features.
+// Non-zero values of mining constraints from blockchain settings override the defaults.
+func NewConstraints(info state.StateInfo) (Constraints, error) {
+	c := DefaultConstraints()
+	blockV5Activated, err := info.IsActivated(int16(settings.BlockV5))
+	if err != nil {
+		return Constraints{}, errors.Wrap(err, "failed to get mining constraints")
+	}
+	if blockV5Activated {
+		// Only total complexity of scripts is limited after BlockV5.
+		c.MaxScriptRunsInBlock = math.MaxInt32
+		c.MaxScriptsComplexityInBlock = maxScriptsComplexityInBlockV5
+	}
+	s, err := info.BlockchainSettings()
+	if err != nil {
+		return Constraints{}, errors.Wrap(err, "failed to get mining constraints")
+	}
+	c.override(s.MiningConstraints)
+	return c, nil
+}
+
+func (c *Constraints) override(o settings.MiningConstraints) {
+	if o.MaxScriptRunsInBlock > 0 {
+		c.MaxScriptRunsInBlock = o.MaxScriptRunsInBlock
+	}
+	if o.MaxScriptsComplexityInBlock > 0 {
+		c.MaxScriptsComplexityInBlock = o.MaxScriptsComplexityInBlock
+	}
+	if o.ClassicAmountOfTxsInBlock > 0 {
+		c.ClassicAmountOfTxsInBlock = o.ClassicAmountOfTxsInBlock
+	}
+	if o.MaxTxsSizeInBytes > 0 {
+		c.MaxTxsSizeInBytes = o.MaxTxsSizeInBytes
+	}
+}
+
+// miningLimits returns the limits of the new block, the block takes 4 bytes for the number of transactions.
+func (c Constraints) miningLimits() proto.MiningLimits {
+	return proto.MiningLimits{
+		MaxScriptRunsInBlock:        c.MaxScriptRunsInBlock,
+		MaxScriptsComplexityInBlock: c.MaxScriptsComplexityInBlock,
+		ClassicAmountOfTxsInBlock:   c.ClassicAmountOfTxsInBlock,
+		MaxTxsSizeInBytes:           c.MaxTxsSizeInBytes - 4,
+	}
+}
+
+// microblockPacking accounts the transactions packed into microblock against the limits left in block and the limit
+// of transactions in microblock. MicroMiner and BuildTemplate share it, so the template stops where the miner would.
+// Script runs and complexity of transaction are known only after its validation.
+type microblockPacking struct {
+	rest            proto.MiningLimits
+	maxTransactions int
+	count           int
+	size            int
+	scriptRuns      uint64
+	complexity      uint64
+}
+
+func newMicroblockPacking(rest proto.MiningLimits, maxTransactions int) *microblockPacking {
+	return &microblockPacking{rest: rest, maxTransactions: maxTransactions}
+}
+
+// fits returns the name of constraint that doesn't let the transaction of given size in, or empty string.
+func (a *microblockPacking) fits(size int) string {
+	switch {
+	case a.count >= a.maxTransactions:
+		return CountConstraint
+	case a.size+size+transactionLengthSize > a.rest.MaxTxsSizeInBytes:
+		return SizeConstraint
+	}
+	return ""
+}
+
+// fitsScripts returns the name of constraint that doesn't let the scripts of validated transaction in, or empty string.
+func (a *microblockPacking) fitsScripts(scriptRuns, complexity uint64) string {
+	switch {
+	case a.complexity+complexity > uint64(a.rest.MaxScriptsComplexityInBlock):
+		return ComplexityConstraint
+	case a.scriptRuns+scriptRuns > uint64(a.rest.MaxScriptRunsInBlock):
+		return ScriptRunsConstraint
+	}
+	return ""
+}
+
+func (a *microblockPacking) add(size int, scriptRuns, complexity uint64) {
+	a.count++
+	a.size += size + transactionLengthSize
+	a.scriptRuns += scriptRuns
+	a.complexity += complexity
+}
+
+// next returns the limits left in block for the next microblock.
+func (a *microblockPacking) next() proto.MiningLimits {
+	return proto.MiningLimits{
+		MaxScriptRunsInBlock:        a.rest.MaxScriptRunsInBlock - int(a.scriptRuns),
+		MaxScriptsComplexityInBlock: a.rest.MaxScriptsComplexityInBlock - int(a.complexity),
+		ClassicAmountOfTxsInBlock:   a.rest.ClassicAmountOfTxsInBlock,
+		MaxTxsSizeInBytes:           a.rest.MaxTxsSizeInBytes - a.size,
 	}
 }
@@ -0,0 +1,37 @@
+package miner
+
+import (
+	"math"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+)
+
+func TestNewConstraints(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	custom := *settings.DefaultCustomSettings
+	custom.MiningConstraints = settings.MiningConstraints{MaxTxsSizeInBytes: 2048}
+
+	for _, test := range []struct {
+		blockV5  bool
+		settings *settings.BlockchainSettings
+		expected Constraints
+	}{
+		{false, settings.MainNetSettings, DefaultConstraints()},
+		{true, settings.MainNetSettings, Constraints{math.MaxInt32, 2500000, 100, 1024 * 1024}},
+		{false, &custom, Constraints{100, 1000000, 100, 2048}},
+		{true, &custom, Constraints{math.MaxInt32, 2500000, 100, 2048}},
+	} {
+		info := mock.NewMockStateInfo(ctrl)
+		info.EXPECT().IsActivated(int16(settings.BlockV5)).Return(test.blockV5, nil)
+		info.EXPECT().BlockchainSettings().Return(test.settings, nil)
+		c, err := NewConstraints(info)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, c)
+	}
+}
@@ -0,0 +1,50 @@
+package miner
+
+import (
+	"context"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/types"
+	"go.uber.org/zap"
+)
+
+// How often UTX is checked for new transactions in dev mining mode.
+const devPoolCheckInterval = 200 * time.Millisecond
+
+type onDemandMiner interface {
+	MineNow() error
+}
+
+// RunDevMining makes the dev scheduler generate blocks on the fixed interval, if it's not zero,
+// and every time transactions appear in the empty pool. Transactions are packed in microblocks after the block.
+func RunDevMining(ctx context.Context, s onDemandMiner, utx types.UtxPool, interval time.Duration) {
+	check := time.NewTicker(devPoolCheckInterval)
+	defer check.Stop()
+	var tick <-chan time.Time
+	if interval > 0 {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+	empty := true
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+			mineNow(s)
+		case <-check.C:
+			wasEmpty := empty
+			empty = utx.Count() == 0
+			if wasEmpty && !empty {
+				mineNow(s)
+			}
+		}
+	}
+}
+
+func mineNow(s onDemandMiner) {
+	if err := s.MineNow(); err != nil {
+		zap.S().Debugf("Dev mining: %v", err)
+	}
+}
@@ -0,0 +1,58 @@
+package miner
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/util/byte_helpers"
+)
+
+type countingMiner int32
+
+func (a *countingMiner) MineNow() error {
+	atomic.AddInt32((*int32)(a), 1)
+	return nil
+}
+
+func (a *countingMiner) count() int32 {
+	return atomic.LoadInt32((*int32)(a))
+}
+
+func TestRunDevMining(t *testing.T) {
+	utx := utxpool.New(10000, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
+	m := new(countingMiner)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go RunDevMining(ctx, m, utx, 0)
+
+	time.Sleep(3 * devPoolCheckInterval)
+	require.EqualValues(t, 0, m.count())
+
+	// block is generated once transactions appear in the pool
+	require.NoError(t, utx.AddWithBytes(byte_helpers.TransferWithSig.Transaction, byte_helpers.TransferWithSig.TransactionBytes))
+	require.Eventually(t, func() bool { return m.count() == 1 }, time.Second, 10*time.Millisecond)
+	require.NoError(t, utx.AddWithBytes(byte_helpers.BurnWithSig.Transaction, byte_helpers.BurnWithSig.TransactionBytes))
+	time.Sleep(3 * devPoolCheckInterval)
+	require.EqualValues(t, 1, m.count())
+
+	// and again after the pool was emptied
+	for utx.Pop() != nil {
+	}
+	time.Sleep(3 * devPoolCheckInterval)
+	require.NoError(t, utx.AddWithBytes(byte_helpers.TransferWithSig.Transaction, byte_helpers.TransferWithSig.TransactionBytes))
+	require.Eventually(t, func() bool { return m.count() == 2 }, time.Second, 10*time.Millisecond)
+}
+
+func TestRunDevMining_Interval(t *testing.T) {
+	utx := utxpool.New(10000, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
+	m := new(countingMiner)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go RunDevMining(ctx, m, utx, 50*time.Millisecond)
+	require.Eventually(t, func() bool { return m.count() >= 3 }, time.Second, 10*time.Millisecond)
+}
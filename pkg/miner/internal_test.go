@@ -7,6 +7,8 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
+	"github.com/wavesplatform/gowaves/pkg/wallet"
 )
 
 func TestMineBlock(t *testing.T) {
@@ -14,11 +16,13 @@ func TestMineBlock(t *testing.T) {
 		BaseTarget:   5767,
 		GenSignature: crypto.MustDigestFromBase58("EijBTmUp8j1VRm8542zBii1BdYHvZ26iDk1hLup8kZTP").Bytes(),
 	}
+	w := wallet.NewWallet()
+	require.NoError(t, w.AddSeed([]byte("abc")))
 	kp, err := proto.NewKeyPair([]byte("abc"))
 	require.NoError(t, err)
 	parentSig := crypto.MustSignatureFromBase58("4f6Nkihj7j3t2ohNPk69MUZzpdHHwXG9hM2qjgeRmKmDPFiRYeedv6ewc9dhvNo1BxvE5CTgTjTTyAYPfR42eBXP")
 	parent := proto.NewBlockIDFromSignature(parentSig)
-	b, err := MineBlock(4, nxt, kp, []settings.Feature{13, 14}, 1581610238465, parent, 600000000, proto.MainNetScheme)
+	b, err := MineBlock(4, nxt, kp.Public, signer.NewLocalSigner(w, proto.MainNetScheme), []settings.Feature{13, 14}, 1581610238465, parent, 600000000, proto.MainNetScheme)
 	require.NoError(t, err)
 
 	bts, err := b.MarshalBinary()
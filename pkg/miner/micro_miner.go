@@ -2,9 +2,13 @@ package miner
 
 import (
 	"errors"
+	"time"
 
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/types"
 	"go.uber.org/zap"
@@ -13,24 +17,55 @@ import (
 var NoTransactionsErr = errors.New("no transactions")
 var StateChangedErr = errors.New("state changed")
 
+// number of transactions taken from UTX to choose from for one microblock, relative to max transactions in it
+const packingCandidatesFactor = 4
+
 type MicroMiner struct {
-	state  state.State
-	utx    types.UtxPool
-	scheme proto.Scheme
+	state    state.State
+	utx      types.UtxPool
+	scheme   proto.Scheme
+	strategy types.PackingStrategy
+	signer   signer.Signer
+	settings microblock.Settings
+	stats    *microblock.Stats
 }
 
 func NewMicroMiner(services services.Services) *MicroMiner {
+	strategy := services.PackingStrategy
+	if strategy == nil {
+		strategy = FeeGreedyStrategy{}
+	}
+	conf := services.MicroblockSettings
+	if conf == (microblock.Settings{}) {
+		conf = microblock.DefaultSettings
+	}
+	stats := services.MicroblockStats
+	if stats == nil {
+		stats = microblock.NewStats()
+	}
 	return &MicroMiner{
-		state:  services.State,
-		utx:    services.UtxPool,
-		scheme: services.Scheme,
+		state:    services.State,
+		utx:      services.UtxPool,
+		scheme:   services.Scheme,
+		strategy: strategy,
+		signer:   services.Signer,
+		settings: conf,
+		stats:    stats,
 	}
 }
 
+func (a *MicroMiner) Settings() microblock.Settings {
+	return a.settings
+}
+
+func (a *MicroMiner) Stats() *microblock.Stats {
+	return a.stats
+}
+
 func (a *MicroMiner) Micro(
 	minedBlock *proto.Block,
 	rest proto.MiningLimits,
-	keyPair proto.KeyPair,
+	pk crypto.PublicKey,
 	vrf []byte) (*proto.Block, *proto.MicroBlock, proto.MiningLimits, error) {
 
 	// way to stop mine microblocks
@@ -60,22 +95,27 @@ func (a *MicroMiner) Micro(
 
 	//
 	transactions := make([]proto.Transaction, 0)
-	cnt := 0
-	binSize := 0
-
-	var unAppliedTransactions []*types.TransactionWithBytes
+	received := make([]time.Time, 0)
+	packing := newMicroblockPacking(rest, a.settings.MaxTransactions)
+
+	popped := make([]*types.TransactionWithBytes, 0)
+	for i := 0; i < packingCandidatesFactor*a.settings.MaxTransactions; i++ {
+		t := a.utx.Pop()
+		if t == nil {
+			break
+		}
+		popped = append(popped, t)
+	}
+	// strategy can reorder the given slice in place
+	candidates := a.strategy.Order(append([]*types.TransactionWithBytes(nil), popped...))
+	applied := make(map[*types.TransactionWithBytes]bool)
+	deferred := make(deferredTransactions)
 
 	_ = a.state.Map(func(s state.NonThreadSafeState) error {
-		// 255 is max transactions count in microblock
-		for i := 0; i < 255; i++ {
-			t := a.utx.Pop()
-			if t == nil {
-				break
-			}
-			binTr := t.B
-			transactionLenBytes := 4
-			if binSize+len(binTr)+transactionLenBytes > rest.MaxTxsSizeInBytes {
-				unAppliedTransactions = append(unAppliedTransactions, t)
+		for len(candidates) > 0 {
+			t := candidates[0]
+			candidates = candidates[1:]
+			if packing.fits(len(t.B)) != "" {
 				continue
 			}
 
@@ -83,26 +123,36 @@ func (a *MicroMiner) Micro(
 			// We should always check all scripts here, even after
 			// activation of accepting transactions with failed scripts.
 			checkScripts := true
+			runs, complexity := s.ScriptsUsage()
 			err = s.ValidateNextTx(t.T, minedBlock.Timestamp, parentTimestamp, minedBlock.Version, checkScripts)
 			if err != nil {
-				unAppliedTransactions = append(unAppliedTransactions, t)
+				deferred.add(t)
 				continue
 			}
+			newRuns, newComplexity := s.ScriptsUsage()
+			if packing.fitsScripts(newRuns-runs, newComplexity-complexity) != "" {
+				// the transaction is validated already, so the following ones would be validated on top of it
+				break
+			}
 
-			cnt += 1
-			binSize += len(binTr) + transactionLenBytes
+			packing.add(len(t.B), newRuns-runs, newComplexity-complexity)
 			transactions = append(transactions, t.T)
+			received = append(received, t.Received)
+			applied[t] = true
+			candidates = append(deferred.release(t.T.GetSenderPK()), candidates...)
 		}
 		return nil
 	})
 
-	// return unapplied transactions
-	for _, unapplied := range unAppliedTransactions {
-		_ = a.utx.AddWithBytes(unapplied.T, unapplied.B)
+	// return unapplied transactions in order they were popped, so the dependent ones are added after their dependencies
+	for _, t := range popped {
+		if !applied[t] {
+			_ = a.utx.Return(t)
+		}
 	}
 
 	// no transactions applied, skip
-	if cnt == 0 {
+	if packing.count == 0 {
 		return nil, nil, rest, NoTransactionsErr
 	}
 
@@ -124,13 +174,11 @@ func (a *MicroMiner) Micro(
 	if err != nil {
 		return nil, nil, rest, err
 	}
-	sk := keyPair.Secret
-
 	err = newBlock.SetTransactionsRootIfPossible(a.scheme)
 	if err != nil {
 		return nil, nil, rest, err
 	}
-	err = newBlock.Sign(a.scheme, keyPair.Secret)
+	err = a.signer.SignBlock(pk, newBlock)
 	if err != nil {
 		return nil, nil, rest, err
 	}
@@ -140,26 +188,21 @@ func (a *MicroMiner) Micro(
 	}
 	micro := proto.MicroBlock{
 		VersionField:          byte(newBlock.Version),
-		SenderPK:              keyPair.Public,
+		SenderPK:              pk,
 		Transactions:          transactions,
-		TransactionCount:      uint32(cnt),
+		TransactionCount:      uint32(packing.count),
 		Reference:             a.state.TopBlock().BlockID(),
 		TotalResBlockSigField: newBlock.BlockSignature,
 		TotalBlockID:          newBlock.BlockID(),
 	}
 
-	err = micro.Sign(sk)
+	err = a.signer.SignMicroBlock(pk, &micro)
 	if err != nil {
 		return nil, nil, rest, err
 	}
 
 	zap.S().Debugf("micro_miner mined %+v", micro)
+	a.stats.Microblock(micro.TotalBlockID, received, time.Now())
 
-	newRest := proto.MiningLimits{
-		MaxScriptRunsInBlock:        rest.MaxScriptRunsInBlock,
-		MaxScriptsComplexityInBlock: rest.MaxScriptsComplexityInBlock,
-		ClassicAmountOfTxsInBlock:   rest.ClassicAmountOfTxsInBlock,
-		MaxTxsSizeInBytes:           rest.MaxTxsSizeInBytes - binSize,
-	}
-	return newBlock, &micro, newRest, nil
+	return newBlock, &micro, packing.next(), nil
 }
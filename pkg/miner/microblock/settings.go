@@ -0,0 +1,38 @@
+package microblock
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// MaxTransactions is the max number of transactions in one microblock allowed by protocol.
+const MaxTransactions = 255
+
+type Settings struct {
+	// Delay between the key block and the first microblock on top of it.
+	MinDelay time.Duration
+	// Interval between microblocks, also the delay before the next try if there were no transactions to mine.
+	Interval time.Duration
+	// Max number of transactions in one microblock.
+	MaxTransactions int
+}
+
+var DefaultSettings = Settings{
+	MinDelay:        5 * time.Second,
+	Interval:        5 * time.Second,
+	MaxTransactions: MaxTransactions,
+}
+
+func (a Settings) Validate() error {
+	if a.MinDelay < 0 {
+		return errors.Errorf("invalid microblock delay %s", a.MinDelay)
+	}
+	if a.Interval <= 0 {
+		return errors.Errorf("invalid microblock interval %s", a.Interval)
+	}
+	if a.MaxTransactions < 1 || a.MaxTransactions > MaxTransactions {
+		return errors.Errorf("invalid max transactions in microblock %d, should be from 1 to %d", a.MaxTransactions, MaxTransactions)
+	}
+	return nil
+}
@@ -0,0 +1,118 @@
+package microblock
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+// KeyBlockStatistics describes the microblocks mined on top of one key block.
+type KeyBlockStatistics struct {
+	ID           proto.BlockID `json:"id"`
+	Microblocks  uint64        `json:"microblocks"`
+	Transactions uint64        `json:"transactions"`
+}
+
+// Statistics holds the counters of microblocks mined by node since the start.
+type Statistics struct {
+	KeyBlocks    uint64 `json:"key_blocks"`
+	Microblocks  uint64 `json:"microblocks"`
+	Transactions uint64 `json:"transactions"`
+	// Average number of microblocks mined on top of one key block
+	MicroblocksPerKeyBlock float64 `json:"microblocks_per_key_block"`
+	// Time between adding transaction to UTX and including it in microblock, in milliseconds
+	AvgLatency uint64 `json:"avg_latency"`
+	MaxLatency uint64 `json:"max_latency"`
+	// Microblocks not included by peers in the blocks they built on top of the node's key block
+	Rejected uint64 `json:"rejected"`
+	// Last mined key block, nil if nothing was mined yet
+	Current *KeyBlockStatistics `json:"current"`
+}
+
+// Stats collects the statistics of microblock mining. It's safe for concurrent use.
+type Stats struct {
+	mu           sync.Mutex
+	stats        Statistics
+	latencies    uint64
+	totalLatency time.Duration
+	maxLatency   time.Duration
+	// total block IDs of microblocks mined on top of current key block in order of mining
+	micro []proto.BlockID
+}
+
+func NewStats() *Stats {
+	return &Stats{}
+}
+
+// KeyBlock starts the counting of microblocks on top of the new key block mined by node.
+func (a *Stats) KeyBlock(id proto.BlockID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.stats.KeyBlocks++
+	a.stats.Current = &KeyBlockStatistics{ID: id}
+	a.micro = nil
+}
+
+// Microblock counts the mined microblock, received are the times its transactions were added to UTX.
+func (a *Stats) Microblock(totalID proto.BlockID, received []time.Time, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.stats.Current == nil {
+		return
+	}
+	a.stats.Microblocks++
+	a.stats.Transactions += uint64(len(received))
+	a.stats.Current.Microblocks++
+	a.stats.Current.Transactions += uint64(len(received))
+	a.micro = append(a.micro, totalID)
+	for _, r := range received {
+		if r.IsZero() {
+			continue
+		}
+		l := now.Sub(r)
+		a.latencies++
+		a.totalLatency += l
+		if l > a.maxLatency {
+			a.maxLatency = l
+		}
+	}
+}
+
+// Block checks the key block received from peer. The microblocks of node mined after the parent of the block
+// were not included by the peer and are counted as rejected.
+func (a *Stats) Block(parent proto.BlockID) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.stats.Current == nil || len(a.micro) == 0 {
+		return
+	}
+	rejected := len(a.micro)
+	for i, id := range a.micro {
+		if id == parent {
+			rejected = len(a.micro) - i - 1
+			break
+		}
+	}
+	a.stats.Rejected += uint64(rejected)
+	// the next block will be built on top of the received one
+	a.micro = nil
+}
+
+func (a *Stats) Statistics() Statistics {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	rs := a.stats
+	if rs.Current != nil {
+		current := *rs.Current
+		rs.Current = &current
+	}
+	if rs.KeyBlocks > 0 {
+		rs.MicroblocksPerKeyBlock = float64(rs.Microblocks) / float64(rs.KeyBlocks)
+	}
+	if a.latencies > 0 {
+		rs.AvgLatency = uint64(a.totalLatency / time.Duration(a.latencies) / time.Millisecond)
+	}
+	rs.MaxLatency = uint64(a.maxLatency / time.Millisecond)
+	return rs
+}
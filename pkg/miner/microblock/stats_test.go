@@ -0,0 +1,53 @@
+package microblock
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func blockID(b byte) proto.BlockID {
+	return proto.NewBlockIDFromDigest(crypto.Digest{b})
+}
+
+func TestStats(t *testing.T) {
+	s := NewStats()
+	now := time.Now()
+	// microblocks without key block are not counted
+	s.Microblock(blockID(1), []time.Time{now}, now)
+	require.Equal(t, Statistics{}, s.Statistics())
+
+	s.KeyBlock(blockID(1))
+	s.Microblock(blockID(2), []time.Time{now.Add(-time.Second), now.Add(-3 * time.Second)}, now)
+	s.Microblock(blockID(3), []time.Time{now.Add(-2 * time.Second)}, now)
+	s.Microblock(blockID(4), []time.Time{{}}, now)
+	// the peer has built its block on top of the first microblock
+	s.Block(blockID(2))
+
+	rs := s.Statistics()
+	require.EqualValues(t, 1, rs.KeyBlocks)
+	require.EqualValues(t, 3, rs.Microblocks)
+	require.EqualValues(t, 4, rs.Transactions)
+	require.EqualValues(t, 3, rs.MicroblocksPerKeyBlock)
+	require.EqualValues(t, 2000, rs.AvgLatency)
+	require.EqualValues(t, 3000, rs.MaxLatency)
+	require.EqualValues(t, 2, rs.Rejected)
+	require.Equal(t, &KeyBlockStatistics{ID: blockID(1), Microblocks: 3, Transactions: 4}, rs.Current)
+
+	s.KeyBlock(blockID(5))
+	s.Microblock(blockID(6), nil, now)
+	// the peer has built its block on top of the key block
+	s.Block(blockID(5))
+	rs = s.Statistics()
+	require.EqualValues(t, 3, rs.Rejected)
+	require.EqualValues(t, 2, rs.MicroblocksPerKeyBlock)
+}
+
+func TestSettingsValidate(t *testing.T) {
+	require.NoError(t, DefaultSettings.Validate())
+	require.Error(t, Settings{Interval: time.Second, MaxTransactions: 256}.Validate())
+	require.Error(t, Settings{MaxTransactions: 100}.Validate())
+}
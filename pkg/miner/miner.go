@@ -3,56 +3,66 @@ package miner
 import (
 	"context"
 
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/miner/scheduler"
 	"github.com/wavesplatform/gowaves/pkg/node/messages"
 	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
 	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/types"
 	"go.uber.org/zap"
 )
 
 type MicroblockMiner struct {
-	utx         types.UtxPool
-	state       state.State
-	peer        peer_manager.PeerManager
-	constraints Constraints
-	services    services.Services
-	features    Features
-	// reward vote 600000000
-	reward int64
+	utx      types.UtxPool
+	state    state.State
+	peer     peer_manager.PeerManager
+	services services.Services
+	signer   signer.Signer
+	// desired reward and features to vote for, can be changed at runtime
+	voting types.MinerVoting
 }
 
-func NewMicroblockMiner(services services.Services, features Features, reward int64) *MicroblockMiner {
+func NewMicroblockMiner(services services.Services) *MicroblockMiner {
 	return &MicroblockMiner{
-		utx:         services.UtxPool,
-		state:       services.State,
-		peer:        services.Peers,
-		constraints: DefaultConstraints(),
-		services:    services,
-		features:    features,
-		reward:      reward,
+		utx:      services.UtxPool,
+		state:    services.State,
+		peer:     services.Peers,
+		services: services,
+		signer:   services.Signer,
+		voting:   services.Voting,
 	}
 }
 
-func (a *MicroblockMiner) MineKeyBlock(ctx context.Context, t proto.Timestamp, k proto.KeyPair, parent proto.BlockID, baseTarget types.BaseTarget, gs []byte, vrf []byte) (*proto.Block, proto.MiningLimits, error) {
+func (a *MicroblockMiner) MineKeyBlock(ctx context.Context, t proto.Timestamp, pk crypto.PublicKey, parent proto.BlockID, baseTarget types.BaseTarget, gs []byte, vrf []byte) (*proto.Block, proto.MiningLimits, error) {
 	nxt := proto.NxtConsensus{
 		BaseTarget:   baseTarget,
 		GenSignature: gs,
 	}
 
+	votes := a.voting.Votes()
+	features := make(Features, len(votes.Features))
+	for i, f := range votes.Features {
+		features[i] = settings.Feature(f)
+	}
+	var constraints Constraints
 	bi, err := a.state.MapR(func(info state.StateInfo) (interface{}, error) {
 		v, err := blockVersion(info)
 		if err != nil {
 			return nil, err
 		}
-		validatedFeatured, err := ValidateFeatures(info, a.features)
+		validatedFeatured, err := ValidateFeatures(info, features)
 		if err != nil {
 			return nil, err
 		}
-		b, err := MineBlock(v, nxt, k, validatedFeatured, t, parent, a.reward, a.services.Scheme)
+		constraints, err = NewConstraints(info)
+		if err != nil {
+			return nil, err
+		}
+		b, err := MineBlock(v, nxt, pk, a.signer, validatedFeatured, t, parent, votes.Reward, a.services.Scheme)
 		if err != nil {
 			return nil, err
 		}
@@ -63,13 +73,7 @@ func (a *MicroblockMiner) MineKeyBlock(ctx context.Context, t proto.Timestamp, k
 	}
 	b := bi.(*proto.Block)
 
-	rest := proto.MiningLimits{
-		MaxScriptRunsInBlock:        a.constraints.MaxScriptRunsInBlock,
-		MaxScriptsComplexityInBlock: a.constraints.MaxScriptsComplexityInBlock,
-		ClassicAmountOfTxsInBlock:   a.constraints.ClassicAmountOfTxsInBlock,
-		MaxTxsSizeInBytes:           a.constraints.MaxTxsSizeInBytes - 4,
-	}
-	return b, rest, nil
+	return b, constraints.miningLimits(), nil
 }
 
 func blockVersion(state state.StateInfo) (proto.BlockVersion, error) {
@@ -100,12 +104,12 @@ func Run(ctx context.Context, a types.Miner, s *scheduler.SchedulerImpl, interna
 		case <-ctx.Done():
 			return
 		case v := <-s.Mine():
-			block, limits, err := a.MineKeyBlock(ctx, v.Timestamp, v.KeyPair, v.Parent, v.BaseTarget, v.GenSignature, v.VRF)
+			block, limits, err := a.MineKeyBlock(ctx, v.Timestamp, v.PublicKey, v.Parent, v.BaseTarget, v.GenSignature, v.VRF)
 			if err != nil {
 				zap.S().Error(err)
 				continue
 			}
-			internalCh <- messages.NewMinedBlockInternalMessage(block, limits, v.KeyPair, v.VRF)
+			internalCh <- messages.NewMinedBlockInternalMessage(block, limits, v.PublicKey, v.VRF)
 		}
 	}
 }
@@ -0,0 +1,94 @@
+package miner
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/types"
+)
+
+// Names of packing strategies.
+const (
+	FeeGreedyPacking  = "fee"
+	FIFOPacking       = "fifo"
+	SenderFairPacking = "sender-fair"
+)
+
+func NewPackingStrategy(name string) (types.PackingStrategy, error) {
+	switch name {
+	case FeeGreedyPacking:
+		return FeeGreedyStrategy{}, nil
+	case FIFOPacking:
+		return FIFOStrategy{}, nil
+	case SenderFairPacking:
+		return SenderFairStrategy{}, nil
+	default:
+		return nil, errors.Errorf("unknown packing strategy '%s'", name)
+	}
+}
+
+// FeeGreedyStrategy packs transactions with the highest fee per byte first, it keeps the order of UTX.
+type FeeGreedyStrategy struct {
+}
+
+func (FeeGreedyStrategy) Order(transactions []*types.TransactionWithBytes) []*types.TransactionWithBytes {
+	return transactions
+}
+
+// FIFOStrategy packs older transactions first.
+type FIFOStrategy struct {
+}
+
+func (FIFOStrategy) Order(transactions []*types.TransactionWithBytes) []*types.TransactionWithBytes {
+	sort.SliceStable(transactions, func(i, j int) bool {
+		return transactions[i].T.GetTimestamp() < transactions[j].T.GetTimestamp()
+	})
+	return transactions
+}
+
+// SenderFairStrategy takes one transaction of every sender in turn, so a single account can't fill the block.
+// Senders are served in order of their most profitable transactions,
+// transactions of one sender are packed from older to newer.
+type SenderFairStrategy struct {
+}
+
+func (SenderFairStrategy) Order(transactions []*types.TransactionWithBytes) []*types.TransactionWithBytes {
+	var senders []crypto.PublicKey
+	bySender := make(map[crypto.PublicKey][]*types.TransactionWithBytes)
+	for _, t := range transactions {
+		pk := t.T.GetSenderPK()
+		if _, ok := bySender[pk]; !ok {
+			senders = append(senders, pk)
+		}
+		bySender[pk] = append(bySender[pk], t)
+	}
+	for _, pk := range senders {
+		FIFOStrategy{}.Order(bySender[pk])
+	}
+	res := make([]*types.TransactionWithBytes, 0, len(transactions))
+	for round := 0; len(res) < len(transactions); round++ {
+		for _, pk := range senders {
+			if txs := bySender[pk]; round < len(txs) {
+				res = append(res, txs[round])
+			}
+		}
+	}
+	return res
+}
+
+// deferredTransactions holds the transactions that failed validation until another transaction of the same sender
+// is packed. Strategy could order a transaction before the one of the same sender it depends on, so it's tried again.
+type deferredTransactions map[crypto.PublicKey][]*types.TransactionWithBytes
+
+func (a deferredTransactions) add(t *types.TransactionWithBytes) {
+	pk := t.T.GetSenderPK()
+	a[pk] = append(a[pk], t)
+}
+
+// release returns the deferred transactions of the sender to try them again.
+func (a deferredTransactions) release(pk crypto.PublicKey) []*types.TransactionWithBytes {
+	rs := a[pk]
+	delete(a, pk)
+	return rs
+}
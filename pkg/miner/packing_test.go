@@ -0,0 +1,52 @@
+package miner
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/types"
+)
+
+func packingTx(t *testing.T, seed string, timestamp uint64) *types.TransactionWithBytes {
+	_, pk, err := crypto.GenerateKeyPair([]byte(seed))
+	require.NoError(t, err)
+	tx := proto.NewUnsignedTransferWithSig(pk, proto.OptionalAsset{}, proto.OptionalAsset{}, timestamp, 1, 100000, proto.NewRecipientFromAddress(proto.Address{}), &proto.LegacyAttachment{})
+	return &types.TransactionWithBytes{T: tx}
+}
+
+func timestamps(txs []*types.TransactionWithBytes) []uint64 {
+	r := make([]uint64, len(txs))
+	for i, t := range txs {
+		r[i] = t.T.GetTimestamp()
+	}
+	return r
+}
+
+func TestPackingStrategies(t *testing.T) {
+	// transactions in order of UTX, the highest fee first
+	txs := func() []*types.TransactionWithBytes {
+		return []*types.TransactionWithBytes{
+			packingTx(t, "a", 3),
+			packingTx(t, "a", 1),
+			packingTx(t, "b", 5),
+			packingTx(t, "a", 2),
+			packingTx(t, "c", 4),
+		}
+	}
+	for _, test := range []struct {
+		name     string
+		expected []uint64
+	}{
+		{FeeGreedyPacking, []uint64{3, 1, 5, 2, 4}},
+		{FIFOPacking, []uint64{1, 2, 3, 4, 5}},
+		{SenderFairPacking, []uint64{1, 5, 4, 2, 3}},
+	} {
+		s, err := NewPackingStrategy(test.name)
+		require.NoError(t, err)
+		require.Equal(t, test.expected, timestamps(s.Order(txs())), test.name)
+	}
+	_, err := NewPackingStrategy("random")
+	require.Error(t, err)
+}
@@ -6,8 +6,10 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/wavesplatform/gowaves/pkg/consensus"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/types"
 	"github.com/wavesplatform/gowaves/pkg/util/cancellable"
@@ -17,15 +19,19 @@ import (
 
 type Emit struct {
 	Timestamp    uint64
-	KeyPair      proto.KeyPair
+	PublicKey    crypto.PublicKey
 	GenSignature []byte
 	VRF          []byte
 	BaseTarget   types.BaseTarget
 	Parent       proto.BlockID
+	// Values the generation time was calculated from.
+	Hit               *consensus.Hit
+	ParentTarget      types.BaseTarget
+	GeneratingBalance uint64
 }
 
 type SchedulerImpl struct {
-	seeder        seeder
+	signer        signer.Signer
 	mine          chan Emit
 	cancel        []func()
 	settings      *settings.BlockchainSettings
@@ -36,27 +42,42 @@ type SchedulerImpl struct {
 	tm            types.Time
 	consensus     types.MinerConsensus
 	outdatePeriod proto.Timestamp
+	// In dev mode blocks are generated only on demand by MineNow.
+	dev bool
 }
 
 type internal interface {
-	schedule(state state.StateInfo, keyPairs []proto.KeyPair, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error)
+	schedule(state state.StateInfo, pks []crypto.PublicKey, signer signer.Signer, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error)
 }
 
 type internalImpl struct {
+	// If set, blocks are generated right now instead of the time given by consensus, it's used for dev mining.
+	instant types.Time
 }
 
-func (a internalImpl) schedule(storage state.StateInfo, keyPairs []proto.KeyPair, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
+func (a internalImpl) timestamp(parent proto.Timestamp, delay uint64) proto.Timestamp {
+	if a.instant == nil {
+		return parent + delay
+	}
+	now := proto.NewTimestampFromTime(a.instant.Now())
+	if now <= parent {
+		return parent + 1
+	}
+	return now
+}
+
+func (a internalImpl) schedule(storage state.StateInfo, pks []crypto.PublicKey, signer signer.Signer, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
 	vrfActivated, err := storage.IsActivated(int16(settings.BlockV5))
 	if err != nil {
 		return nil, errors.Wrap(err, "failed get vrfActivated")
 	}
 	if vrfActivated {
-		return a.scheduleWithVrf(storage, keyPairs, schema, AverageBlockDelaySeconds, confirmedBlock, confirmedBlockHeight)
+		return a.scheduleWithVrf(storage, pks, signer, schema, AverageBlockDelaySeconds, confirmedBlock, confirmedBlockHeight)
 	}
-	return a.scheduleWithoutVrf(storage, keyPairs, schema, AverageBlockDelaySeconds, confirmedBlock, confirmedBlockHeight)
+	return a.scheduleWithoutVrf(storage, pks, signer, schema, AverageBlockDelaySeconds, confirmedBlock, confirmedBlockHeight)
 }
 
-func (a internalImpl) scheduleWithVrf(storage state.StateInfo, keyPairs []proto.KeyPair, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
+func (a internalImpl) scheduleWithVrf(storage state.StateInfo, pks []crypto.PublicKey, signer signer.Signer, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
 	var greatGrandParentTimestamp proto.Timestamp = 0
 	if confirmedBlockHeight > 2 {
 		greatGrandParent, err := storage.BlockByHeight(confirmedBlockHeight - 2)
@@ -93,21 +114,30 @@ func (a internalImpl) scheduleWithVrf(storage state.StateInfo, keyPairs []proto.
 	zap.S().Debugf("Scheduler: topBlock: id %s, gensig: %s, topBlockHeight: %d", confirmedBlock.BlockID().String(), confirmedBlock.GenSignature, confirmedBlockHeight)
 
 	var out []Emit
-	for _, keyPair := range keyPairs {
-		key := keyPair.Secret
+	for _, pk := range pks {
 		HitSourceAtHeight, err := storage.HitSourceAtHeight(heightForHit)
 		if err != nil {
 			zap.S().Error("scheduler, internalImpl", err)
 			continue
 		}
-		genSig, err := gsp.GenerationSignature(key, HitSourceAtHeight)
+		var genSig []byte
+		if blockV5Activated {
+			// VRF proof requires secret key, so it is calculated by signer
+			genSig, err = signer.GenerationSignature(pk, HitSourceAtHeight)
+		} else {
+			genSig, err = gsp.GenerationSignature(pk, HitSourceAtHeight)
+		}
 		if err != nil {
-			zap.S().Error("Scheduler: Failed to schedule mining: %v", err)
+			zap.S().Errorf("Scheduler: Failed to schedule mining: %v", err)
 			continue
 		}
-		source, err := gsp.HitSource(key, HitSourceAtHeight)
+		ok, source, err := gsp.VerifyGenerationSignature(pk, HitSourceAtHeight, genSig)
 		if err != nil {
-			zap.S().Error("Scheduler: Failed to schedule mining: %v", err)
+			zap.S().Errorf("Scheduler: Failed to schedule mining: %v", err)
+			continue
+		}
+		if !ok {
+			zap.S().Errorf("Scheduler: Failed to schedule mining: invalid generation signature for key %s", pk.String())
 			continue
 		}
 		var vrf []byte = nil
@@ -120,7 +150,7 @@ func (a internalImpl) scheduleWithVrf(storage state.StateInfo, keyPairs []proto.
 			continue
 		}
 
-		addr, err := keyPair.Addr(schema)
+		addr, err := proto.NewAddressFromPublicKey(schema, pk)
 		if err != nil {
 			zap.S().Error("Scheduler: Failed to schedule mining: %v", err)
 			continue
@@ -141,25 +171,30 @@ func (a internalImpl) scheduleWithVrf(storage state.StateInfo, keyPairs []proto.
 			continue
 		}
 
-		baseTarget, err := pos.CalculateBaseTarget(AverageBlockDelaySeconds, confirmedBlockHeight, confirmedBlock.BlockHeader.BaseTarget, confirmedBlock.Timestamp, greatGrandParentTimestamp, delay+confirmedBlock.Timestamp)
+		ts := a.timestamp(confirmedBlock.Timestamp, delay)
+		baseTarget, err := pos.CalculateBaseTarget(AverageBlockDelaySeconds, confirmedBlockHeight, confirmedBlock.BlockHeader.BaseTarget, confirmedBlock.Timestamp, greatGrandParentTimestamp, ts)
 		if err != nil {
 			zap.S().Error("Scheduler: Failed to schedule mining: %v", err)
 			continue
 		}
 
 		out = append(out, Emit{
-			Timestamp:    confirmedBlock.Timestamp + delay,
-			KeyPair:      keyPair,
+			Timestamp:    ts,
+			PublicKey:    pk,
 			GenSignature: genSig,
 			VRF:          vrf,
 			BaseTarget:   baseTarget,
 			Parent:       confirmedBlock.BlockID(),
+
+			Hit:               hit,
+			ParentTarget:      confirmedBlock.BlockHeader.BaseTarget,
+			GeneratingBalance: effectiveBalance,
 		})
 	}
 	return out, nil
 }
 
-func (a internalImpl) scheduleWithoutVrf(storage state.StateInfo, keyPairs []proto.KeyPair, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
+func (a internalImpl) scheduleWithoutVrf(storage state.StateInfo, pks []crypto.PublicKey, signer signer.Signer, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
 	var greatGrandParentTimestamp proto.Timestamp = 0
 	if confirmedBlockHeight > 2 {
 		greatGrandParent, err := storage.BlockByHeight(confirmedBlockHeight - 2)
@@ -196,14 +231,14 @@ func (a internalImpl) scheduleWithoutVrf(storage state.StateInfo, keyPairs []pro
 	zap.S().Infof("Scheduler: topBlock: id %s, gensig: %s, topBlockHeight: %d", confirmedBlock.BlockID().String(), confirmedBlock.GenSignature, confirmedBlockHeight)
 
 	var out []Emit
-	for _, keyPair := range keyPairs {
+	for _, pk := range pks {
 		genSigBlock := confirmedBlock.BlockHeader
-		genSig, err := gsp.GenerationSignature(keyPair.Public, genSigBlock.GenSignature)
+		genSig, err := gsp.GenerationSignature(pk, genSigBlock.GenSignature)
 		if err != nil {
 			zap.S().Error("scheduler, internalImpl", err)
 			continue
 		}
-		source, err := gsp.HitSource(keyPair.Public, hitSourceHeader.GenSignature)
+		source, err := gsp.HitSource(pk, hitSourceHeader.GenSignature)
 		if err != nil {
 			zap.S().Error("scheduler, internalImpl HitSource", err)
 			continue
@@ -215,9 +250,9 @@ func (a internalImpl) scheduleWithoutVrf(storage state.StateInfo, keyPairs []pro
 			continue
 		}
 
-		addr, err := keyPair.Addr(schema)
+		addr, err := proto.NewAddressFromPublicKey(schema, pk)
 		if err != nil {
-			zap.S().Error("scheduler, internalImpl keyPair.Addr", err)
+			zap.S().Error("scheduler, internalImpl NewAddressFromPublicKey", err)
 			continue
 		}
 		var startHeight proto.Height = 1
@@ -236,38 +271,41 @@ func (a internalImpl) scheduleWithoutVrf(storage state.StateInfo, keyPairs []pro
 			continue
 		}
 
-		baseTarget, err := pos.CalculateBaseTarget(AverageBlockDelaySeconds, confirmedBlockHeight, confirmedBlock.BlockHeader.BaseTarget, confirmedBlock.Timestamp, greatGrandParentTimestamp, delay+confirmedBlock.Timestamp)
+		ts := a.timestamp(confirmedBlock.Timestamp, delay)
+		baseTarget, err := pos.CalculateBaseTarget(AverageBlockDelaySeconds, confirmedBlockHeight, confirmedBlock.BlockHeader.BaseTarget, confirmedBlock.Timestamp, greatGrandParentTimestamp, ts)
 		if err != nil {
 			zap.S().Error("scheduler, internalImpl pos.CalculateBaseTarget", err)
 			continue
 		}
 
 		out = append(out, Emit{
-			Timestamp:    confirmedBlock.Timestamp + delay,
-			KeyPair:      keyPair,
+			Timestamp:    ts,
+			PublicKey:    pk,
 			GenSignature: genSig,
 			VRF:          vrf,
 			BaseTarget:   baseTarget,
 			Parent:       confirmedBlock.BlockID(),
+
+			Hit:               hit,
+			ParentTarget:      confirmedBlock.BlockHeader.BaseTarget,
+			GeneratingBalance: effectiveBalance,
 		})
 	}
 	return out, nil
 }
 
-type seeder interface {
-	Seeds() [][]byte
-}
-
-func NewScheduler(state state.State, seeder seeder, settings *settings.BlockchainSettings, tm types.Time, consensus types.MinerConsensus, minerDelay proto.Timestamp) *SchedulerImpl {
-	return newScheduler(internalImpl{}, state, seeder, settings, tm, consensus, minerDelay)
+// NewScheduler creates the scheduler of mining with keys of signer.
+// If no signer given, the scheduler uses local signer with empty wallet and never mines.
+func NewScheduler(state state.State, signer signer.Signer, settings *settings.BlockchainSettings, tm types.Time, consensus types.MinerConsensus, minerDelay proto.Timestamp) *SchedulerImpl {
+	return newScheduler(internalImpl{}, state, signer, settings, tm, consensus, minerDelay)
 }
 
-func newScheduler(internal internal, state state.State, seeder seeder, settings *settings.BlockchainSettings, tm types.Time, consensus types.MinerConsensus, minerDelay proto.Timestamp) *SchedulerImpl {
-	if seeder == nil {
-		seeder = wallet.NewWallet()
+func newScheduler(internal internal, state state.State, sgn signer.Signer, settings *settings.BlockchainSettings, tm types.Time, consensus types.MinerConsensus, minerDelay proto.Timestamp) *SchedulerImpl {
+	if sgn == nil {
+		sgn = signer.NewLocalSigner(wallet.NewWallet(), proto.MainNetScheme)
 	}
 	return &SchedulerImpl{
-		seeder:        seeder,
+		signer:        sgn,
 		mine:          make(chan Emit, 1),
 		settings:      settings,
 		internal:      internal,
@@ -279,17 +317,36 @@ func newScheduler(internal internal, state state.State, seeder seeder, settings
 	}
 }
 
+// NewDevScheduler creates the scheduler for dev mining, it generates blocks immediately on demand
+// and doesn't require connected peers. Dev mining must be enabled in blockchain settings.
+func NewDevScheduler(state state.State, signer signer.Signer, settings *settings.BlockchainSettings, tm types.Time) (*SchedulerImpl, error) {
+	if !settings.DevMiningEnabled() {
+		return nil, errors.New("dev mining is not enabled in blockchain settings")
+	}
+	s := newScheduler(internalImpl{instant: tm}, state, signer, settings, tm, StubConsensus{}, 0)
+	s.dev = true
+	return s, nil
+}
+
 func (a *SchedulerImpl) Mine() chan Emit {
 	return a.mine
 }
 
 func (a *SchedulerImpl) Reschedule() {
-	if len(a.seeder.Seeds()) == 0 {
-		zap.S().Debug("Scheduler: Mining is not possible because no seeds registered")
+	if a.dev {
+		return
+	}
+	pks, err := a.signer.PublicKeys()
+	if err != nil {
+		zap.S().Errorf("Scheduler: Failed to get public keys from signer: %v", err)
+		return
+	}
+	if len(pks) == 0 {
+		zap.S().Debug("Scheduler: Mining is not possible because no keys registered")
 		return
 	}
 
-	zap.S().Debugf("Scheduler: Trying to mine with %d seeds", len(a.seeder.Seeds()))
+	zap.S().Debugf("Scheduler: Trying to mine with %d keys", len(pks))
 
 	if !a.consensus.IsMiningAllowed() {
 		zap.S().Debug("Scheduler: Mining is not allowed because of lack of connected nodes")
@@ -315,13 +372,10 @@ func (a *SchedulerImpl) Reschedule() {
 		return
 	}
 
-	a.reschedule(block, h)
+	a.reschedule(pks, block, h)
 }
 
-func (a *SchedulerImpl) reschedule(confirmedBlock *proto.Block, confirmedBlockHeight uint64) {
-	if len(a.seeder.Seeds()) == 0 {
-		return
-	}
+func (a *SchedulerImpl) reschedule(pks []crypto.PublicKey, confirmedBlock *proto.Block, confirmedBlockHeight uint64) {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
@@ -331,14 +385,8 @@ func (a *SchedulerImpl) reschedule(confirmedBlock *proto.Block, confirmedBlockHe
 	}
 	a.cancel = nil
 
-	keyPairs, err := makeKeyPairs(a.seeder.Seeds())
-	if err != nil {
-		zap.S().Error(err)
-		return
-	}
-
 	rs, err := a.storage.MapR(func(info state.StateInfo) (i interface{}, err error) {
-		return a.internal.schedule(info, keyPairs, a.settings.AddressSchemeCharacter, a.settings.AverageBlockDelaySeconds, confirmedBlock, confirmedBlockHeight)
+		return a.internal.schedule(info, pks, a.signer, a.settings.AddressSchemeCharacter, a.settings.AverageBlockDelaySeconds, confirmedBlock, confirmedBlockHeight)
 	})
 	if err != nil {
 		zap.S().Error(err)
@@ -371,20 +419,49 @@ func (a *SchedulerImpl) reschedule(confirmedBlock *proto.Block, confirmedBlockHe
 	}
 }
 
+// MineNow generates the block on top of the last one right now, it's available only in dev mode.
+func (a *SchedulerImpl) MineNow() error {
+	if !a.dev {
+		return errors.New("mining on demand is available only in dev mining mode")
+	}
+	pks, err := a.signer.PublicKeys()
+	if err != nil {
+		return errors.Wrap(err, "failed to get public keys from signer")
+	}
+	if len(pks) == 0 {
+		return errors.New("no keys registered")
+	}
+	h, err := a.storage.Height()
+	if err != nil {
+		return err
+	}
+	block, err := a.storage.BlockByHeight(h)
+	if err != nil {
+		return err
+	}
+	rs, err := a.storage.MapR(func(info state.StateInfo) (interface{}, error) {
+		return a.internal.schedule(info, pks, a.signer, a.settings.AddressSchemeCharacter, a.settings.AverageBlockDelaySeconds, block, h)
+	})
+	if err != nil {
+		return err
+	}
+	emits := rs.([]Emit)
+	if len(emits) == 0 {
+		return errors.New("no account is allowed to generate block")
+	}
+	a.mu.Lock()
+	a.emits = emits
+	a.mu.Unlock()
+	select {
+	case a.mine <- emits[0]:
+		return nil
+	default:
+		return errors.New("previous block is still being mined")
+	}
+}
+
 func (a *SchedulerImpl) Emits() []Emit {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	return a.emits
 }
-
-func makeKeyPairs(seeds [][]byte) ([]proto.KeyPair, error) {
-	var out []proto.KeyPair
-	for _, bts := range seeds {
-		kp, err := proto.NewKeyPair(bts)
-		if err != nil {
-			return nil, err
-		}
-		out = append(out, kp)
-	}
-	return out, nil
-}
@@ -2,16 +2,20 @@ package scheduler
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 )
 
 type mockInternal struct {
 }
 
-func (a mockInternal) schedule(state state.StateInfo, keyPairs []proto.KeyPair, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
+func (a mockInternal) schedule(state state.StateInfo, pks []crypto.PublicKey, signer signer.Signer, schema proto.Scheme, AverageBlockDelaySeconds uint64, confirmedBlock *proto.Block, confirmedBlockHeight uint64) ([]Emit, error) {
 	return nil, nil
 }
 
@@ -22,3 +26,46 @@ func TestSchedulerImpl_Emits(t *testing.T) {
 
 	require.EqualValues(t, []Emit([]Emit(nil)), rs)
 }
+
+func TestNewDevScheduler(t *testing.T) {
+	for _, public := range []*settings.BlockchainSettings{settings.MainNetSettings, settings.TestNetSettings, settings.StageNetSettings} {
+		s := *public
+		s.DevMining = true
+		_, err := NewDevScheduler(nil, nil, &s, nil)
+		require.Error(t, err)
+	}
+	// custom blockchain with scheme of public one
+	s := *settings.DefaultCustomSettings
+	s.DevMining = true
+	s.AddressSchemeCharacter = proto.MainNetScheme
+	_, err := NewDevScheduler(nil, nil, &s, nil)
+	require.Error(t, err)
+
+	s = *settings.DefaultCustomSettings
+	_, err = NewDevScheduler(nil, nil, &s, nil)
+	require.Error(t, err)
+
+	s.DevMining = true
+	sch, err := NewDevScheduler(nil, nil, &s, nil)
+	require.NoError(t, err)
+	// no keys to mine with
+	require.Error(t, sch.MineNow())
+
+	require.Error(t, newScheduler(mockInternal{}, nil, nil, nil, nil, nil, 0).MineNow())
+}
+
+type fixedTime time.Time
+
+func (a fixedTime) Now() time.Time {
+	return time.Time(a)
+}
+
+func TestInternalImpl_Timestamp(t *testing.T) {
+	require.EqualValues(t, 1500, internalImpl{}.timestamp(1000, 500))
+
+	now := time.Unix(10, 0)
+	instant := internalImpl{instant: fixedTime(now)}
+	require.EqualValues(t, 10000, instant.timestamp(1000, 500))
+	// never earlier than parent
+	require.EqualValues(t, 20001, instant.timestamp(20000, 500))
+}
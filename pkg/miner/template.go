@@ -0,0 +1,156 @@
+package miner
+
+import (
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/types"
+)
+
+type TemplateTransaction struct {
+	ID   crypto.Digest         `json:"id"`
+	Type proto.TransactionType `json:"type"`
+	Fee  uint64                `json:"fee"`
+	Size int                   `json:"size"`
+	// Complexity of all scripts run by transaction: the account, DApp and smart assets scripts.
+	Complexity uint64 `json:"complexity"`
+}
+
+// Template describes the block the miner would produce from UTX right now.
+type Template struct {
+	Constraints  Constraints           `json:"constraints"`
+	Transactions []TemplateTransaction `json:"transactions"`
+	Size         int                   `json:"size"`
+	Complexity   uint64                `json:"complexity"`
+	ScriptRuns   int                   `json:"script_runs"`
+	// Number of microblocks the transactions are packed in, before NG transactions are put in the key block.
+	Microblocks int `json:"microblocks"`
+	// The constraint that stopped packing, empty if all valid transactions fit in block.
+	StoppedBy string `json:"stopped_by,omitempty"`
+	// Number of transactions in UTX that failed validation.
+	Invalid int `json:"invalid"`
+}
+
+type templateParams struct {
+	constraints     Constraints
+	version         proto.BlockVersion
+	parentTimestamp proto.Timestamp
+	ng              bool
+}
+
+// BuildTemplate packs the transactions from UTX in the order of strategy the same way the miner does,
+// but without removing them from the pool. Transactions are packed into microblocks of at most
+// conf.MaxTransactions transactions after NG, and into the key block limited by ClassicAmountOfTxsInBlock before.
+func BuildTemplate(st state.State, utx types.UtxPool, strategy types.PackingStrategy, conf microblock.Settings, scheme proto.Scheme, now proto.Timestamp) (*Template, error) {
+	transactions := strategy.Order(utx.AllTransactions())
+	rs, err := st.MapR(func(info state.StateInfo) (interface{}, error) {
+		return newTemplateParams(info)
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build block template")
+	}
+	params := rs.(*templateParams)
+	tpl := &Template{
+		Constraints:  params.constraints,
+		Transactions: make([]TemplateTransaction, 0),
+	}
+	maxTransactions := conf.MaxTransactions
+	if !params.ng {
+		maxTransactions = params.constraints.ClassicAmountOfTxsInBlock
+	}
+	packing := newMicroblockPacking(params.constraints.miningLimits(), maxTransactions)
+	err = st.TxValidation(func(validation state.TxValidation) error {
+		// In miner we pack transactions from UTX into new block.
+		// We should always check all scripts here, even after
+		// activation of accepting transactions with failed scripts.
+		checkScripts := true
+		deferred := make(deferredTransactions)
+		for len(transactions) > 0 {
+			t := transactions[0]
+			stop := packing.fits(len(t.B))
+			if stop == CountConstraint && params.ng {
+				packing = newMicroblockPacking(packing.next(), maxTransactions)
+				stop = packing.fits(len(t.B))
+			}
+			if stop != "" {
+				tpl.StoppedBy = stop
+				break
+			}
+			transactions = transactions[1:]
+			runs, complexity := validation.ScriptsUsage()
+			if err := validation.ValidateNextTx(t.T, now, params.parentTimestamp, params.version, checkScripts); err != nil {
+				deferred.add(t)
+				continue
+			}
+			newRuns, newComplexity := validation.ScriptsUsage()
+			runs, complexity = newRuns-runs, newComplexity-complexity
+			if stop := packing.fitsScripts(runs, complexity); stop != "" {
+				tpl.StoppedBy = stop
+				break
+			}
+			id, err := t.T.GetID(scheme)
+			if err != nil {
+				return err
+			}
+			digest, err := crypto.NewDigestFromBytes(id)
+			if err != nil {
+				return err
+			}
+			if packing.count == 0 && params.ng {
+				tpl.Microblocks++
+			}
+			packing.add(len(t.B), runs, complexity)
+			tpl.Transactions = append(tpl.Transactions, TemplateTransaction{
+				ID:         digest,
+				Type:       t.T.GetTypeInfo().Type,
+				Fee:        t.T.GetFee(),
+				Size:       len(t.B),
+				Complexity: complexity,
+			})
+			tpl.Size += len(t.B) + transactionLengthSize
+			tpl.Complexity += complexity
+			tpl.ScriptRuns += int(runs)
+			transactions = append(deferred.release(t.T.GetSenderPK()), transactions...)
+		}
+		for _, txs := range deferred {
+			tpl.Invalid += len(txs)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to build block template")
+	}
+	return tpl, nil
+}
+
+func newTemplateParams(info state.StateInfo) (*templateParams, error) {
+	constraints, err := NewConstraints(info)
+	if err != nil {
+		return nil, err
+	}
+	version, err := blockVersion(info)
+	if err != nil {
+		return nil, err
+	}
+	ng, err := info.IsActivated(int16(settings.NG))
+	if err != nil {
+		return nil, err
+	}
+	height, err := info.Height()
+	if err != nil {
+		return nil, err
+	}
+	top, err := info.HeaderByHeight(height)
+	if err != nil {
+		return nil, err
+	}
+	return &templateParams{
+		constraints:     constraints,
+		version:         version,
+		parentTimestamp: top.Timestamp,
+		ng:              ng,
+	}, nil
+}
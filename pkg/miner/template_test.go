@@ -0,0 +1,147 @@
+package miner
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
+	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
+	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/types"
+	"github.com/wavesplatform/gowaves/pkg/util/byte_helpers"
+)
+
+func TestBuildTemplate(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	utx := utxpool.New(10000, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, utx.AddWithBytes(byte_helpers.TransferWithSig.Transaction, byte_helpers.TransferWithSig.TransactionBytes))
+	require.NoError(t, utx.AddWithBytes(byte_helpers.BurnWithSig.Transaction, byte_helpers.BurnWithSig.TransactionBytes))
+
+	for _, test := range []struct {
+		constraints     settings.MiningConstraints
+		ng              bool
+		maxTransactions int
+		count           int
+		microblocks     int
+		stoppedBy       string
+	}{
+		{settings.MiningConstraints{}, false, 255, 2, 0, ""},
+		{settings.MiningConstraints{MaxScriptsComplexityInBlock: 500}, false, 255, 1, 0, ComplexityConstraint},
+		{settings.MiningConstraints{MaxScriptRunsInBlock: 1}, false, 255, 1, 0, ScriptRunsConstraint},
+		{settings.MiningConstraints{MaxTxsSizeInBytes: 10}, false, 255, 0, 0, SizeConstraint},
+		{settings.MiningConstraints{ClassicAmountOfTxsInBlock: 1}, false, 255, 1, 0, CountConstraint},
+		{settings.MiningConstraints{ClassicAmountOfTxsInBlock: 1}, true, 255, 2, 1, ""},
+		{settings.MiningConstraints{}, true, 1, 2, 2, ""},
+		{settings.MiningConstraints{MaxScriptsComplexityInBlock: 500}, true, 1, 1, 1, ComplexityConstraint},
+	} {
+		custom := *settings.MainNetSettings
+		custom.MiningConstraints = test.constraints
+		conf := microblock.DefaultSettings
+		conf.MaxTransactions = test.maxTransactions
+
+		info := mock.NewMockStateInfo(ctrl)
+		ng := test.ng
+		info.EXPECT().IsActivated(gomock.Any()).DoAndReturn(func(feature int16) (bool, error) {
+			return ng && feature == int16(settings.NG), nil
+		}).AnyTimes()
+		info.EXPECT().IsActiveAtHeight(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+		info.EXPECT().BlockchainSettings().Return(&custom, nil)
+		info.EXPECT().Height().Return(proto.Height(10), nil).AnyTimes()
+		info.EXPECT().HeaderByHeight(proto.Height(10)).Return(&proto.BlockHeader{}, nil)
+
+		// every transaction runs scripts of complexity 300
+		var runs, complexity uint64
+		validation := mock.NewMockTxValidation(ctrl)
+		validation.EXPECT().ScriptsUsage().DoAndReturn(func() (uint64, uint64) {
+			return runs, complexity
+		}).AnyTimes()
+		validation.EXPECT().ValidateNextTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), true).DoAndReturn(
+			func(proto.Transaction, uint64, uint64, proto.BlockVersion, bool) error {
+				runs++
+				complexity += 300
+				return nil
+			}).AnyTimes()
+
+		st := mock.NewMockState(ctrl)
+		st.EXPECT().MapR(gomock.Any()).DoAndReturn(func(f func(state.StateInfo) (interface{}, error)) (interface{}, error) {
+			return f(info)
+		})
+		st.EXPECT().TxValidation(gomock.Any()).DoAndReturn(func(f func(state.TxValidation) error) error {
+			return f(validation)
+		})
+
+		tpl, err := BuildTemplate(st, utx, FeeGreedyStrategy{}, conf, proto.MainNetScheme, 0)
+		require.NoError(t, err)
+		require.Len(t, tpl.Transactions, test.count)
+		require.Equal(t, test.microblocks, tpl.Microblocks)
+		require.Equal(t, test.stoppedBy, tpl.StoppedBy)
+		require.EqualValues(t, 300*test.count, tpl.Complexity)
+		require.Equal(t, test.count, tpl.ScriptRuns)
+	}
+	require.Equal(t, 2, utx.Count())
+}
+
+type reverseStrategy struct{}
+
+func (reverseStrategy) Order(transactions []*types.TransactionWithBytes) []*types.TransactionWithBytes {
+	res := make([]*types.TransactionWithBytes, len(transactions))
+	for i, t := range transactions {
+		res[len(transactions)-1-i] = t
+	}
+	return res
+}
+
+func TestBuildTemplate_Dependencies(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	transfer := byte_helpers.TransferWithSig.Transaction
+	burn := byte_helpers.BurnWithSig.Transaction
+	require.Equal(t, transfer.GetSenderPK(), burn.GetSenderPK())
+
+	utx := utxpool.New(10000, utxpool.NoOpValidator{}, utxpool.NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, utx.AddWithBytes(transfer, byte_helpers.TransferWithSig.TransactionBytes))
+	require.NoError(t, utx.AddWithBytes(burn, byte_helpers.BurnWithSig.TransactionBytes))
+
+	info := mock.NewMockStateInfo(ctrl)
+	info.EXPECT().IsActivated(gomock.Any()).Return(false, nil).AnyTimes()
+	info.EXPECT().IsActiveAtHeight(gomock.Any(), gomock.Any()).Return(false, nil).AnyTimes()
+	info.EXPECT().BlockchainSettings().Return(settings.MainNetSettings, nil)
+	info.EXPECT().Height().Return(proto.Height(10), nil).AnyTimes()
+	info.EXPECT().HeaderByHeight(proto.Height(10)).Return(&proto.BlockHeader{}, nil)
+
+	// the burn is valid only after the transfer
+	validated := make(map[proto.Transaction]bool)
+	validation := mock.NewMockTxValidation(ctrl)
+	validation.EXPECT().ScriptsUsage().Return(uint64(0), uint64(0)).AnyTimes()
+	validation.EXPECT().ValidateNextTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), true).DoAndReturn(
+		func(tx proto.Transaction, _, _ uint64, _ proto.BlockVersion, _ bool) error {
+			if tx == burn && !validated[transfer] {
+				return errors.New("depends on transfer")
+			}
+			validated[tx] = true
+			return nil
+		}).Times(3)
+
+	st := mock.NewMockState(ctrl)
+	st.EXPECT().MapR(gomock.Any()).DoAndReturn(func(f func(state.StateInfo) (interface{}, error)) (interface{}, error) {
+		return f(info)
+	})
+	st.EXPECT().TxValidation(gomock.Any()).DoAndReturn(func(f func(state.TxValidation) error) error {
+		return f(validation)
+	})
+
+	tpl, err := BuildTemplate(st, utx, reverseStrategy{}, microblock.DefaultSettings, proto.MainNetScheme, 0)
+	require.NoError(t, err)
+	require.Len(t, tpl.Transactions, 2)
+	require.Equal(t, proto.TransferTransaction, tpl.Transactions[0].Type)
+	require.Equal(t, proto.BurnTransaction, tpl.Transactions[1].Type)
+	require.Equal(t, 0, tpl.Invalid)
+}
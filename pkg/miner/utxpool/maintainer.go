@@ -0,0 +1,154 @@
+package utxpool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/types"
+	"go.uber.org/zap"
+)
+
+type MaintenanceSettings struct {
+	// Transactions that stay in the pool longer are removed, zero value disables the check.
+	MaxAge time.Duration
+	// Transactions that stay in the pool longer are broadcast again, and then again after the same period.
+	RebroadcastAfter time.Duration
+	// Max number of transactions broadcast again on one run.
+	RebroadcastLimit int
+}
+
+var DefaultMaintenanceSettings = MaintenanceSettings{
+	MaxAge:           time.Hour,
+	RebroadcastAfter: 2 * time.Minute,
+	RebroadcastLimit: 100,
+}
+
+// MaintenanceStats holds the counters of transactions removed or rebroadcast by Maintainer since the start.
+type MaintenanceStats struct {
+	Runs        uint64 `json:"runs"`
+	Expired     uint64 `json:"expired"`
+	Invalidated uint64 `json:"invalidated"`
+	Rebroadcast uint64 `json:"rebroadcast"`
+}
+
+// Maintainer periodically cleans the pool independently of new blocks. It removes expired transactions,
+// revalidates the rest against the state and selects long pending ones for rebroadcast.
+type Maintainer struct {
+	mu       sync.Mutex
+	state    stateWrapper
+	pool     types.UtxPool
+	tm       types.Time
+	settings *settings.BlockchainSettings
+	conf     MaintenanceSettings
+	// time of the last rebroadcast of transactions
+	broadcast map[crypto.Digest]time.Time
+	stats     MaintenanceStats
+}
+
+func NewMaintainer(state state.State, pool types.UtxPool, tm types.Time, settings *settings.BlockchainSettings, conf MaintenanceSettings) *Maintainer {
+	return newMaintainer(state, pool, tm, settings, conf)
+}
+
+func newMaintainer(state stateWrapper, pool types.UtxPool, tm types.Time, settings *settings.BlockchainSettings, conf MaintenanceSettings) *Maintainer {
+	return &Maintainer{
+		state:     state,
+		pool:      pool,
+		tm:        tm,
+		settings:  settings,
+		conf:      conf,
+		broadcast: make(map[crypto.Digest]time.Time),
+	}
+}
+
+// Maintain runs one round of maintenance and returns the transactions that should be broadcast again.
+// The age of transaction is counted from the time it was received.
+func (a *Maintainer) Maintain() []*types.TransactionWithBytes {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.stats.Runs++
+	now := a.tm.Now()
+	transactions, err := a.revalidate(now)
+	if err != nil {
+		zap.S().Debug(err)
+		return nil
+	}
+	broadcast := make(map[crypto.Digest]time.Time, len(transactions))
+	var rebroadcast []*types.TransactionWithBytes
+	for _, t := range transactions {
+		if err := a.pool.Return(t); err != nil {
+			continue
+		}
+		id := a.id(t.T)
+		last, ok := a.broadcast[id]
+		if ok {
+			broadcast[id] = last
+		}
+		if len(rebroadcast) >= a.conf.RebroadcastLimit {
+			continue
+		}
+		if now.Sub(t.Received) >= a.conf.RebroadcastAfter && now.Sub(last) >= a.conf.RebroadcastAfter {
+			broadcast[id] = now
+			rebroadcast = append(rebroadcast, t)
+		}
+	}
+	a.broadcast = broadcast
+	a.stats.Rebroadcast += uint64(len(rebroadcast))
+	return rebroadcast
+}
+
+// Stats returns the current values of counters.
+func (a *Maintainer) Stats() MaintenanceStats {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.stats
+}
+
+func (a *Maintainer) id(t proto.Transaction) crypto.Digest {
+	return makeDigest(t.GetID(a.settings.AddressSchemeCharacter))
+}
+
+func (a *Maintainer) expired(t *types.TransactionWithBytes, now time.Time) bool {
+	if t.T.GetTimestamp()+a.settings.MaxTxTimeBackOffset < proto.NewTimestampFromTime(now) {
+		return true
+	}
+	return a.conf.MaxAge != 0 && now.Sub(t.Received) > a.conf.MaxAge
+}
+
+func (a *Maintainer) revalidate(now time.Time) ([]*types.TransactionWithBytes, error) {
+	if a.pool.Count() == 0 {
+		return nil, nil
+	}
+	var transactions []*types.TransactionWithBytes
+	currentTimestamp := proto.NewTimestampFromTime(now)
+	lastKnownBlock := a.state.TopBlock()
+
+	checkScripts, err := needToCheckScriptsInUtx(a.state)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.state.TxValidation(func(validation state.TxValidation) error {
+		for {
+			t := a.pool.Pop()
+			if t == nil {
+				break
+			}
+			if a.expired(t, now) {
+				a.stats.Expired++
+				continue
+			}
+			if err := validation.ValidateNextTx(t.T, currentTimestamp, lastKnownBlock.Timestamp, lastKnownBlock.Version, checkScripts); err != nil {
+				a.stats.Invalidated++
+				continue
+			}
+			transactions = append(transactions, t)
+		}
+		return nil
+	})
+	return transactions, err
+}
@@ -0,0 +1,121 @@
+package utxpool
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/types"
+	"github.com/wavesplatform/gowaves/pkg/util/byte_helpers"
+)
+
+func newMaintainedPool(t *testing.T, ctrl *gomock.Controller, validationErr error) (*UtxImpl, *MockstateWrapper) {
+	v := mock.NewMockTxValidation(ctrl)
+	v.EXPECT().ValidateNextTx(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return(validationErr).AnyTimes()
+	m := NewMockstateWrapper(ctrl)
+	m.EXPECT().TopBlock().Return(&proto.Block{}).AnyTimes()
+	m.EXPECT().IsActivated(gomock.Any()).Return(false, nil).AnyTimes()
+	m.EXPECT().TxValidation(gomock.Any()).DoAndReturn(func(f func(state.TxValidation) error) error {
+		return f(v)
+	}).AnyTimes()
+	utx := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, utx.Return(&types.TransactionWithBytes{
+		T:        byte_helpers.TransferWithSig.Transaction,
+		B:        byte_helpers.TransferWithSig.TransactionBytes,
+		Received: txTime(),
+	}))
+	return utx, m
+}
+
+func txTime() time.Time {
+	ts := byte_helpers.TransferWithSig.Transaction.Timestamp
+	return time.Unix(0, int64(ts)*int64(time.Millisecond))
+}
+
+func TestMaintainer_ExpiredByTimestamp(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	utx, m := newMaintainedPool(t, ctrl, nil)
+	now := txTime().Add(time.Duration(settings.MainNetSettings.MaxTxTimeBackOffset)*time.Millisecond + time.Minute)
+	a := newMaintainer(m, utx, tm(now), settings.MainNetSettings, DefaultMaintenanceSettings)
+
+	require.Empty(t, a.Maintain())
+	require.Equal(t, 0, utx.Count())
+	require.Equal(t, MaintenanceStats{Runs: 1, Expired: 1}, a.Stats())
+}
+
+func TestMaintainer_ExpiredByAge(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	utx, m := newMaintainedPool(t, ctrl, nil)
+	conf := MaintenanceSettings{MaxAge: time.Minute, RebroadcastAfter: time.Hour, RebroadcastLimit: 10}
+	a := newMaintainer(m, utx, tm(txTime()), settings.MainNetSettings, conf)
+
+	a.Maintain()
+	require.Equal(t, 1, utx.Count())
+
+	a.tm = tm(txTime().Add(2 * time.Minute))
+	a.Maintain()
+	require.Equal(t, 0, utx.Count())
+	require.Equal(t, MaintenanceStats{Runs: 2, Expired: 1}, a.Stats())
+}
+
+func TestMaintainer_ExpiredByAgeOnFirstRound(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	// the transaction received long before the first round is expired on it
+	utx, m := newMaintainedPool(t, ctrl, nil)
+	conf := MaintenanceSettings{MaxAge: time.Minute, RebroadcastAfter: time.Hour, RebroadcastLimit: 10}
+	a := newMaintainer(m, utx, tm(txTime().Add(2*time.Minute)), settings.MainNetSettings, conf)
+
+	a.Maintain()
+	require.Equal(t, 0, utx.Count())
+	require.Equal(t, MaintenanceStats{Runs: 1, Expired: 1}, a.Stats())
+}
+
+func TestMaintainer_Invalidated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	utx, m := newMaintainedPool(t, ctrl, errors.New("invalid"))
+	a := newMaintainer(m, utx, tm(txTime()), settings.MainNetSettings, DefaultMaintenanceSettings)
+
+	require.Empty(t, a.Maintain())
+	require.Equal(t, 0, utx.Count())
+	require.Equal(t, MaintenanceStats{Runs: 1, Invalidated: 1}, a.Stats())
+}
+
+func TestMaintainer_Rebroadcast(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	utx, m := newMaintainedPool(t, ctrl, nil)
+	conf := MaintenanceSettings{RebroadcastAfter: 2 * time.Minute, RebroadcastLimit: 10}
+	a := newMaintainer(m, utx, tm(txTime()), settings.MainNetSettings, conf)
+
+	// just seen, too early to rebroadcast
+	require.Empty(t, a.Maintain())
+
+	a.tm = tm(txTime().Add(3 * time.Minute))
+	rs := a.Maintain()
+	require.Len(t, rs, 1)
+	require.Equal(t, byte_helpers.TransferWithSig.Transaction, rs[0].T)
+
+	// rebroadcast recently
+	a.tm = tm(txTime().Add(4 * time.Minute))
+	require.Empty(t, a.Maintain())
+
+	a.tm = tm(txTime().Add(6 * time.Minute))
+	require.Len(t, a.Maintain(), 1)
+	require.Equal(t, 1, utx.Count())
+	require.Equal(t, MaintenanceStats{Runs: 4, Rebroadcast: 2}, a.Stats())
+}
@@ -0,0 +1,204 @@
+package utxpool
+
+import (
+	"bufio"
+	"context"
+	"encoding/binary"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"go.uber.org/zap"
+)
+
+const (
+	// SnapshotFileName is the name of the file in state directory the pool is saved to.
+	SnapshotFileName = "utx.snapshot"
+
+	snapshotVersion  byte = 1
+	binaryEncoding   byte = 0
+	protobufEncoding byte = 1
+)
+
+// The snapshot file starts with the version byte followed by the records of transactions.
+// Each record consists of the encoding byte of transaction, the length of encoded transaction (4 bytes),
+// the encoded transaction itself, the length of transaction bytes as they were added to the pool (4 bytes),
+// and these bytes.
+
+func writeChunk(w io.Writer, b []byte) error {
+	var l [4]byte
+	binary.BigEndian.PutUint32(l[:], uint32(len(b)))
+	if _, err := w.Write(l[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(b)
+	return err
+}
+
+func readChunk(r io.Reader) ([]byte, error) {
+	var l [4]byte
+	if _, err := io.ReadFull(r, l[:]); err != nil {
+		return nil, err
+	}
+	b := make([]byte, binary.BigEndian.Uint32(l[:]))
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// SaveSnapshot writes all transactions of the pool to the file. The file is replaced atomically,
+// so the previous snapshot stays intact if writing fails.
+func (a *UtxImpl) SaveSnapshot(path string) error {
+	scheme := a.settings.AddressSchemeCharacter
+	txs := a.AllTransactions()
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "failed to create snapshot file")
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	w := bufio.NewWriter(f)
+	if err := w.WriteByte(snapshotVersion); err != nil {
+		return err
+	}
+	for _, tx := range txs {
+		encoding := binaryEncoding
+		if proto.IsProtobufTx(tx.T) {
+			encoding = protobufEncoding
+		}
+		b, err := proto.MarshalTx(scheme, tx.T)
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal transaction")
+		}
+		if err := w.WriteByte(encoding); err != nil {
+			return err
+		}
+		if err := writeChunk(w, b); err != nil {
+			return err
+		}
+		if err := writeChunk(w, tx.B); err != nil {
+			return err
+		}
+	}
+	if err := w.Flush(); err != nil {
+		return err
+	}
+	if err := f.Sync(); err != nil {
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), path)
+}
+
+// LoadSnapshot adds the transactions from snapshot file to the pool. Every transaction is validated again,
+// the transactions that became invalid or expired since the snapshot was made are dropped.
+// It returns the numbers of restored and dropped transactions, missing file is not an error.
+func (a *UtxImpl) LoadSnapshot(path string) (int, int, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	defer f.Close()
+	r := bufio.NewReader(f)
+	version, err := r.ReadByte()
+	if err != nil {
+		if err == io.EOF {
+			return 0, 0, nil
+		}
+		return 0, 0, err
+	}
+	if version != snapshotVersion {
+		return 0, 0, errors.Errorf("unsupported version of UTX snapshot %d", version)
+	}
+	restored, dropped := 0, 0
+	for {
+		encoding, err := r.ReadByte()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return restored, dropped, err
+		}
+		b, err := readChunk(r)
+		if err != nil {
+			return restored, dropped, errors.Wrap(err, "truncated UTX snapshot")
+		}
+		bts, err := readChunk(r)
+		if err != nil {
+			return restored, dropped, errors.Wrap(err, "truncated UTX snapshot")
+		}
+		var tx proto.Transaction
+		switch encoding {
+		case binaryEncoding:
+			tx, err = proto.BytesToTransaction(b, a.settings.AddressSchemeCharacter)
+		case protobufEncoding:
+			tx, err = proto.SignedTxFromProtobuf(b)
+		default:
+			err = errors.Errorf("unknown transaction encoding %d", encoding)
+		}
+		if err != nil {
+			return restored, dropped, errors.Wrap(err, "failed to unmarshal transaction from UTX snapshot")
+		}
+		if err := a.AddWithBytes(tx, bts); err != nil {
+			zap.S().Debugf("Transaction from UTX snapshot dropped: %v", err)
+			dropped++
+			continue
+		}
+		restored++
+	}
+	return restored, dropped, nil
+}
+
+// SnapshotRestored tells if RunSnapshots restored the pool from the file.
+func (a *UtxImpl) SnapshotRestored() bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.restored
+}
+
+func (a *UtxImpl) restoreSnapshot(path string) {
+	restored, dropped, err := a.LoadSnapshot(path)
+	if err != nil {
+		zap.S().Errorf("Failed to load UTX snapshot: %v", err)
+	} else {
+		zap.S().Infof("Restored %d transactions from UTX snapshot, %d dropped", restored, dropped)
+	}
+	a.mu.Lock()
+	a.restored = true
+	a.mu.Unlock()
+}
+
+// RunSnapshots restores the pool from the file as soon as ready returns true and then saves the pool to the file
+// periodically until the context is canceled. Restored transactions are validated against the state, so ready should
+// tell that the node caught up with the network, otherwise they are dropped as outdated. The file is not overwritten
+// until it's restored.
+func (a *UtxImpl) RunSnapshots(ctx context.Context, path string, interval time.Duration, ready func() bool) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if a.SnapshotRestored() {
+			if err := a.SaveSnapshot(path); err != nil {
+				zap.S().Errorf("Failed to save UTX snapshot: %v", err)
+			}
+		} else if ready() {
+			a.restoreSnapshot(path)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
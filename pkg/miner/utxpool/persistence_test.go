@@ -0,0 +1,113 @@
+package utxpool
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/util/byte_helpers"
+)
+
+type rejectingValidator struct {
+	rejected proto.Transaction
+}
+
+func (a rejectingValidator) Validate(t proto.Transaction, pending ...proto.Transaction) error {
+	if t.GetTimestamp() == a.rejected.GetTimestamp() && t.GetSenderPK() == a.rejected.GetSenderPK() {
+		return errors.New("expired")
+	}
+	return nil
+}
+
+func TestUtxImpl_Snapshot(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "utx")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, SnapshotFileName)
+
+	sk, pk, err := crypto.GenerateKeyPair([]byte("seed"))
+	require.NoError(t, err)
+	rcp := proto.NewRecipientFromAddress(proto.Address{})
+	pbTx := proto.NewUnsignedTransferWithProofs(3, pk, proto.OptionalAsset{}, proto.OptionalAsset{}, 100, 1, 100000, rcp, &proto.LegacyAttachment{})
+	require.NoError(t, pbTx.Sign(settings.MainNetSettings.AddressSchemeCharacter, sk))
+	pbBytes, err := pbTx.MarshalSignedToProtobuf(settings.MainNetSettings.AddressSchemeCharacter)
+	require.NoError(t, err)
+	expired := proto.NewUnsignedTransferWithProofs(3, pk, proto.OptionalAsset{}, proto.OptionalAsset{}, 200, 1, 100000, rcp, &proto.LegacyAttachment{})
+	require.NoError(t, expired.Sign(settings.MainNetSettings.AddressSchemeCharacter, sk))
+
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, a.AddWithBytes(byte_helpers.TransferWithSig.Transaction, byte_helpers.TransferWithSig.TransactionBytes))
+	require.NoError(t, a.AddWithBytes(pbTx, pbBytes))
+	require.NoError(t, a.Add(byte_helpers.BurnWithSig.Transaction))
+	require.NoError(t, a.AddWithBytes(expired, pbBytes))
+	require.NoError(t, a.SaveSnapshot(path))
+
+	b := New(10000, rejectingValidator{rejected: expired}, NoOpFeeConverter{}, settings.MainNetSettings)
+	restored, dropped, err := b.LoadSnapshot(path)
+	require.NoError(t, err)
+	assert.Equal(t, 3, restored)
+	assert.Equal(t, 1, dropped)
+	assert.Equal(t, a.CurSize()-uint64(len(pbBytes)), b.CurSize())
+	assert.True(t, b.ExistsByID(byte_helpers.TransferWithSig.Transaction.ID.Bytes()))
+	assert.True(t, b.ExistsByID(byte_helpers.BurnWithSig.Transaction.ID.Bytes()))
+	assert.True(t, b.ExistsByID(pbTx.ID.Bytes()))
+	assert.False(t, b.ExistsByID(expired.ID.Bytes()))
+
+	// missing snapshot is not an error
+	c := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	restored, dropped, err = c.LoadSnapshot(filepath.Join(dir, "missing"))
+	require.NoError(t, err)
+	assert.Equal(t, 0, restored+dropped)
+
+	// truncated snapshot
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(path, data[:len(data)-3], 0644))
+	_, _, err = c.LoadSnapshot(path)
+	assert.Error(t, err)
+}
+
+func TestUtxImpl_RunSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir(os.TempDir(), "utx")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, SnapshotFileName)
+
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, a.Add(byte_helpers.BurnWithSig.Transaction))
+	require.NoError(t, a.SaveSnapshot(path))
+	saved, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+
+	b := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	var ready int32
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		b.RunSnapshots(ctx, path, time.Millisecond, func() bool { return atomic.LoadInt32(&ready) == 1 })
+		close(done)
+	}()
+
+	// Empty pool doesn't overwrite the snapshot that was not restored.
+	time.Sleep(20 * time.Millisecond)
+	assert.False(t, b.SnapshotRestored())
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	assert.Equal(t, saved, data)
+
+	atomic.StoreInt32(&ready, 1)
+	require.Eventually(t, b.SnapshotRestored, time.Second, time.Millisecond)
+	assert.True(t, b.ExistsByID(byte_helpers.BurnWithSig.Transaction.ID.Bytes()))
+	cancel()
+	<-done
+}
@@ -3,7 +3,9 @@ package utxpool
 import (
 	"container/heap"
 	"fmt"
+	"math/bits"
 	"sync"
+	"time"
 
 	"github.com/mr-tron/base58"
 	"github.com/pkg/errors"
@@ -13,25 +15,140 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/types"
 )
 
-type transactionsHeap []*types.TransactionWithBytes
+// maxTransactionsPerSender limits the number of transactions of one account in the pool.
+const maxTransactionsPerSender = 100
+
+// FeeConverter converts the fees paid in sponsored assets to WAVES.
+type FeeConverter interface {
+	SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error)
+}
+
+// NoOpFeeConverter treats the fees in sponsored assets as the fees in WAVES.
+type NoOpFeeConverter struct {
+}
+
+func (NoOpFeeConverter) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	return assetAmount, nil
+}
+
+func feeAsset(t proto.Transaction) proto.OptionalAsset {
+	switch tx := t.(type) {
+	case *proto.TransferWithSig:
+		return tx.FeeAsset
+	case *proto.TransferWithProofs:
+		return tx.FeeAsset
+	case *proto.InvokeScriptWithProofs:
+		return tx.FeeAsset
+	case *proto.UpdateAssetInfoWithProofs:
+		return tx.FeeAsset
+	default:
+		return proto.OptionalAsset{}
+	}
+}
+
+type transactionEntry struct {
+	*types.TransactionWithBytes
+	id        crypto.Digest
+	sender    crypto.PublicKey
+	wavesFee  uint64
+	heapIndex int
+	evicIndex int
+	// Number of pooled transactions of the same sender that must be popped before this one.
+	// Transactions with unresolved dependencies are not in the transactions heap.
+	dependsOn  int
+	dependents []*transactionEntry
+}
+
+// lowerPriority compares the WAVES fees per byte of transactions.
+func (e *transactionEntry) lowerPriority(other *transactionEntry) bool {
+	// e.wavesFee / len(e.B) < other.wavesFee / len(other.B) without loss of precision
+	hi1, lo1 := bits.Mul64(e.wavesFee, uint64(len(other.B)))
+	hi2, lo2 := bits.Mul64(other.wavesFee, uint64(len(e.B)))
+	if hi1 != hi2 {
+		return hi1 < hi2
+	}
+	return lo1 < lo2
+}
+
+// transactionsHeap gives transactions with the highest fee per byte first.
+type transactionsHeap []*transactionEntry
 
 func (a transactionsHeap) Len() int { return len(a) }
 
 func (a transactionsHeap) Less(i, j int) bool {
-	// skip division by zero, check it when we add transaction
-	return a[i].T.GetFee()/uint64(len(a[i].B)) > a[j].T.GetFee()/uint64(len(a[j].B))
+	return a[j].lowerPriority(a[i])
 }
 
 func (a transactionsHeap) Swap(i, j int) {
 	a[i], a[j] = a[j], a[i]
+	a[i].heapIndex = i
+	a[j].heapIndex = j
 }
 
 func (a *transactionsHeap) Push(x interface{}) {
-	item := x.(*types.TransactionWithBytes)
+	item := x.(*transactionEntry)
+	item.heapIndex = len(*a)
 	*a = append(*a, item)
 }
 
 func (a *transactionsHeap) Pop() interface{} {
+	old := *a
+	n := len(old)
+	item := old[n-1]
+	item.heapIndex = -1
+	*a = old[0 : n-1]
+	return item
+}
+
+// evictionHeap gives transactions with the lowest fee per byte first, these are evicted when the pool is full.
+type evictionHeap []*transactionEntry
+
+func (a evictionHeap) Len() int { return len(a) }
+
+func (a evictionHeap) Less(i, j int) bool {
+	return a[i].lowerPriority(a[j])
+}
+
+func (a evictionHeap) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+	a[i].evicIndex = i
+	a[j].evicIndex = j
+}
+
+func (a *evictionHeap) Push(x interface{}) {
+	item := x.(*transactionEntry)
+	item.evicIndex = len(*a)
+	*a = append(*a, item)
+}
+
+func (a *evictionHeap) Pop() interface{} {
+	old := *a
+	n := len(old)
+	item := old[n-1]
+	item.evicIndex = -1
+	*a = old[0 : n-1]
+	return item
+}
+
+// priorityQueue orders transactions the same way as transactionsHeap, but doesn't keep their positions.
+// It's used to walk through the pool without modifying it.
+type priorityQueue []*transactionEntry
+
+func (a priorityQueue) Len() int { return len(a) }
+
+func (a priorityQueue) Less(i, j int) bool {
+	return a[j].lowerPriority(a[i])
+}
+
+func (a priorityQueue) Swap(i, j int) {
+	a[i], a[j] = a[j], a[i]
+}
+
+func (a *priorityQueue) Push(x interface{}) {
+	*a = append(*a, x.(*transactionEntry))
+}
+
+func (a *priorityQueue) Pop() interface{} {
 	old := *a
 	n := len(old)
 	item := old[n-1]
@@ -42,28 +159,60 @@ func (a *transactionsHeap) Pop() interface{} {
 type UtxImpl struct {
 	mu             sync.Mutex
 	transactions   transactionsHeap
-	transactionIds map[crypto.Digest]struct{}
-	sizeLimit      uint64 // max transaction size in bytes
-	curSize        uint64
-	validator      Validator
-	settings       *settings.BlockchainSettings
+	evictions      evictionHeap
+	transactionIds map[crypto.Digest]*transactionEntry
+	// pooled transactions of every sender in order of adding
+	senders     map[crypto.PublicKey][]*transactionEntry
+	sizeLimit   uint64 // max transaction size in bytes
+	senderLimit int    // max number of transactions from one sender
+	curSize     uint64
+	validator   Validator
+	converter   FeeConverter
+	settings    *settings.BlockchainSettings
+	// snapshot file was restored, see RunSnapshots
+	restored bool
 }
 
-func New(sizeLimit uint64, validator Validator, settings *settings.BlockchainSettings) *UtxImpl {
+func New(sizeLimit uint64, validator Validator, converter FeeConverter, settings *settings.BlockchainSettings) *UtxImpl {
 	return &UtxImpl{
-		transactionIds: make(map[crypto.Digest]struct{}),
+		transactionIds: make(map[crypto.Digest]*transactionEntry),
+		senders:        make(map[crypto.PublicKey][]*transactionEntry),
 		sizeLimit:      sizeLimit,
+		senderLimit:    maxTransactionsPerSender,
 		validator:      validator,
+		converter:      converter,
 		settings:       settings,
 	}
 }
 
+// AllTransactions returns the transactions of the pool in order they are popped, the highest priority first.
+// Dependent transactions always follow the transactions they depend on.
 func (a *UtxImpl) AllTransactions() []*types.TransactionWithBytes {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 
-	res := make([]*types.TransactionWithBytes, len(a.transactions))
-	copy(res, a.transactions)
+	queue := make(priorityQueue, len(a.transactions))
+	copy(queue, a.transactions)
+	heap.Init(&queue)
+	waiting := make(map[*transactionEntry]int)
+	res := make([]*types.TransactionWithBytes, 0, len(a.transactionIds))
+	for queue.Len() > 0 {
+		e := heap.Pop(&queue).(*transactionEntry)
+		res = append(res, e.TransactionWithBytes)
+		for _, d := range e.dependents {
+			if !a.pooled(d) {
+				continue
+			}
+			n, ok := waiting[d]
+			if !ok {
+				n = d.dependsOn
+			}
+			waiting[d] = n - 1
+			if n == 1 {
+				heap.Push(&queue, d)
+			}
+		}
+	}
 	return res
 }
 
@@ -72,22 +221,61 @@ func (a *UtxImpl) Add(t proto.Transaction) error {
 	if err != nil {
 		return err
 	}
-	return a.addWithBytes(t, bts)
+	return a.AddWithBytes(t, bts)
 }
 
 func (a *UtxImpl) AddWithBytes(t proto.Transaction, b []byte) error {
 	a.mu.Lock()
 	defer a.mu.Unlock()
-	return a.addWithBytes(t, b)
+	return a.addWithBytes(&types.TransactionWithBytes{T: t, B: b, Received: time.Now()})
 }
 
-func (a *UtxImpl) addWithBytes(t proto.Transaction, b []byte) error {
+func (a *UtxImpl) Return(t *types.TransactionWithBytes) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if t.Received.IsZero() {
+		t.Received = time.Now()
+	}
+	return a.addWithBytes(t)
+}
+
+func (a *UtxImpl) wavesFee(t proto.Transaction) (uint64, error) {
+	asset := feeAsset(t)
+	if !asset.Present {
+		return t.GetFee(), nil
+	}
+	fee, err := a.converter.SponsoredAssetToWaves(asset.ID, t.GetFee())
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to convert fee in asset %s to WAVES", asset.String())
+	}
+	return fee, nil
+}
+
+// evictionCandidates selects the transactions with lower priority than the new one
+// that should be removed to fit the new transaction in the pool.
+func (a *UtxImpl) evictionCandidates(e *transactionEntry) ([]*transactionEntry, bool) {
+	need := a.curSize + uint64(len(e.B)) - a.sizeLimit
+	var freed uint64
+	var candidates []*transactionEntry
+	for freed < need && a.evictions.Len() > 0 {
+		c := heap.Pop(&a.evictions).(*transactionEntry)
+		candidates = append(candidates, c)
+		if !c.lowerPriority(e) {
+			break
+		}
+		freed += uint64(len(c.B))
+	}
+	// Candidates are removed from eviction heap only, they are returned back or removed completely by caller.
+	return candidates, freed >= need
+}
+
+func (a *UtxImpl) addWithBytes(tb *types.TransactionWithBytes) error {
+	t, b := tb.T, tb.B
 	if len(b) == 0 {
 		return errors.New("transaction with empty bytes")
 	}
-	// exceed limit
-	if a.curSize+uint64(len(b)) > a.sizeLimit {
-		return errors.Errorf("size overflow, curSize: %d, limit: %d", a.curSize, a.sizeLimit)
+	if uint64(len(b)) > a.sizeLimit {
+		return errors.Errorf("transaction size %d exceeds the pool limit %d", len(b), a.sizeLimit)
 	}
 	if err := t.GenerateID(a.settings.AddressSchemeCharacter); err != nil {
 		return errors.Errorf("failed to generate ID: %v", err)
@@ -99,25 +287,133 @@ func (a *UtxImpl) addWithBytes(t proto.Transaction, b []byte) error {
 	if a.exists(t) {
 		return errors.Errorf("transaction with id %s exists", base58.Encode(tID))
 	}
-	err = a.validator.Validate(t)
+	sender := t.GetSenderPK()
+	pending := a.senders[sender]
+	if len(pending) >= a.senderLimit {
+		return errors.Errorf("too many transactions from sender %s, limit: %d", sender.String(), a.senderLimit)
+	}
+	fee, err := a.wavesFee(t)
+	if err != nil {
+		return err
+	}
+	e := &transactionEntry{
+		TransactionWithBytes: tb,
+		id:                   makeDigest(tID, nil),
+		sender:               sender,
+		wavesFee:             fee,
+		heapIndex:            -1,
+		evicIndex:            -1,
+	}
+	// exceed limit
+	var evicted []*transactionEntry
+	if a.curSize+uint64(len(b)) > a.sizeLimit {
+		candidates, ok := a.evictionCandidates(e)
+		if !ok {
+			for _, c := range candidates {
+				heap.Push(&a.evictions, c)
+			}
+			return errors.Errorf("size overflow, curSize: %d, limit: %d", a.curSize, a.sizeLimit)
+		}
+		evicted = candidates
+	}
+	dependent, err := a.validate(t, pending)
+	if err == nil && dependent {
+		// the transaction can't outlive the ones it depends on
+		for _, c := range evicted {
+			if c.sender == sender {
+				err = errors.Errorf("size overflow, curSize: %d, limit: %d", a.curSize, a.sizeLimit)
+				break
+			}
+		}
+	}
 	if err != nil {
+		for _, c := range evicted {
+			heap.Push(&a.evictions, c)
+		}
 		return err
 	}
-	tb := &types.TransactionWithBytes{
-		T: t,
-		B: b,
+	for _, c := range evicted {
+		if a.pooled(c) {
+			a.remove(c)
+		}
 	}
-	heap.Push(&a.transactions, tb)
-	id := makeDigest(t.GetID(a.settings.AddressSchemeCharacter))
-	a.transactionIds[id] = struct{}{}
+	if dependent {
+		e.dependsOn = len(pending)
+		for _, p := range pending {
+			p.dependents = append(p.dependents, e)
+		}
+	} else {
+		heap.Push(&a.transactions, e)
+	}
+	heap.Push(&a.evictions, e)
+	a.transactionIds[e.id] = e
+	a.senders[sender] = append(pending, e)
 	a.curSize += uint64(len(b))
 	return nil
 }
 
+// validate checks the transaction against the state, and if it's not valid, on top of the pooled transactions
+// of the same sender. In the latter case the transaction depends on them.
+func (a *UtxImpl) validate(t proto.Transaction, pending []*transactionEntry) (bool, error) {
+	err := a.validator.Validate(t)
+	if err == nil || len(pending) == 0 {
+		return false, err
+	}
+	txs := make([]proto.Transaction, len(pending))
+	for i, p := range pending {
+		txs[i] = p.T
+	}
+	if err := a.validator.Validate(t, txs...); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (a *UtxImpl) pooled(e *transactionEntry) bool {
+	return a.transactionIds[e.id] == e
+}
+
+// remove removes the transaction from the pool together with all transactions depending on it.
+func (a *UtxImpl) remove(e *transactionEntry) {
+	if e.heapIndex >= 0 {
+		heap.Remove(&a.transactions, e.heapIndex)
+	}
+	if e.evicIndex >= 0 {
+		heap.Remove(&a.evictions, e.evicIndex)
+	}
+	a.forget(e)
+	for _, d := range e.dependents {
+		if a.pooled(d) {
+			a.remove(d)
+		}
+	}
+}
+
+// forget removes the information about transaction that has been already removed from the heaps.
+func (a *UtxImpl) forget(e *transactionEntry) {
+	delete(a.transactionIds, e.id)
+	pending := a.senders[e.sender]
+	for i, p := range pending {
+		if p == e {
+			pending = append(pending[:i:i], pending[i+1:]...)
+			break
+		}
+	}
+	if len(pending) == 0 {
+		delete(a.senders, e.sender)
+	} else {
+		a.senders[e.sender] = pending
+	}
+	if uint64(len(e.B)) > a.curSize {
+		panic(fmt.Sprintf("UtxImpl: size of transaction %d > than current size %d", len(e.B), a.curSize))
+	}
+	a.curSize -= uint64(len(e.B))
+}
+
 func (a *UtxImpl) Count() int {
 	a.mu.Lock()
 	defer a.mu.Unlock()
-	return len(a.transactions)
+	return len(a.transactionIds)
 }
 
 func makeDigest(b []byte, e error) crypto.Digest {
@@ -152,13 +448,19 @@ func (a *UtxImpl) Pop() *types.TransactionWithBytes {
 	a.mu.Lock()
 	defer a.mu.Unlock()
 	if a.transactions.Len() > 0 {
-		tb := heap.Pop(&a.transactions).(*types.TransactionWithBytes)
-		delete(a.transactionIds, makeDigest(tb.T.GetID(a.settings.AddressSchemeCharacter)))
-		if uint64(len(tb.B)) > a.curSize {
-			panic(fmt.Sprintf("UtxImpl Pop: size of transaction %d > than current size %d", len(tb.B), a.curSize))
+		e := heap.Pop(&a.transactions).(*transactionEntry)
+		heap.Remove(&a.evictions, e.evicIndex)
+		a.forget(e)
+		for _, d := range e.dependents {
+			if !a.pooled(d) {
+				continue
+			}
+			d.dependsOn--
+			if d.dependsOn == 0 {
+				heap.Push(&a.transactions, d)
+			}
 		}
-		a.curSize -= uint64(len(tb.B))
-		return tb
+		return e.TransactionWithBytes
 	}
 	return nil
 }
@@ -172,5 +474,5 @@ func (a *UtxImpl) CurSize() uint64 {
 func (a *UtxImpl) Len() int {
 	a.mu.Lock()
 	defer a.mu.Unlock()
-	return a.transactions.Len()
+	return len(a.transactionIds)
 }
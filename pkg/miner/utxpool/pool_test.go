@@ -2,6 +2,7 @@ package utxpool
 
 import (
 	"bytes"
+	"errors"
 	"math/rand"
 	"testing"
 	"time"
@@ -15,8 +16,9 @@ import (
 )
 
 type transaction struct {
-	fee uint64
-	id  []byte
+	fee    uint64
+	id     []byte
+	sender crypto.PublicKey
 }
 
 func (a transaction) BinarySize() int {
@@ -92,7 +94,7 @@ func (a transaction) GetFee() uint64 {
 }
 
 func (a transaction) GetSenderPK() crypto.PublicKey {
-	panic("implement me")
+	return a.sender
 }
 
 func tr(fee uint64) *transaction {
@@ -104,7 +106,7 @@ func id(b []byte, fee uint64) *transaction {
 }
 
 func TestTransactionPool(t *testing.T) {
-	a := New(10000, NoOpValidator{}, settings.MainNetSettings)
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 
 	require.EqualValues(t, 0, a.CurSize())
 	// add unique by id transactions, then check them sorted
@@ -125,7 +127,7 @@ func TestTransactionPool(t *testing.T) {
 func BenchmarkTransactionPool(b *testing.B) {
 	b.ReportAllocs()
 	rand.Seed(time.Now().Unix())
-	a := New(10000, NoOpValidator{}, settings.MainNetSettings)
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 
 	b.ResetTimer()
 	for i := 0; i < b.N; i++ {
@@ -149,7 +151,7 @@ func BenchmarkTransactionPool(b *testing.B) {
 }
 
 func TestTransactionPool_Exists(t *testing.T) {
-	a := New(10000, NoOpValidator{}, settings.MainNetSettings)
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 
 	require.False(t, a.Exists(id([]byte{1, 2, 3}, 0)))
 
@@ -160,9 +162,22 @@ func TestTransactionPool_Exists(t *testing.T) {
 	require.False(t, a.Exists(id([]byte{1, 2, 3}, 0)))
 }
 
+func TestUtxPool_Return(t *testing.T) {
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+
+	require.NoError(t, a.AddWithBytes(id([]byte{1, 2, 3}, 10), []byte{1}))
+	popped := a.Pop()
+	require.False(t, popped.Received.IsZero())
+	received := popped.Received
+
+	require.NoError(t, a.Return(popped))
+	require.True(t, a.Exists(id([]byte{1, 2, 3}, 0)))
+	require.Equal(t, received, a.Pop().Received)
+}
+
 // check transaction not added when limit
 func TestUtxPool_Limit(t *testing.T) {
-	a := New(10, NoOpValidator{}, settings.MainNetSettings)
+	a := New(10, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 	require.Equal(t, 0, a.Len())
 
 	// added
@@ -176,15 +191,156 @@ func TestUtxPool_Limit(t *testing.T) {
 	require.Error(t, added)
 }
 
+func sent(b []byte, fee uint64, sender byte) *transaction {
+	return &transaction{fee: fee, id: b, sender: crypto.PublicKey{sender}}
+}
+
+func TestUtxPool_Eviction(t *testing.T) {
+	a := New(30, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, a.AddWithBytes(sent([]byte{1}, 10, 1), bytes.Repeat([]byte{1}, 10)))
+	require.NoError(t, a.AddWithBytes(sent([]byte{2}, 30, 2), bytes.Repeat([]byte{1}, 10)))
+	require.NoError(t, a.AddWithBytes(sent([]byte{3}, 20, 3), bytes.Repeat([]byte{1}, 10)))
+
+	// lower fee per byte, not added
+	require.Error(t, a.AddWithBytes(sent([]byte{4}, 5, 4), bytes.Repeat([]byte{1}, 10)))
+	require.Equal(t, 3, a.Len())
+
+	// evicts two transactions with the lowest fee per byte
+	require.NoError(t, a.AddWithBytes(sent([]byte{5}, 100, 5), bytes.Repeat([]byte{1}, 20)))
+	require.Equal(t, 2, a.Len())
+	require.EqualValues(t, 30, a.CurSize())
+	require.False(t, a.ExistsByID(crypto.Digest{1}.Bytes()))
+	require.False(t, a.ExistsByID(crypto.Digest{3}.Bytes()))
+
+	// the first one is not enough to fit, nothing evicted
+	require.Error(t, a.AddWithBytes(sent([]byte{6}, 80, 6), bytes.Repeat([]byte{1}, 20)))
+	require.Equal(t, 2, a.Len())
+
+	require.EqualValues(t, 100, a.Pop().T.GetFee())
+	require.EqualValues(t, 30, a.Pop().T.GetFee())
+	require.Nil(t, a.Pop())
+	require.EqualValues(t, 0, a.CurSize())
+}
+
+func TestUtxPool_SenderLimit(t *testing.T) {
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	a.senderLimit = 2
+	require.NoError(t, a.AddWithBytes(sent([]byte{1}, 10, 1), []byte{1}))
+	require.NoError(t, a.AddWithBytes(sent([]byte{2}, 10, 1), []byte{1}))
+	require.Error(t, a.AddWithBytes(sent([]byte{3}, 10, 1), []byte{1}))
+	require.NoError(t, a.AddWithBytes(sent([]byte{4}, 10, 2), []byte{1}))
+
+	a.Pop()
+	require.Equal(t, 2, a.Len())
+	require.NoError(t, a.AddWithBytes(sent([]byte{3}, 10, 1), []byte{1}))
+}
+
+type rateConverter uint64
+
+func (r rateConverter) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	return assetAmount / uint64(r), nil
+}
+
+func TestUtxPool_SponsoredFee(t *testing.T) {
+	a := New(10000, NoOpValidator{}, rateConverter(1000), settings.MainNetSettings)
+	asset := proto.OptionalAsset{Present: true, ID: crypto.Digest{1}}
+	rcp := proto.NewRecipientFromAddress(proto.Address{})
+	sponsored := proto.NewUnsignedTransferWithProofs(2, crypto.PublicKey{1}, proto.OptionalAsset{}, asset, 1, 1, 1000000, rcp, &proto.LegacyAttachment{})
+	waves := proto.NewUnsignedTransferWithProofs(2, crypto.PublicKey{2}, proto.OptionalAsset{}, proto.OptionalAsset{}, 1, 1, 1000000, rcp, &proto.LegacyAttachment{})
+	require.NoError(t, a.AddWithBytes(sponsored, []byte{1}))
+	require.NoError(t, a.AddWithBytes(waves, []byte{1}))
+
+	// 1000000 of sponsored asset costs 1000 WAVES units
+	require.Equal(t, waves, a.Pop().T)
+	require.Equal(t, sponsored, a.Pop().T)
+}
+
 func TestUtxImpl_AllTransactions(t *testing.T) {
-	a := New(10, NoOpValidator{}, settings.MainNetSettings)
+	a := New(10, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 	_ = a.AddWithBytes(id([]byte{1, 2, 3}, 10), bytes.Repeat([]byte{1, 2}, 5))
 	require.Len(t, a.AllTransactions(), 1)
+
+	b := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
+	for i, fee := range []uint64{4, 1, 10, 8, 6} {
+		require.NoError(t, b.AddWithBytes(id([]byte{byte(i)}, fee), []byte{1}))
+	}
+	var fees []uint64
+	for _, tx := range b.AllTransactions() {
+		fees = append(fees, tx.T.GetFee())
+	}
+	require.Equal(t, []uint64{10, 8, 6, 4, 1}, fees)
+	require.Equal(t, 5, b.Len())
 }
 
 func TestUtxImpl_TransactionExists(t *testing.T) {
-	a := New(10000, NoOpValidator{}, settings.MainNetSettings)
+	a := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 	require.NoError(t, a.AddWithBytes(byte_helpers.BurnWithSig.Transaction, byte_helpers.BurnWithSig.TransactionBytes))
 	require.True(t, a.ExistsByID(byte_helpers.BurnWithSig.Transaction.ID.Bytes()))
 	require.False(t, a.ExistsByID(byte_helpers.TransferWithSig.Transaction.ID.Bytes()))
 }
+
+// dependencyValidator accepts the transactions with the first byte of ID equal to some other transaction's ID,
+// only on top of that transaction.
+type dependencyValidator map[byte]byte
+
+func (a dependencyValidator) Validate(t proto.Transaction, pending ...proto.Transaction) error {
+	id, _ := t.GetID(proto.MainNetScheme)
+	parent, ok := a[id[0]]
+	if !ok {
+		return nil
+	}
+	for _, p := range pending {
+		pid, _ := p.GetID(proto.MainNetScheme)
+		if pid[0] == parent {
+			return nil
+		}
+	}
+	return errors.New("depends on missing transaction")
+}
+
+func TestUtxPool_Dependencies(t *testing.T) {
+	a := New(10000, dependencyValidator{2: 1, 3: 2}, NoOpFeeConverter{}, settings.MainNetSettings)
+	// no transaction to depend on
+	require.Error(t, a.AddWithBytes(sent([]byte{2}, 100, 1), []byte{1}))
+
+	require.NoError(t, a.AddWithBytes(sent([]byte{1}, 1, 1), []byte{1}))
+	require.NoError(t, a.AddWithBytes(sent([]byte{2}, 100, 1), []byte{1}))
+	require.NoError(t, a.AddWithBytes(sent([]byte{3}, 50, 1), []byte{1}))
+	// transactions of other sender don't count
+	require.Error(t, a.AddWithBytes(sent([]byte{2, 1}, 100, 2), []byte{1}))
+	require.NoError(t, a.AddWithBytes(sent([]byte{4}, 10, 2), []byte{1}))
+	require.Equal(t, 4, a.Len())
+
+	var fees []uint64
+	for _, tx := range a.AllTransactions() {
+		fees = append(fees, tx.T.GetFee())
+	}
+	require.Equal(t, []uint64{10, 1, 100, 50}, fees)
+
+	// dependent transactions are popped after the ones they depend on despite higher fee
+	fees = nil
+	for tx := a.Pop(); tx != nil; tx = a.Pop() {
+		fees = append(fees, tx.T.GetFee())
+	}
+	require.Equal(t, []uint64{10, 1, 100, 50}, fees)
+	require.Equal(t, 0, a.Len())
+	require.EqualValues(t, 0, a.CurSize())
+}
+
+func TestUtxPool_EvictionOfDependencies(t *testing.T) {
+	a := New(30, dependencyValidator{2: 1}, NoOpFeeConverter{}, settings.MainNetSettings)
+	require.NoError(t, a.AddWithBytes(sent([]byte{1}, 1, 1), bytes.Repeat([]byte{1}, 10)))
+	require.NoError(t, a.AddWithBytes(sent([]byte{2}, 100, 1), bytes.Repeat([]byte{1}, 10)))
+	require.NoError(t, a.AddWithBytes(sent([]byte{3}, 20, 2), bytes.Repeat([]byte{1}, 10)))
+
+	// eviction of the first transaction removes the one depending on it
+	require.NoError(t, a.AddWithBytes(sent([]byte{4}, 30, 3), bytes.Repeat([]byte{1}, 10)))
+	require.Equal(t, 2, a.Len())
+	require.EqualValues(t, 20, a.CurSize())
+	require.False(t, a.ExistsByID(crypto.Digest{1}.Bytes()))
+	require.False(t, a.ExistsByID(crypto.Digest{2}.Bytes()))
+
+	require.EqualValues(t, 30, a.Pop().T.GetFee())
+	require.EqualValues(t, 20, a.Pop().T.GetFee())
+	require.Nil(t, a.Pop())
+}
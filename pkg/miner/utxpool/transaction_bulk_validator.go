@@ -32,7 +32,7 @@ func (a bulkValidator) Validate() {
 		return
 	}
 	for _, t := range transactions {
-		_ = a.utx.AddWithBytes(t.T, t.B)
+		_ = a.utx.Return(t)
 	}
 }
 
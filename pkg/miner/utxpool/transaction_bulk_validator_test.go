@@ -22,7 +22,7 @@ func TestBulkValidator_Validate(t *testing.T) {
 	m.EXPECT().TopBlock().Return(emptyBlock)
 	m.EXPECT().IsActivated(gomock.Any()).Return(false, nil)
 	m.EXPECT().TxValidation(gomock.Any()).Return(nil)
-	utx := New(10000, NoOpValidator{}, settings.MainNetSettings)
+	utx := New(10000, NoOpValidator{}, NoOpFeeConverter{}, settings.MainNetSettings)
 	require.NoError(t, utx.AddWithBytes(byte_helpers.TransferWithSig.Transaction, byte_helpers.TransferWithSig.TransactionBytes))
 
 	validator := newBulkValidator(m, utx, tm(now))
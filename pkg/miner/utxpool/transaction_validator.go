@@ -11,7 +11,8 @@ import (
 const DELTA = 86400 * 1000 / 6 // 4 hours
 
 type Validator interface {
-	Validate(t proto.Transaction) error
+	// Validate checks the transaction on top of the pending ones, that are validated first in given order.
+	Validate(t proto.Transaction, pending ...proto.Transaction) error
 }
 
 type ValidatorImpl struct {
@@ -26,7 +27,14 @@ func NewValidator(state stateWrapper, tm types.Time) *ValidatorImpl {
 	}
 }
 
-func (a *ValidatorImpl) Validate(t proto.Transaction) error {
+// Outdated tells if the last block is too old to validate transactions against the state,
+// that's the case while node is catching up with the network.
+func (a *ValidatorImpl) Outdated() bool {
+	currentTimestamp := proto.NewTimestampFromTime(a.tm.Now())
+	return currentTimestamp-a.state.TopBlock().Timestamp > DELTA
+}
+
+func (a *ValidatorImpl) Validate(t proto.Transaction, pending ...proto.Transaction) error {
 	currentTimestamp := proto.NewTimestampFromTime(a.tm.Now())
 	lastKnownBlock := a.state.TopBlock()
 	if currentTimestamp-lastKnownBlock.Timestamp > DELTA {
@@ -37,6 +45,10 @@ func (a *ValidatorImpl) Validate(t proto.Transaction) error {
 		return err
 	}
 	return a.state.TxValidation(func(validation state.TxValidation) error {
+		for _, p := range pending {
+			// Invalid pending transactions are just skipped, the ones depending on them fail validation.
+			_ = validation.ValidateNextTx(p, currentTimestamp, lastKnownBlock.Timestamp, lastKnownBlock.Version, checkScripts)
+		}
 		return validation.ValidateNextTx(t, currentTimestamp, lastKnownBlock.Timestamp, lastKnownBlock.Version, checkScripts)
 	})
 }
@@ -44,6 +56,6 @@ func (a *ValidatorImpl) Validate(t proto.Transaction) error {
 type NoOpValidator struct {
 }
 
-func (a NoOpValidator) Validate(t proto.Transaction) error {
+func (a NoOpValidator) Validate(t proto.Transaction, pending ...proto.Transaction) error {
 	return nil
 }
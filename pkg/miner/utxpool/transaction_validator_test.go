@@ -35,3 +35,18 @@ func TestValidatorImpl_Validate(t *testing.T) {
 	err := v.Validate(byte_helpers.BurnWithSig.Transaction)
 	require.NoError(t, err)
 }
+
+func TestValidatorImpl_Outdated(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	now := time.Now()
+	block := &proto.Block{}
+	block.Timestamp = proto.NewTimestampFromTime(now.Add(-5 * time.Hour))
+
+	m := NewMockstateWrapper(ctrl)
+	m.EXPECT().TopBlock().Return(block).Times(2)
+
+	require.True(t, NewValidator(m, tm(now)).Outdated())
+	require.False(t, NewValidator(m, tm(now.Add(-2*time.Hour))).Outdated())
+}
@@ -0,0 +1,195 @@
+package miner
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/types"
+	"go.uber.org/zap"
+)
+
+// VotesFileName is the name of the file in state directory the votes of miner are saved to.
+const VotesFileName = "miner.votes.json"
+
+// Voting keeps the desired reward and the features miner votes for. Votes can be changed at runtime,
+// every change is saved to the file if the path to it is set.
+type Voting struct {
+	mu    sync.Mutex
+	state featureState
+	path  string
+	votes types.MinerVotes
+}
+
+// InitialVotes are the votes given on start by command line flags.
+type InitialVotes struct {
+	Features Features
+	Reward   int64
+	// FeaturesSet and RewardSet tell that the votes were given explicitly, so they take precedence over the saved ones.
+	FeaturesSet bool
+	RewardSet   bool
+}
+
+// NewVoting creates the voting with given initial votes. The votes saved to the file by previous run take
+// precedence over the initial ones, unless those are set explicitly. Features are validated against the state.
+func NewVoting(state featureState, initial InitialVotes, path string) (*Voting, error) {
+	features, reward := initial.Features, initial.Reward
+	overridden := false
+	if path != "" {
+		votes, ok, err := load(path)
+		if err != nil {
+			return nil, err
+		}
+		if ok {
+			zap.S().Infof("Miner votes restored from '%s': reward %d, features %v", path, votes.Reward, votes.Features)
+			saved := make(Features, len(votes.Features))
+			for i, f := range votes.Features {
+				saved[i] = settings.Feature(f)
+			}
+			if !initial.FeaturesSet {
+				features = saved
+			} else if !equalFeatures(features, saved) {
+				zap.S().Warnf("Saved miner votes for features %v are replaced with %v from command line", saved, features)
+				overridden = true
+			}
+			if !initial.RewardSet {
+				reward = votes.Reward
+			} else if reward != votes.Reward {
+				zap.S().Warnf("Saved miner vote for reward %d is replaced with %d from command line", votes.Reward, reward)
+				overridden = true
+			}
+		}
+	}
+	// initial votes are not saved, only the changes made at runtime and the saved votes replaced on start are
+	v := &Voting{state: state}
+	if err := v.SetFeatures(FeaturesToInt16(features)); err != nil {
+		return nil, err
+	}
+	if err := v.SetReward(reward); err != nil {
+		return nil, err
+	}
+	v.path = path
+	if overridden {
+		if err := save(path, v.votes); err != nil {
+			return nil, err
+		}
+	}
+	return v, nil
+}
+
+func equalFeatures(a, b Features) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Votes returns the copy of current votes.
+func (a *Voting) Votes() types.MinerVotes {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return types.MinerVotes{
+		Reward:   a.votes.Reward,
+		Features: append([]int16{}, a.votes.Features...),
+	}
+}
+
+// SetReward sets the desired block reward, -1 means no vote.
+func (a *Voting) SetReward(reward int64) error {
+	return a.SetVotes(nil, &reward)
+}
+
+// SetFeatures replaces the voted features, activated and approved ones are dropped.
+func (a *Voting) SetFeatures(features []int16) error {
+	return a.SetVotes(&features, nil)
+}
+
+// SetVotes validates both votes first and then changes them at once, so nothing is changed if any vote is invalid.
+// Nil vote is left unchanged.
+func (a *Voting) SetVotes(features *[]int16, reward *int64) error {
+	if reward != nil && *reward < -1 {
+		return errors.Errorf("invalid reward %d", *reward)
+	}
+	var validated []int16
+	if features != nil {
+		fs := make(Features, len(*features))
+		for i, f := range *features {
+			fs[i] = settings.Feature(f)
+		}
+		v, err := ValidateFeatures(a.state, fs)
+		if err != nil {
+			return err
+		}
+		validated = FeaturesToInt16(v)
+		if validated == nil {
+			validated = make([]int16, 0)
+		}
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	votes := a.votes
+	if features != nil {
+		votes.Features = validated
+	}
+	if reward != nil {
+		votes.Reward = *reward
+	}
+	return a.update(votes)
+}
+
+func (a *Voting) update(votes types.MinerVotes) error {
+	if a.path != "" {
+		if err := save(a.path, votes); err != nil {
+			return err
+		}
+	}
+	a.votes = votes
+	return nil
+}
+
+func load(path string) (types.MinerVotes, bool, error) {
+	var votes types.MinerVotes
+	b, err := ioutil.ReadFile(path)
+	if os.IsNotExist(err) {
+		return votes, false, nil
+	}
+	if err != nil {
+		return votes, false, errors.Wrap(err, "failed to read miner votes")
+	}
+	if err := json.Unmarshal(b, &votes); err != nil {
+		return votes, false, errors.Wrap(err, "failed to read miner votes")
+	}
+	return votes, true, nil
+}
+
+// save replaces the file atomically, so the previous votes stay intact if writing fails.
+func save(path string, votes types.MinerVotes) error {
+	b, err := json.Marshal(votes)
+	if err != nil {
+		return err
+	}
+	f, err := ioutil.TempFile(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return errors.Wrap(err, "failed to save miner votes")
+	}
+	defer func() {
+		_ = f.Close()
+		_ = os.Remove(f.Name())
+	}()
+	if _, err := f.Write(b); err != nil {
+		return errors.Wrap(err, "failed to save miner votes")
+	}
+	if err := f.Close(); err != nil {
+		return errors.Wrap(err, "failed to save miner votes")
+	}
+	return errors.Wrap(os.Rename(f.Name(), path), "failed to save miner votes")
+}
@@ -0,0 +1,82 @@
+package miner
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+type votingState struct {
+	activated map[int16]bool
+}
+
+func (a votingState) IsActivated(featureID int16) (bool, error) {
+	return a.activated[featureID], nil
+}
+
+func (a votingState) IsApproved(featureID int16) (bool, error) {
+	return false, nil
+}
+
+func TestVotingSaveAndRestore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "voting")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, VotesFileName)
+	state := votingState{activated: map[int16]bool{2: true}}
+
+	v, err := NewVoting(state, InitialVotes{Features: Features{2, 8}, Reward: 600000000}, path)
+	require.NoError(t, err)
+	require.Equal(t, []int16{8}, v.Votes().Features)
+	require.EqualValues(t, 600000000, v.Votes().Reward)
+	// initial votes are not saved
+	_, err = os.Stat(path)
+	require.True(t, os.IsNotExist(err))
+
+	require.NoError(t, v.SetReward(700000000))
+	require.NoError(t, v.SetFeatures([]int16{14}))
+	require.Error(t, v.SetFeatures([]int16{10000}))
+	require.Error(t, v.SetReward(-2))
+	// nothing is changed if one of votes is invalid
+	reward, features := int64(800000000), []int16{10000}
+	require.Error(t, v.SetVotes(&features, &reward))
+	require.EqualValues(t, 700000000, v.Votes().Reward)
+
+	v, err = NewVoting(state, InitialVotes{Features: Features{8}, Reward: 600000000}, path)
+	require.NoError(t, err)
+	require.EqualValues(t, 700000000, v.Votes().Reward)
+	require.Equal(t, []int16{14}, v.Votes().Features)
+}
+
+func TestVotingExplicitVotes(t *testing.T) {
+	dir, err := ioutil.TempDir("", "voting")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, VotesFileName)
+	state := votingState{}
+
+	v, err := NewVoting(state, InitialVotes{Reward: 600000000}, path)
+	require.NoError(t, err)
+	require.NoError(t, v.SetReward(700000000))
+	require.NoError(t, v.SetFeatures([]int16{14}))
+
+	// explicit reward replaces the saved one, the saved features are kept
+	v, err = NewVoting(state, InitialVotes{Features: Features{8}, Reward: 500000000, RewardSet: true}, path)
+	require.NoError(t, err)
+	require.EqualValues(t, 500000000, v.Votes().Reward)
+	require.Equal(t, []int16{14}, v.Votes().Features)
+
+	// the replaced votes are saved
+	v, err = NewVoting(state, InitialVotes{}, path)
+	require.NoError(t, err)
+	require.EqualValues(t, 500000000, v.Votes().Reward)
+	require.Equal(t, []int16{14}, v.Votes().Features)
+
+	v, err = NewVoting(state, InitialVotes{Features: Features{8}, FeaturesSet: true}, path)
+	require.NoError(t, err)
+	require.EqualValues(t, 500000000, v.Votes().Reward)
+	require.Equal(t, []int16{8}, v.Votes().Features)
+}
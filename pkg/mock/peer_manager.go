@@ -7,11 +7,13 @@ package mock
 import (
 	context "context"
 	gomock "github.com/golang/mock/gomock"
+	peer_manager "github.com/wavesplatform/gowaves/pkg/node/peer_manager"
 	peer "github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	proto "github.com/wavesplatform/gowaves/pkg/proto"
 	big "math/big"
 	net "net"
 	reflect "reflect"
+	time "time"
 )
 
 // MockPeerManager is a mock of PeerManager interface
@@ -147,6 +149,74 @@ func (mr *MockPeerManagerMockRecorder) Suspended() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Suspended", reflect.TypeOf((*MockPeerManager)(nil).Suspended))
 }
 
+// Report mocks base method
+func (m *MockPeerManager) Report(arg0 peer.Peer, arg1 peer_manager.Event) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "Report", arg0, arg1)
+}
+
+// Report indicates an expected call of Report
+func (mr *MockPeerManagerMockRecorder) Report(arg0, arg1 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Report", reflect.TypeOf((*MockPeerManager)(nil).Report), arg0, arg1)
+}
+
+// Ban mocks base method
+func (m *MockPeerManager) Ban(ip net.IP, d time.Duration, reason string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Ban", ip, d, reason)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Ban indicates an expected call of Ban
+func (mr *MockPeerManagerMockRecorder) Ban(ip, d, reason interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Ban", reflect.TypeOf((*MockPeerManager)(nil).Ban), ip, d, reason)
+}
+
+// Unban mocks base method
+func (m *MockPeerManager) Unban(ip net.IP) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Unban", ip)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Unban indicates an expected call of Unban
+func (mr *MockPeerManagerMockRecorder) Unban(ip interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Unban", reflect.TypeOf((*MockPeerManager)(nil).Unban), ip)
+}
+
+// Banned mocks base method
+func (m *MockPeerManager) Banned() []peer_manager.Ban {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Banned")
+	ret0, _ := ret[0].([]peer_manager.Ban)
+	return ret0
+}
+
+// Banned indicates an expected call of Banned
+func (mr *MockPeerManagerMockRecorder) Banned() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Banned", reflect.TypeOf((*MockPeerManager)(nil).Banned))
+}
+
+// ClearBans mocks base method
+func (m *MockPeerManager) ClearBans() error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ClearBans")
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ClearBans indicates an expected call of ClearBans
+func (mr *MockPeerManagerMockRecorder) ClearBans() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ClearBans", reflect.TypeOf((*MockPeerManager)(nil).ClearBans))
+}
+
 // AddConnected mocks base method
 func (m *MockPeerManager) AddConnected(arg0 peer.Peer) {
 	m.ctrl.T.Helper()
@@ -190,17 +260,17 @@ func (mr *MockPeerManagerMockRecorder) UpdateScore(p, score interface{}) *gomock
 }
 
 // UpdateKnownPeers mocks base method
-func (m *MockPeerManager) UpdateKnownPeers(arg0 []proto.TCPAddr) error {
+func (m *MockPeerManager) UpdateKnownPeers(arg0 peer.Peer, arg1 []proto.TCPAddr) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "UpdateKnownPeers", arg0)
+	ret := m.ctrl.Call(m, "UpdateKnownPeers", arg0, arg1)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // UpdateKnownPeers indicates an expected call of UpdateKnownPeers
-func (mr *MockPeerManagerMockRecorder) UpdateKnownPeers(arg0 interface{}) *gomock.Call {
+func (mr *MockPeerManagerMockRecorder) UpdateKnownPeers(arg0, arg1 interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKnownPeers", reflect.TypeOf((*MockPeerManager)(nil).UpdateKnownPeers), arg0)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateKnownPeers", reflect.TypeOf((*MockPeerManager)(nil).UpdateKnownPeers), arg0, arg1)
 }
 
 // KnownPeers mocks base method
@@ -218,6 +288,20 @@ func (mr *MockPeerManagerMockRecorder) KnownPeers() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "KnownPeers", reflect.TypeOf((*MockPeerManager)(nil).KnownPeers))
 }
 
+// AddressBook mocks base method
+func (m *MockPeerManager) AddressBook() []peer_manager.KnownPeer {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddressBook")
+	ret0, _ := ret[0].([]peer_manager.KnownPeer)
+	return ret0
+}
+
+// AddressBook indicates an expected call of AddressBook
+func (mr *MockPeerManagerMockRecorder) AddressBook() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddressBook", reflect.TypeOf((*MockPeerManager)(nil).AddressBook))
+}
+
 // Close mocks base method
 func (m *MockPeerManager) Close() {
 	m.ctrl.T.Helper()
@@ -340,6 +340,20 @@ func (mr *MockStateInfoMockRecorder) BlockchainSettings() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockchainSettings", reflect.TypeOf((*MockStateInfo)(nil).BlockchainSettings))
 }
 
+// Checkpoints mocks base method
+func (m *MockStateInfo) Checkpoints() []settings.Checkpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoints")
+	ret0, _ := ret[0].([]settings.Checkpoint)
+	return ret0
+}
+
+// Checkpoints indicates an expected call of Checkpoints
+func (mr *MockStateInfoMockRecorder) Checkpoints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoints", reflect.TypeOf((*MockStateInfo)(nil).Checkpoints))
+}
+
 // Peers mocks base method
 func (m *MockStateInfo) Peers() ([]proto.TCPAddr, error) {
 	m.ctrl.T.Helper()
@@ -671,6 +685,21 @@ func (mr *MockStateInfoMockRecorder) AssetIsSponsored(assetID interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssetIsSponsored", reflect.TypeOf((*MockStateInfo)(nil).AssetIsSponsored), assetID)
 }
 
+// SponsoredAssetToWaves mocks base method
+func (m *MockStateInfo) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SponsoredAssetToWaves", assetID, assetAmount)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SponsoredAssetToWaves indicates an expected call of SponsoredAssetToWaves
+func (mr *MockStateInfoMockRecorder) SponsoredAssetToWaves(assetID, assetAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SponsoredAssetToWaves", reflect.TypeOf((*MockStateInfo)(nil).SponsoredAssetToWaves), assetID, assetAmount)
+}
+
 // AssetInfo mocks base method
 func (m *MockStateInfo) AssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error) {
 	m.ctrl.T.Helper()
@@ -1004,6 +1033,20 @@ func (mr *MockStateModifierMockRecorder) RollbackTo(removalEdge interface{}) *go
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTo", reflect.TypeOf((*MockStateModifier)(nil).RollbackTo), removalEdge)
 }
 
+// AddCheckpoints mocks base method
+func (m *MockStateModifier) AddCheckpoints(checkpoints []settings.Checkpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCheckpoints", checkpoints)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCheckpoints indicates an expected call of AddCheckpoints
+func (mr *MockStateModifierMockRecorder) AddCheckpoints(checkpoints interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCheckpoints", reflect.TypeOf((*MockStateModifier)(nil).AddCheckpoints), checkpoints)
+}
+
 // ValidateNextTx mocks base method
 func (m *MockStateModifier) ValidateNextTx(tx proto.Transaction, currentTimestamp, parentTimestamp uint64, blockVersion proto.BlockVersion, checkScripts bool) error {
 	m.ctrl.T.Helper()
@@ -1018,6 +1061,21 @@ func (mr *MockStateModifierMockRecorder) ValidateNextTx(tx, currentTimestamp, pa
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateNextTx", reflect.TypeOf((*MockStateModifier)(nil).ValidateNextTx), tx, currentTimestamp, parentTimestamp, blockVersion, checkScripts)
 }
 
+// ScriptsUsage mocks base method
+func (m *MockStateModifier) ScriptsUsage() (uint64, uint64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScriptsUsage")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint64)
+	return ret0, ret1
+}
+
+// ScriptsUsage indicates an expected call of ScriptsUsage
+func (mr *MockStateModifierMockRecorder) ScriptsUsage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptsUsage", reflect.TypeOf((*MockStateModifier)(nil).ScriptsUsage))
+}
+
 // ResetValidationList mocks base method
 func (m *MockStateModifier) ResetValidationList() {
 	m.ctrl.T.Helper()
@@ -1151,6 +1209,21 @@ func (mr *MockTxValidationMockRecorder) ValidateNextTx(tx, currentTimestamp, par
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateNextTx", reflect.TypeOf((*MockTxValidation)(nil).ValidateNextTx), tx, currentTimestamp, parentTimestamp, blockVersion, checkScripts)
 }
 
+// ScriptsUsage mocks base method
+func (m *MockTxValidation) ScriptsUsage() (uint64, uint64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScriptsUsage")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint64)
+	return ret0, ret1
+}
+
+// ScriptsUsage indicates an expected call of ScriptsUsage
+func (mr *MockTxValidationMockRecorder) ScriptsUsage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptsUsage", reflect.TypeOf((*MockTxValidation)(nil).ScriptsUsage))
+}
+
 // MockState is a mock of State interface
 type MockState struct {
 	ctrl     *gomock.Controller
@@ -1398,6 +1471,20 @@ func (mr *MockStateMockRecorder) BlockchainSettings() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockchainSettings", reflect.TypeOf((*MockState)(nil).BlockchainSettings))
 }
 
+// Checkpoints mocks base method
+func (m *MockState) Checkpoints() []settings.Checkpoint {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Checkpoints")
+	ret0, _ := ret[0].([]settings.Checkpoint)
+	return ret0
+}
+
+// Checkpoints indicates an expected call of Checkpoints
+func (mr *MockStateMockRecorder) Checkpoints() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Checkpoints", reflect.TypeOf((*MockState)(nil).Checkpoints))
+}
+
 // Peers mocks base method
 func (m *MockState) Peers() ([]proto.TCPAddr, error) {
 	m.ctrl.T.Helper()
@@ -1729,6 +1816,21 @@ func (mr *MockStateMockRecorder) AssetIsSponsored(assetID interface{}) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssetIsSponsored", reflect.TypeOf((*MockState)(nil).AssetIsSponsored), assetID)
 }
 
+// SponsoredAssetToWaves mocks base method
+func (m *MockState) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SponsoredAssetToWaves", assetID, assetAmount)
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SponsoredAssetToWaves indicates an expected call of SponsoredAssetToWaves
+func (mr *MockStateMockRecorder) SponsoredAssetToWaves(assetID, assetAmount interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SponsoredAssetToWaves", reflect.TypeOf((*MockState)(nil).SponsoredAssetToWaves), assetID, assetAmount)
+}
+
 // AssetInfo mocks base method
 func (m *MockState) AssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error) {
 	m.ctrl.T.Helper()
@@ -2039,6 +2141,20 @@ func (mr *MockStateMockRecorder) RollbackTo(removalEdge interface{}) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RollbackTo", reflect.TypeOf((*MockState)(nil).RollbackTo), removalEdge)
 }
 
+// AddCheckpoints mocks base method
+func (m *MockState) AddCheckpoints(checkpoints []settings.Checkpoint) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AddCheckpoints", checkpoints)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AddCheckpoints indicates an expected call of AddCheckpoints
+func (mr *MockStateMockRecorder) AddCheckpoints(checkpoints interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AddCheckpoints", reflect.TypeOf((*MockState)(nil).AddCheckpoints), checkpoints)
+}
+
 // ValidateNextTx mocks base method
 func (m *MockState) ValidateNextTx(tx proto.Transaction, currentTimestamp, parentTimestamp uint64, blockVersion proto.BlockVersion, checkScripts bool) error {
 	m.ctrl.T.Helper()
@@ -2053,6 +2169,21 @@ func (mr *MockStateMockRecorder) ValidateNextTx(tx, currentTimestamp, parentTime
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ValidateNextTx", reflect.TypeOf((*MockState)(nil).ValidateNextTx), tx, currentTimestamp, parentTimestamp, blockVersion, checkScripts)
 }
 
+// ScriptsUsage mocks base method
+func (m *MockState) ScriptsUsage() (uint64, uint64) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ScriptsUsage")
+	ret0, _ := ret[0].(uint64)
+	ret1, _ := ret[1].(uint64)
+	return ret0, ret1
+}
+
+// ScriptsUsage indicates an expected call of ScriptsUsage
+func (mr *MockStateMockRecorder) ScriptsUsage() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ScriptsUsage", reflect.TypeOf((*MockState)(nil).ScriptsUsage))
+}
+
 // ResetValidationList mocks base method
 func (m *MockState) ResetValidationList() {
 	m.ctrl.T.Helper()
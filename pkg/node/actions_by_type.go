@@ -7,11 +7,14 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
+	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer/extension"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
+	"github.com/wavesplatform/gowaves/pkg/settings"
 	"go.uber.org/zap"
 )
 
@@ -40,6 +43,15 @@ func GetPeersAction(services services.Services, mess peer.ProtoMessage, fsm stat
 	return fsm, nil, nil
 }
 
+func PeersAction(services services.Services, mess peer.ProtoMessage, fsm state_fsm.FSM) (state_fsm.FSM, state_fsm.Async, error) {
+	rs := mess.Message.(*proto.PeersMessage).Peers
+	addrs := make([]proto.TCPAddr, 0, len(rs))
+	for _, r := range rs {
+		addrs = append(addrs, proto.NewTCPAddr(r.Addr, int(r.Port)))
+	}
+	return fsm, nil, services.Peers.UpdateKnownPeers(mess.ID, addrs)
+}
+
 func BlockAction(services services.Services, mess peer.ProtoMessage, fsm state_fsm.FSM) (state_fsm.FSM, state_fsm.Async, error) {
 	b := &proto.Block{}
 	err := b.UnmarshalBinary(mess.Message.(*proto.BlockMessage).BlockBytes, services.Scheme)
@@ -206,11 +218,54 @@ func BlockIdsAction(_ services.Services, mess peer.ProtoMessage, fsm state_fsm.F
 	return fsm.BlockIDs(mess.ID, mess.Message.(*proto.BlockIdsMessage).Blocks)
 }
 
+// CheckpointAction saves checkpoints signed by the issuer from settings, others are ignored.
+// If conflicting blocks were rolled back, mining is rescheduled and UTX is cleaned of transactions invalid on new top.
+func CheckpointAction(services services.Services, mess peer.ProtoMessage, fsm state_fsm.FSM) (state_fsm.FSM, state_fsm.Async, error) {
+	s, err := services.State.BlockchainSettings()
+	if err != nil {
+		return fsm, nil, err
+	}
+	if s.CheckpointsPublicKey == nil {
+		return fsm, nil, nil
+	}
+	m := mess.Message.(*proto.CheckPointMessage)
+	if !m.Verify(*s.CheckpointsPublicKey) {
+		services.Peers.Report(mess.ID, peer_manager.ProtocolViolation)
+		return fsm, nil, errors.New("CheckpointAction: invalid signature")
+	}
+	checkpoints := make([]settings.Checkpoint, len(m.Checkpoints))
+	for i, c := range m.Checkpoints {
+		checkpoints[i] = settings.Checkpoint{
+			Height:  c.Height,
+			BlockID: proto.NewBlockIDFromSignature(c.Signature),
+		}
+	}
+	height, err := services.State.Height()
+	if err != nil {
+		return fsm, nil, errors.Wrap(err, "CheckpointAction")
+	}
+	err = services.State.AddCheckpoints(checkpoints)
+	if err != nil {
+		return fsm, nil, errors.Wrap(err, "CheckpointAction")
+	}
+	newHeight, err := services.State.Height()
+	if err != nil {
+		return fsm, nil, errors.Wrap(err, "CheckpointAction")
+	}
+	if newHeight < height {
+		// blocks conflicting with checkpoints were rolled back, the same as after applying blocks of other branch
+		services.Scheduler.Reschedule()
+		utxpool.NewCleaner(services.State, services.UtxPool, services.Time).Clean()
+	}
+	return fsm, nil, nil
+}
+
 // TODO broadcast
 func TransactionAction(s services.Services, mess peer.ProtoMessage, fsm state_fsm.FSM) (state_fsm.FSM, state_fsm.Async, error) {
 	tbts := mess.Message.(*proto.TransactionMessage).Transaction
 	t, err := proto.BytesToTransaction(tbts, s.Scheme)
 	if err != nil {
+		s.Peers.Report(mess.ID, peer_manager.InvalidTransaction)
 		return fsm, nil, err
 	}
 	return fsm.Transaction(mess.ID, t)
@@ -220,6 +275,7 @@ func CreateActions() map[reflect.Type]Action {
 	return map[reflect.Type]Action{
 		reflect.TypeOf(&proto.ScoreMessage{}):             ScoreAction,
 		reflect.TypeOf(&proto.GetPeersMessage{}):          GetPeersAction,
+		reflect.TypeOf(&proto.PeersMessage{}):             PeersAction,
 		reflect.TypeOf(&proto.BlockMessage{}):             BlockAction,
 		reflect.TypeOf(&proto.GetBlockMessage{}):          GetBlockAction,
 		reflect.TypeOf(&proto.SignaturesMessage{}):        SignaturesAction,
@@ -231,6 +287,7 @@ func CreateActions() map[reflect.Type]Action {
 		reflect.TypeOf(&proto.PBMicroBlockMessage{}):      PBMicroBlockAction,
 		reflect.TypeOf(&proto.GetBlockIdsMessage{}):       GetBlockIdsAction,
 		reflect.TypeOf(&proto.BlockIdsMessage{}):          BlockIdsAction,
+		reflect.TypeOf(&proto.CheckPointMessage{}):        CheckpointAction,
 
 		reflect.TypeOf(&proto.TransactionMessage{}): TransactionAction,
 	}
@@ -174,6 +174,10 @@ func (a *MockStateManager) ResetValidationList() {
 
 }
 
+func (a *MockStateManager) ScriptsUsage() (uint64, uint64) {
+	panic("implement me")
+}
+
 func (a *MockStateManager) SavePeers([]proto.TCPAddr) error {
 	panic("implement me")
 }
@@ -221,6 +225,10 @@ func (a *MockStateManager) AssetIsSponsored(assetID crypto.Digest) (bool, error)
 	panic("implement me")
 }
 
+func (a *MockStateManager) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	panic("implement me")
+}
+
 func (a *MockStateManager) AssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error) {
 	panic("implement me")
 }
@@ -416,7 +424,7 @@ func (*mockPeerManager) SpawnOutgoingConnections(ctx context.Context) {
 	panic("implement me")
 }
 
-func (*mockPeerManager) UpdateKnownPeers([]proto.TCPAddr) error {
+func (*mockPeerManager) UpdateKnownPeers(peer.Peer, []proto.TCPAddr) error {
 	panic("implement me")
 }
 
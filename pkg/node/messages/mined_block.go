@@ -1,23 +1,24 @@
 package messages
 
 import (
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/util/common"
 )
 
 type MinedBlockInternalMessage struct {
-	Block   *proto.Block
-	Limits  proto.MiningLimits
-	KeyPair proto.KeyPair
-	Vrf     []byte
+	Block     *proto.Block
+	Limits    proto.MiningLimits
+	PublicKey crypto.PublicKey
+	Vrf       []byte
 }
 
-func NewMinedBlockInternalMessage(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) *MinedBlockInternalMessage {
+func NewMinedBlockInternalMessage(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) *MinedBlockInternalMessage {
 	return &MinedBlockInternalMessage{
-		Block:   block,
-		Limits:  limits,
-		KeyPair: keyPair,
-		Vrf:     common.Dup(vrf),
+		Block:     block,
+		Limits:    limits,
+		PublicKey: pk,
+		Vrf:       common.Dup(vrf),
 	}
 }
 
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"net"
 	"reflect"
+	"strings"
 	"time"
 
 	"github.com/wavesplatform/gowaves/pkg/libs/runner"
@@ -36,6 +37,7 @@ type Node struct {
 	utx       types.UtxPool
 	services  services.Services
 	outdate   proto.Timestamp
+	limiter   *rateLimiter
 }
 
 func NewNode(services services.Services, declAddr proto.TCPAddr, bindAddr proto.TCPAddr, outdate proto.Timestamp) *Node {
@@ -51,6 +53,7 @@ func NewNode(services services.Services, declAddr proto.TCPAddr, bindAddr proto.
 		utx:       services.UtxPool,
 		services:  services,
 		outdate:   outdate,
+		limiter:   newRateLimiter(messageLimits),
 	}
 }
 
@@ -127,17 +130,24 @@ func (a *Node) Run(ctx context.Context, p peer.Parent, InternalMessageCh chan me
 	}
 	spawnAsync(ctx, tasksCh, a.services.LoggableRunner, async)
 	actions := CreateActions()
+	a.updateStatus(fsm, "Start", nil, nil)
 
 	for {
+		// event and peer that caused the transition of FSM
+		var event string
+		var from peer.Peer
 		select {
 		case internalMess := <-InternalMessageCh:
 			switch t := internalMess.(type) {
 			case *messages.MinedBlockInternalMessage:
-				fsm, async, err = fsm.MinedBlock(t.Block, t.Limits, t.KeyPair, t.Vrf)
+				event = "MinedBlock"
+				fsm, async, err = fsm.MinedBlock(t.Block, t.Limits, t.PublicKey, t.Vrf)
 			case *messages.HaltMessage:
+				event = "Halt"
 				fsm, async, err = fsm.Halt()
 				t.Complete()
 			case *messages.BroadcastTransaction:
+				event = "BroadcastTransaction"
 				fsm, async, err = fsm.Transaction(nil, t.Transaction)
 				select {
 				case t.Response <- err:
@@ -148,13 +158,21 @@ func (a *Node) Run(ctx context.Context, p peer.Parent, InternalMessageCh chan me
 				continue
 			}
 		case task := <-tasksCh:
+			event = "Task " + task.Name()
 			fsm, async, err = fsm.Task(task)
 		case m := <-p.InfoCh:
+			from = m.Peer
 			switch t := m.Value.(type) {
 			case *peer.Connected:
+				event = "NewPeer"
 				fsm, async, err = fsm.NewPeer(t.Peer)
 			case error:
+				event = "PeerError"
 				zap.S().Error("infoCH error ", m.Peer, t)
+				a.limiter.remove(m.Peer)
+				if _, ok := t.(*peer.InvalidMessageError); ok {
+					a.peers.Report(m.Peer, peer_manager.ProtocolViolation)
+				}
 				fsm, async, err = fsm.PeerError(m.Peer, t)
 			}
 		case mess := <-p.MessageCh:
@@ -164,16 +182,38 @@ func (a *Node) Run(ctx context.Context, p peer.Parent, InternalMessageCh chan me
 				zap.S().Errorf("unknown proto Message %T", mess.Message)
 				continue
 			}
+			if allowed, exceeded := a.limiter.allow(mess.ID, mess.Message, a.services.Time.Now()); !allowed {
+				zap.S().Debugf("Peer %s exceeded the limit of %T messages", mess.ID.ID(), mess.Message)
+				if exceeded {
+					a.peers.Report(mess.ID, peer_manager.ProtocolViolation)
+				}
+				continue
+			}
+			event = strings.TrimPrefix(reflect.TypeOf(mess.Message).String(), "*proto.")
+			from = mess.ID
 			fsm, async, err = action(a.services, mess, fsm)
 		}
 		if err != nil {
 			zap.S().Errorf("Failure during synchronization: %v", err)
 		}
+		a.updateStatus(fsm, event, from, err)
 		spawnAsync(ctx, tasksCh, a.services.LoggableRunner, async)
 		zap.S().Debugf("FSM %T", fsm)
 	}
 }
 
+func (a *Node) updateStatus(fsm state_fsm.FSM, event string, p peer.Peer, err error) {
+	if a.services.FsmStatus == nil {
+		return
+	}
+	id := ""
+	if p != nil {
+		id = p.ID()
+	}
+	state, sync := state_fsm.Status(fsm)
+	a.services.FsmStatus.Update(state, sync, event, id, err, time.Now())
+}
+
 type BlockIds struct {
 	ids    []proto.BlockID
 	unique map[proto.BlockID]struct{}
@@ -0,0 +1,228 @@
+package peer_manager
+
+import (
+	"sort"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+const (
+	// when address book is full, new addresses replace the entries with failed attempts or are ignored
+	maxKnownPeers = 1000
+	// one peer can't fill the address book with its addresses
+	maxKnownPeersFromSource = 100
+	// peer is dead after this number of failed attempts if it was not connected for deadAge
+	maxFailures = 5
+	deadAge     = 7 * 24 * time.Hour
+	// delay between connection attempts doubles with every failed attempt
+	retryDelay    = time.Minute
+	maxRetryDelay = 2 * time.Hour
+)
+
+// Sources of address that are not peers.
+const (
+	SourceManual   = "manual"
+	SourceDeclared = "declared"
+	SourceMigrated = "migrated"
+)
+
+// KnownPeer is the entry of address book. Time fields are zero if the event never happened.
+type KnownPeer struct {
+	Addr          proto.TCPAddr `json:"addr"`
+	FirstSeen     time.Time     `json:"firstSeen"`
+	LastConnected time.Time     `json:"lastConnected"`
+	LastAttempt   time.Time     `json:"lastAttempt"`
+	// Failures is the number of failed connection attempts since the last successful connection.
+	Failures int `json:"failures"`
+	// Source is ID of the peer that sent the address, or one of Source constants.
+	Source string `json:"source"`
+	// Declared is the address declared by peer in handshake,
+	// Observed is the remote address of the last connection with peer.
+	Declared proto.TCPAddr `json:"declared"`
+	Observed proto.TCPAddr `json:"observed"`
+	Version  string        `json:"version"`
+}
+
+func (a KnownPeer) dead(now time.Time) bool {
+	if a.Failures < maxFailures {
+		return false
+	}
+	return a.LastConnected.IsZero() || now.Sub(a.LastConnected) > deadAge
+}
+
+// ready tells if enough time passed since the last failed attempt to try again.
+func (a KnownPeer) ready(now time.Time) bool {
+	if a.Failures == 0 {
+		return true
+	}
+	d := retryDelay
+	for i := 1; i < a.Failures && d < maxRetryDelay; i++ {
+		d *= 2
+	}
+	if d > maxRetryDelay {
+		d = maxRetryDelay
+	}
+	return now.Sub(a.LastAttempt) >= d
+}
+
+// byReliability puts the recently connected peers first, then the peers with fewer failures and older ones.
+type byReliability []KnownPeer
+
+func (a byReliability) Len() int      { return len(a) }
+func (a byReliability) Swap(i, j int) { a[i], a[j] = a[j], a[i] }
+func (a byReliability) Less(i, j int) bool {
+	if !a[i].LastConnected.Equal(a[j].LastConnected) {
+		return a[i].LastConnected.After(a[j].LastConnected)
+	}
+	if a[i].Failures != a[j].Failures {
+		return a[i].Failures < a[j].Failures
+	}
+	if !a[i].FirstSeen.Equal(a[j].FirstSeen) {
+		return a[i].FirstSeen.Before(a[j].FirstSeen)
+	}
+	return a[i].Addr.String() < a[j].Addr.String()
+}
+
+// addressBook keeps the peers known to node and the quality of connections with them, non thread safe.
+type addressBook map[proto.IpPort]*KnownPeer
+
+func newAddressBook(peers []KnownPeer) addressBook {
+	out := addressBook{}
+	for i := range peers {
+		p := peers[i]
+		out[p.Addr.ToIpPort()] = &p
+	}
+	return out
+}
+
+func validAddr(addr proto.TCPAddr) bool {
+	return !addr.Empty() && addr.Port > 0 && addr.Port <= 0xffff
+}
+
+// add adds new address, it returns false if the address is invalid, already known or the book is full
+// and there is no entry to evict.
+func (a addressBook) add(addr proto.TCPAddr, source string, now time.Time) bool {
+	if !validAddr(addr) {
+		return false
+	}
+	key := addr.ToIpPort()
+	if _, ok := a[key]; ok {
+		return false
+	}
+	if len(a) >= maxKnownPeers && !a.evict() {
+		return false
+	}
+	a[key] = &KnownPeer{Addr: key.ToTcpAddr(), FirstSeen: now, Source: source}
+	return true
+}
+
+// addReceived adds the addresses received from peer until the number of entries from it reaches
+// maxKnownPeersFromSource, it returns the number of added addresses.
+func (a addressBook) addReceived(addrs []proto.TCPAddr, source string, now time.Time) int {
+	fromSource := 0
+	for _, p := range a {
+		if p.Source == source {
+			fromSource++
+		}
+	}
+	added := 0
+	for _, addr := range addrs {
+		if fromSource >= maxKnownPeersFromSource {
+			break
+		}
+		if a.add(addr, source, now) {
+			added++
+			fromSource++
+		}
+	}
+	return added
+}
+
+// evict removes the least reliable entry with failed attempts and returns false if there is no such entry.
+// Entries that were not tried yet are kept, so the flood of new addresses can't replace them.
+func (a addressBook) evict() bool {
+	var worst *KnownPeer
+	for _, p := range a {
+		if p.Failures > 0 && (worst == nil || byReliability{*worst, *p}.Less(0, 1)) {
+			worst = p
+		}
+	}
+	if worst == nil {
+		return false
+	}
+	delete(a, worst.Addr.ToIpPort())
+	return true
+}
+
+func (a addressBook) attempt(addr proto.TCPAddr, now time.Time) {
+	if p, ok := a[addr.ToIpPort()]; ok {
+		p.LastAttempt = now
+	}
+}
+
+// connected records the successful connection. Incoming peer is recorded under its declared address,
+// incoming peers without declared address are not recorded.
+func (a addressBook) connected(p peer.Peer, now time.Time) bool {
+	h := p.Handshake()
+	addr := p.RemoteAddr()
+	if p.Direction() == peer.Incoming {
+		addr = proto.TCPAddr(h.DeclaredAddr)
+		a.add(addr, SourceDeclared, now)
+	}
+	known, ok := a[addr.ToIpPort()]
+	if !ok {
+		return false
+	}
+	known.LastConnected = now
+	known.Failures = 0
+	known.Declared = proto.TCPAddr(h.DeclaredAddr)
+	known.Observed = p.RemoteAddr()
+	known.Version = h.Version.String()
+	return true
+}
+
+// finished records the end of connection attempt, it is failed if the peer was not connected since the attempt.
+func (a addressBook) finished(addr proto.TCPAddr) {
+	p, ok := a[addr.ToIpPort()]
+	if !ok {
+		return
+	}
+	if p.LastConnected.Before(p.LastAttempt) {
+		p.Failures++
+	}
+}
+
+// prune removes dead peers and returns true if any was removed.
+func (a addressBook) prune(now time.Time) bool {
+	removed := false
+	for k, p := range a {
+		if p.dead(now) {
+			delete(a, k)
+			removed = true
+		}
+	}
+	return removed
+}
+
+// list returns all entries ordered by reliability.
+func (a addressBook) list() []KnownPeer {
+	out := make([]KnownPeer, 0, len(a))
+	for _, p := range a {
+		out = append(out, *p)
+	}
+	sort.Sort(byReliability(out))
+	return out
+}
+
+// candidates returns addresses ready to connect ordered by reliability.
+func (a addressBook) candidates(now time.Time) []proto.TCPAddr {
+	var out []proto.TCPAddr
+	for _, p := range a.list() {
+		if !p.dead(now) && p.ready(now) {
+			out = append(out, p.Addr)
+		}
+	}
+	return out
+}
@@ -0,0 +1,128 @@
+package peer_manager
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func TestAddressBook_Add(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	b := addressBook{}
+	addr := proto.NewTCPAddr(net.IPv4(8, 8, 8, 8), 6868)
+	require.True(t, b.add(addr, "1.1.1.1-1", now))
+	require.False(t, b.add(addr, "2.2.2.2-1", now), "already known")
+	require.Equal(t, "1.1.1.1-1", b[addr.ToIpPort()].Source)
+	require.False(t, b.add(proto.NewTCPAddr(net.IPv4zero, 6868), SourceManual, now))
+	require.False(t, b.add(proto.NewTCPAddr(net.IPv4(8, 8, 8, 9), 0), SourceManual, now))
+}
+
+func TestAddressBook_Full(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	b := addressBook{}
+	for i := 0; i < maxKnownPeers; i++ {
+		require.True(t, b.add(proto.NewTCPAddr(net.IPv4(10, 0, byte(i/256), byte(i%256)), 6868), SourceManual, now))
+	}
+	// untried entries are not replaced
+	addr := proto.NewTCPAddr(net.IPv4(8, 8, 8, 8), 6868)
+	require.False(t, b.add(addr, SourceManual, now))
+
+	// the entry with the most failures is replaced
+	failing := proto.NewTCPAddr(net.IPv4(10, 0, 0, 1), 6868)
+	worst := proto.NewTCPAddr(net.IPv4(10, 0, 0, 2), 6868)
+	b[failing.ToIpPort()].Failures = 1
+	b[worst.ToIpPort()].Failures = 2
+	require.True(t, b.add(addr, SourceManual, now))
+	require.Len(t, b, maxKnownPeers)
+	require.NotContains(t, b, worst.ToIpPort())
+	require.Contains(t, b, failing.ToIpPort())
+	require.Contains(t, b, addr.ToIpPort())
+}
+
+func TestAddressBook_AddReceived(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	b := addressBook{}
+	addrs := make([]proto.TCPAddr, maxKnownPeersFromSource+10)
+	for i := range addrs {
+		addrs[i] = proto.NewTCPAddr(net.IPv4(10, 0, 0, byte(i)), 6868)
+	}
+	require.Equal(t, 10, b.addReceived(addrs[:10], "1.1.1.1-1", now))
+	require.Equal(t, maxKnownPeersFromSource-10, b.addReceived(addrs, "1.1.1.1-1", now))
+	require.Equal(t, 0, b.addReceived(addrs, "1.1.1.1-1", now))
+	require.Equal(t, 10, b.addReceived(addrs, "2.2.2.2-1", now))
+	require.Len(t, b, maxKnownPeersFromSource+10)
+}
+
+func TestAddressBook_Reliability(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	b := addressBook{}
+	reliable := proto.NewTCPAddr(net.IPv4(8, 8, 8, 1), 6868)
+	fresh := proto.NewTCPAddr(net.IPv4(8, 8, 8, 2), 6868)
+	failing := proto.NewTCPAddr(net.IPv4(8, 8, 8, 3), 6868)
+	for _, addr := range []proto.TCPAddr{failing, fresh, reliable} {
+		b.add(addr, SourceManual, now)
+	}
+
+	// outgoing connection succeeded
+	b.attempt(reliable, now)
+	require.True(t, b.connected(&outgoingPeer{addrPeer{id: "8.8.8.1-1", ip: reliable.IP}}, now))
+	b.finished(reliable)
+	require.Equal(t, 0, b[reliable.ToIpPort()].Failures)
+
+	// connection failed, the next attempt is delayed
+	b.attempt(failing, now)
+	b.finished(failing)
+	require.Equal(t, 1, b[failing.ToIpPort()].Failures)
+	require.Equal(t, []proto.TCPAddr{reliable, fresh}, b.candidates(now.Add(time.Second)))
+	require.Equal(t, []proto.TCPAddr{reliable, fresh, failing}, b.candidates(now.Add(retryDelay)))
+
+	// dead peer is removed
+	for i := 1; i < maxFailures; i++ {
+		b.attempt(failing, now)
+		b.finished(failing)
+	}
+	require.True(t, b.prune(now))
+	require.Len(t, b, 2)
+	require.False(t, b.prune(now))
+}
+
+func TestAddressBook_ConnectedIncoming(t *testing.T) {
+	now := time.Unix(1600000000, 0)
+	b := addressBook{}
+	declared := proto.NewTCPAddr(net.IPv4(8, 8, 8, 8), 6868)
+	p := &declaredPeer{addrPeer: addrPeer{id: "8.8.8.8-1", ip: net.IPv4(8, 8, 8, 8)}, declared: declared}
+	require.True(t, b.connected(p, now))
+	known := b[declared.ToIpPort()]
+	require.Equal(t, SourceDeclared, known.Source)
+	require.True(t, now.Equal(known.LastConnected))
+	require.Equal(t, "8.8.8.8:6868", known.Observed.String())
+	require.Equal(t, "1.2.3", known.Version)
+
+	// incoming peer without declared address is not recorded
+	require.False(t, b.connected(&addrPeer{id: "8.8.8.9-1", ip: net.IPv4(8, 8, 8, 9)}, now))
+}
+
+type outgoingPeer struct {
+	addrPeer
+}
+
+func (a *outgoingPeer) Direction() peer.Direction {
+	return peer.Outgoing
+}
+
+type declaredPeer struct {
+	addrPeer
+	declared proto.TCPAddr
+}
+
+func (a *declaredPeer) Direction() peer.Direction {
+	return peer.Incoming
+}
+
+func (a *declaredPeer) Handshake() proto.Handshake {
+	return proto.Handshake{Version: proto.NewVersion(1, 2, 3), DeclaredAddr: proto.HandshakeTCPAddr(a.declared)}
+}
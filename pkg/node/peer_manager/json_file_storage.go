@@ -6,6 +6,7 @@ import (
 	"os"
 	"path"
 	"sync"
+	"time"
 
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"go.uber.org/zap"
@@ -13,51 +14,108 @@ import (
 
 type JsonFileStorage struct {
 	fullpath string
-	peers    []proto.TCPAddr
+	bansPath string
+	peers    []KnownPeer
+	bans     []Ban
 	sync.Mutex
 }
 
-func (a *JsonFileStorage) SavePeers(addrs []proto.TCPAddr) error {
+func (a *JsonFileStorage) SavePeers(peers []KnownPeer) error {
 	a.Lock()
 	defer a.Unlock()
-	a.peers = addrs
-	bts, err := json.Marshal(addrs)
+	a.peers = peers
+	bts, err := json.Marshal(peers)
 	if err != nil {
 		return err
 	}
-	zap.S().Debugf("*JsonFileStorage SavePeers %s", string(bts))
+	zap.S().Debugf("*JsonFileStorage SavePeers %d peers", len(peers))
 	return ioutil.WriteFile(a.fullpath, bts, 0644)
 }
 
-func (a *JsonFileStorage) Peers() ([]proto.TCPAddr, error) {
+func (a *JsonFileStorage) Peers() ([]KnownPeer, error) {
 	a.Lock()
 	defer a.Unlock()
 	zap.S().Debugf("*JsonFileStorage Peers %+v", a.peers)
 	return a.peers, nil
 }
 
+func (a *JsonFileStorage) SaveBans(bans []Ban) error {
+	a.Lock()
+	defer a.Unlock()
+	a.bans = bans
+	bts, err := json.Marshal(bans)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(a.bansPath, bts, 0644)
+}
+
+func (a *JsonFileStorage) Bans() ([]Ban, error) {
+	a.Lock()
+	defer a.Unlock()
+	return a.bans, nil
+}
+
 func NewJsonFileStorage(p string) (*JsonFileStorage, error) {
 	// if directory not writable or other problems, state fill fail before this
-	fullpath := path.Join(p, "blocks_storage", "peers.dat")
-	bts, err := ioutil.ReadFile(fullpath)
-	if err != nil {
-		if os.IsNotExist(err) {
-			return &JsonFileStorage{
-				fullpath: fullpath,
-				peers:    nil,
-				Mutex:    sync.Mutex{},
-			}, nil
-		}
+	fullpath := path.Join(p, "blocks_storage", "known_peers.dat")
+	legacyPath := path.Join(p, "blocks_storage", "peers.dat")
+	bansPath := path.Join(p, "blocks_storage", "bans.dat")
+	var peers []KnownPeer
+	if err := readJsonFile(fullpath, &peers); err != nil {
 		return nil, err
 	}
-	var peers []proto.TCPAddr
-	err = json.Unmarshal(bts, &peers)
-	if err != nil {
+	var bans []Ban
+	if err := readJsonFile(bansPath, &bans); err != nil {
 		return nil, err
 	}
-	return &JsonFileStorage{
+	s := &JsonFileStorage{
 		fullpath: fullpath,
+		bansPath: bansPath,
 		peers:    peers,
+		bans:     bans,
 		Mutex:    sync.Mutex{},
-	}, nil
+	}
+	if peers == nil {
+		legacy, err := readLegacyPeers(legacyPath, time.Now())
+		if err != nil {
+			return nil, err
+		}
+		if len(legacy) > 0 {
+			if err := s.SavePeers(legacy); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return s, nil
+}
+
+// readLegacyPeers reads the list of addresses stored by previous versions of node.
+// The file is left untouched, so previous versions could still use it.
+func readLegacyPeers(path string, now time.Time) ([]KnownPeer, error) {
+	var addrs []proto.TCPAddr
+	if err := readJsonFile(path, &addrs); err != nil {
+		return nil, err
+	}
+	book := addressBook{}
+	for _, addr := range addrs {
+		book.add(addr, SourceMigrated, now)
+	}
+	if len(book) == 0 {
+		return nil, nil
+	}
+	zap.S().Infof("Migrated %d known peers from %s", len(book), path)
+	return book.list(), nil
+}
+
+// readJsonFile leaves the value untouched if the file doesn't exist.
+func readJsonFile(path string, v interface{}) error {
+	bts, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		return err
+	}
+	return json.Unmarshal(bts, v)
 }
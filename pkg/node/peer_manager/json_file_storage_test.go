@@ -2,9 +2,11 @@ package peer_manager
 
 import (
 	"io/ioutil"
+	"net"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 	"github.com/wavesplatform/gowaves/pkg/proto"
@@ -24,17 +26,82 @@ func TestJsonFileStorage_Peers(t *testing.T) {
 	require.NoError(t, err)
 	require.Len(t, peers, 0)
 
-	addrs := []proto.TCPAddr{
-		proto.NewTCPAddrFromString("127.0.0.1:8080"),
-		proto.NewTCPAddrFromString("0.0.0.0:6862"),
+	now := time.Unix(1600000000, 0).UTC()
+	known := []KnownPeer{
+		{Addr: proto.NewTCPAddrFromString("127.0.0.1:8080"), FirstSeen: now, Source: SourceManual},
+		{Addr: proto.NewTCPAddrFromString("8.8.8.8:6862"), FirstSeen: now, LastConnected: now, Source: "1.1.1.1-10", Version: "1.2.3"},
 	}
 
-	err = s.SavePeers(addrs)
+	err = s.SavePeers(known)
 	require.NoError(t, err)
 
 	s, err = NewJsonFileStorage(d)
 	require.NoError(t, err)
 	peers, err = s.Peers()
 	require.NoError(t, err)
-	require.Equal(t, addrs, peers)
+	require.Len(t, peers, 2)
+	require.Equal(t, known[0].Addr.String(), peers[0].Addr.String())
+	require.True(t, known[1].LastConnected.Equal(peers[1].LastConnected))
+	require.Equal(t, known[1].Source, peers[1].Source)
+	require.Equal(t, known[1].Version, peers[1].Version)
+}
+
+func TestJsonFileStorage_MigratePeers(t *testing.T) {
+	d, err := ioutil.TempDir("", "abc")
+	require.NoError(t, err)
+	defer os.RemoveAll(d)
+
+	err = os.Mkdir(path.Join(d, "blocks_storage"), 0755)
+	require.NoError(t, err)
+	// peers stored by previous versions
+	legacy := []byte(`[{"IP":"127.0.0.1","Port":8080,"Zone":""},{"IP":"0.0.0.0","Port":6862,"Zone":""}]`)
+	require.NoError(t, ioutil.WriteFile(path.Join(d, "blocks_storage", "peers.dat"), legacy, 0644))
+
+	s, err := NewJsonFileStorage(d)
+	require.NoError(t, err)
+	peers, err := s.Peers()
+	require.NoError(t, err)
+	require.Len(t, peers, 1, "unspecified address is dropped")
+	require.Equal(t, "127.0.0.1:8080", peers[0].Addr.String())
+	require.Equal(t, SourceMigrated, peers[0].Source)
+	require.False(t, peers[0].FirstSeen.IsZero())
+
+	// migrated peers are stored in new format
+	require.NoError(t, os.Remove(path.Join(d, "blocks_storage", "peers.dat")))
+	s, err = NewJsonFileStorage(d)
+	require.NoError(t, err)
+	peers, err = s.Peers()
+	require.NoError(t, err)
+	require.Len(t, peers, 1)
+}
+
+func TestJsonFileStorage_Bans(t *testing.T) {
+	d, err := ioutil.TempDir("", "abc")
+	require.NoError(t, err)
+	defer os.RemoveAll(d)
+
+	err = os.Mkdir(path.Join(d, "blocks_storage"), 0755)
+	require.NoError(t, err)
+
+	s, err := NewJsonFileStorage(d)
+	require.NoError(t, err)
+	bans, err := s.Bans()
+	require.NoError(t, err)
+	require.Len(t, bans, 0)
+
+	now := time.Unix(1600000000, 0).UTC()
+	saved := []Ban{
+		{IP: net.IPv4(8, 8, 8, 8), Since: now, Until: now.Add(time.Hour), Reason: "invalid block"},
+		{IP: net.IPv4(1, 1, 1, 1), Since: now, Reason: "permanent"},
+	}
+	require.NoError(t, s.SaveBans(saved))
+
+	s, err = NewJsonFileStorage(d)
+	require.NoError(t, err)
+	bans, err = s.Bans()
+	require.NoError(t, err)
+	require.Len(t, bans, 2)
+	require.True(t, saved[0].IP.Equal(bans[0].IP))
+	require.True(t, saved[0].Until.Equal(bans[0].Until))
+	require.True(t, bans[1].Permanent())
 }
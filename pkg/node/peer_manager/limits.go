@@ -0,0 +1,74 @@
+package peer_manager
+
+import (
+	"net"
+
+	"github.com/pkg/errors"
+)
+
+// ConnectionLimits restricts the number of connections with the same IP address and subnet,
+// so the attacker with few addresses can't take all connection slots of node. Zero value means no limit.
+// Connections from loopback and private addresses are not limited, nodes of local and custom networks
+// usually share few hosts.
+type ConnectionLimits struct {
+	PerIP int
+	// subnet is /24 for IPv4 and /48 for IPv6 addresses
+	PerSubnet int
+}
+
+var DefaultConnectionLimits = ConnectionLimits{PerIP: 2, PerSubnet: 5}
+
+var privateNetworks = func() []*net.IPNet {
+	var out []*net.IPNet
+	for _, cidr := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", "fc00::/7"} {
+		_, n, err := net.ParseCIDR(cidr)
+		if err != nil {
+			panic(err)
+		}
+		out = append(out, n)
+	}
+	return out
+}()
+
+func isPrivate(ip net.IP) bool {
+	for _, n := range privateNetworks {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func subnet(ip net.IP) Ip {
+	out := Ip{}
+	if v4 := ip.To4(); v4 != nil {
+		copy(out[:], v4.Mask(net.CIDRMask(24, 32)).To16())
+		return out
+	}
+	copy(out[:], ip.To16().Mask(net.CIDRMask(48, 128)))
+	return out
+}
+
+// check returns error if one more connection with the IP address exceeds the limits.
+func (a ConnectionLimits) check(connected []net.IP, ip net.IP) error {
+	if ip.IsLoopback() || isPrivate(ip) {
+		return nil
+	}
+	sameIP, sameSubnet := 0, 0
+	sn := subnet(ip)
+	for _, c := range connected {
+		if c.Equal(ip) {
+			sameIP++
+		}
+		if subnet(c) == sn {
+			sameSubnet++
+		}
+	}
+	if a.PerIP > 0 && sameIP >= a.PerIP {
+		return errors.Errorf("exceed limit of %d connections per IP address %s", a.PerIP, ip.String())
+	}
+	if a.PerSubnet > 0 && sameSubnet >= a.PerSubnet {
+		return errors.Errorf("exceed limit of %d connections per subnet of %s", a.PerSubnet, ip.String())
+	}
+	return nil
+}
@@ -1,18 +1,28 @@
 package peer_manager
 
-import "github.com/wavesplatform/gowaves/pkg/proto"
-
 type MemoryPeerStorage struct {
-	peers []proto.TCPAddr
+	peers []KnownPeer
+	bans  []Ban
 }
 
-func (a *MemoryPeerStorage) SavePeers(peers []proto.TCPAddr) error {
-	b := make([]proto.TCPAddr, len(peers))
+func (a *MemoryPeerStorage) SavePeers(peers []KnownPeer) error {
+	b := make([]KnownPeer, len(peers))
 	copy(b, peers)
 	a.peers = b
 	return nil
 }
 
-func (a *MemoryPeerStorage) Peers() ([]proto.TCPAddr, error) {
+func (a *MemoryPeerStorage) Peers() ([]KnownPeer, error) {
 	return a.peers, nil
 }
+
+func (a *MemoryPeerStorage) SaveBans(bans []Ban) error {
+	b := make([]Ban, len(bans))
+	copy(b, bans)
+	a.bans = b
+	return nil
+}
+
+func (a *MemoryPeerStorage) Bans() ([]Ban, error) {
+	return a.bans, nil
+}
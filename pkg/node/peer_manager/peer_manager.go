@@ -41,11 +41,21 @@ type PeerManager interface {
 	IsSuspended(peer.Peer) bool
 	Suspend(peer.Peer, string)
 	Suspended() []string
+	// Report changes the reputation of peer, the peer is banned when its reputation drops too low.
+	Report(peer.Peer, Event)
+	// Ban bans IP address for the duration, zero duration means permanent ban.
+	Ban(ip net.IP, d time.Duration, reason string) error
+	Unban(ip net.IP) error
+	Banned() []Ban
+	ClearBans() error
 	AddConnected(peer.Peer)
 	PeerWithHighestScore() (peer.Peer, *big.Int, bool)
 	UpdateScore(p peer.Peer, score *proto.Score) error
-	UpdateKnownPeers([]proto.TCPAddr) error
+	// UpdateKnownPeers adds the addresses received from the peer to address book.
+	UpdateKnownPeers(peer.Peer, []proto.TCPAddr) error
+	// KnownPeers returns addresses of alive known peers, the most reliable first.
 	KnownPeers() ([]proto.TCPAddr, error)
+	AddressBook() []KnownPeer
 	Close()
 	SpawnOutgoingConnections(context.Context)
 	SpawnIncomingConnection(ctx context.Context, conn net.Conn) error
@@ -114,19 +124,40 @@ type PeerManagerImpl struct {
 	state            PeerStorage
 	spawned          map[proto.IpPort]struct{}
 	suspended        suspended
+	blacklist        blacklist
+	reputation       map[Ip]*reputation
 	connectPeers     bool // spawn outgoing
 	limitConnections int
+	limits           ConnectionLimits
+	book             addressBook
+	bookChanged      bool
 }
 
 func NewPeerManager(spawner PeerSpawner, storage PeerStorage, limitConnections int) *PeerManagerImpl {
+	bl := blacklist{}
+	bans, err := storage.Bans()
+	if err != nil {
+		zap.S().Errorf("Failed to load banned peers: %v", err)
+	}
+	for _, b := range bans {
+		bl[ipToKey(b.IP)] = b
+	}
+	known, err := storage.Peers()
+	if err != nil {
+		zap.S().Errorf("Failed to load known peers: %v", err)
+	}
 	return &PeerManagerImpl{
 		spawner:          spawner,
 		active:           make(map[peer.Peer]peerInfo),
 		state:            storage,
 		spawned:          make(map[proto.IpPort]struct{}),
 		suspended:        suspended{},
+		blacklist:        bl,
+		reputation:       make(map[Ip]*reputation),
 		connectPeers:     true,
 		limitConnections: limitConnections,
+		limits:           DefaultConnectionLimits,
+		book:             newAddressBook(known),
 	}
 }
 
@@ -147,6 +178,22 @@ func (a *PeerManagerImpl) SetConnectPeers(connect bool) {
 	a.mu.Unlock()
 }
 
+func (a *PeerManagerImpl) SetConnectionLimits(limits ConnectionLimits) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.limits = limits
+}
+
+func (a *PeerManagerImpl) checkLimits(p peer.Peer) error {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	connected := make([]net.IP, 0, len(a.active))
+	for _, info := range a.active {
+		connected = append(connected, info.peer.RemoteAddr().IP)
+	}
+	return a.limits.check(connected, p.RemoteAddr().IP)
+}
+
 func (a *PeerManagerImpl) Connected(p peer.Peer) (peer.Peer, bool) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
@@ -175,6 +222,10 @@ func (a *PeerManagerImpl) NewConnection(p peer.Peer) error {
 		p.Close()
 		return errors.New("peer is suspended")
 	}
+	if err := a.checkLimits(p); err != nil {
+		_ = p.Close()
+		return err
+	}
 
 	in, out := a.InOutCount()
 	switch p.Direction() {
@@ -203,7 +254,15 @@ func (a *PeerManagerImpl) Run(ctx context.Context) {
 			return
 		case <-time.After(1 * time.Minute):
 			a.mu.Lock()
-			a.suspended.clear(time.Now())
+			now := time.Now()
+			a.suspended.clear(now)
+			if a.blacklist.clear(now) {
+				a.saveBans()
+			}
+			if a.book.prune(now) {
+				a.bookChanged = true
+			}
+			a.savePeers()
 			a.mu.Unlock()
 		}
 	}
@@ -214,6 +273,9 @@ func (a *PeerManagerImpl) AddConnected(peer peer.Peer) {
 	defer a.mu.Unlock()
 	delete(a.spawned, peer.RemoteAddr().ToIpPort())
 	a.active[peer] = newPeerInfo(peer)
+	if a.book.connected(peer, time.Now()) {
+		a.bookChanged = true
+	}
 }
 
 func (a *PeerManagerImpl) PeerWithHighestScore() (peer.Peer, *big.Int, bool) {
@@ -248,7 +310,9 @@ func (a *PeerManagerImpl) UpdateScore(p peer.Peer, score *big.Int) error {
 func (a *PeerManagerImpl) IsSuspended(p peer.Peer) bool {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
-	return a.suspended.Blocked(p.RemoteAddr().ToIpPort(), time.Now())
+	ipPort := p.RemoteAddr().ToIpPort()
+	now := time.Now()
+	return a.suspended.Blocked(ipPort, now) || a.blacklist.Blocked(ipPort, now)
 }
 
 // Count connected peers,
@@ -281,20 +345,164 @@ func (a *PeerManagerImpl) Suspended() []string {
 	return a.suspended.AllBlocked()
 }
 
-func (a *PeerManagerImpl) AddAddress(ctx context.Context, addr string) {
-	peers, err := a.state.Peers()
-	if err == nil {
-		tcpAddr := proto.NewTCPAddrFromString(addr)
-		if tcpAddr.Port > 0 {
-			peers = append(peers, tcpAddr)
-			err = a.state.SavePeers(peers)
-			if err != nil {
-				zap.S().Info(err)
-			}
+func (a *PeerManagerImpl) Report(p peer.Peer, e Event) {
+	ip := ipPortToIp(p.RemoteAddr().ToIpPort())
+	a.mu.Lock()
+	r, ok := a.reputation[ip]
+	if !ok {
+		a.evictReputation()
+		r = &reputation{}
+		a.reputation[ip] = r
+	}
+	ban, d := r.add(e)
+	points := r.points
+	if r.points == 0 && r.bans == 0 {
+		// nothing to remember
+		delete(a.reputation, ip)
+	}
+	var disconnect []peer.Peer
+	if ban {
+		disconnect = a.ban(ip, d, "reputation dropped too low after "+e.String())
+	}
+	a.mu.Unlock()
+	for _, p := range disconnect {
+		a.Disconnect(p)
+	}
+	zap.S().Debugf("[%s] Peer reported for %s, reputation %d", p.ID(), e, points)
+}
+
+// evictReputation removes the reputation of some not connected IP address if there is no room for new one,
+// the addresses that were never banned are removed first. Non thread safe.
+func (a *PeerManagerImpl) evictReputation() {
+	if len(a.reputation) < maxReputations {
+		return
+	}
+	connected := make(map[Ip]bool, len(a.active))
+	for p := range a.active {
+		connected[ipPortToIp(p.RemoteAddr().ToIpPort())] = true
+	}
+	var banned *Ip
+	for ip, r := range a.reputation {
+		if connected[ip] {
+			continue
 		}
-	} else {
-		zap.S().Info(err)
+		if r.bans == 0 {
+			delete(a.reputation, ip)
+			return
+		}
+		if banned == nil {
+			ip := ip
+			banned = &ip
+		}
+	}
+	if banned != nil {
+		delete(a.reputation, *banned)
+	}
+}
+
+func (a *PeerManagerImpl) Ban(ip net.IP, d time.Duration, reason string) error {
+	if ip.To16() == nil {
+		return errors.New("invalid IP address")
 	}
+	if d < 0 {
+		return errors.Errorf("invalid ban duration %s", d)
+	}
+	a.mu.Lock()
+	disconnect := a.ban(ipToKey(ip), d, reason)
+	a.mu.Unlock()
+	for _, p := range disconnect {
+		a.Disconnect(p)
+	}
+	return nil
+}
+
+// ban adds the ban and returns the connected peers that should be disconnected, non thread safe.
+func (a *PeerManagerImpl) ban(ip Ip, d time.Duration, reason string) []peer.Peer {
+	now := time.Now()
+	b := Ban{
+		IP:     net.IP(ip[:]),
+		Since:  now,
+		Reason: reason,
+	}
+	if d > 0 {
+		b.Until = now.Add(d)
+	}
+	a.blacklist[ip] = b
+	a.saveBans()
+	zap.S().Infof("Peer %s banned until %s, reason: %s", b.IP, b.Until, reason)
+	var out []peer.Peer
+	for p := range a.active {
+		if ipPortToIp(p.RemoteAddr().ToIpPort()) == ip {
+			out = append(out, p)
+		}
+	}
+	return out
+}
+
+func (a *PeerManagerImpl) Unban(ip net.IP) error {
+	if ip.To16() == nil {
+		return errors.New("invalid IP address")
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	key := ipToKey(ip)
+	if _, ok := a.blacklist[key]; !ok {
+		return errors.Errorf("peer %s is not banned", ip)
+	}
+	delete(a.blacklist, key)
+	delete(a.reputation, key)
+	return a.saveBans()
+}
+
+func (a *PeerManagerImpl) Banned() []Ban {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	now := time.Now()
+	out := make([]Ban, 0, len(a.blacklist))
+	for _, b := range a.blacklist.list() {
+		if b.Active(now) {
+			out = append(out, b)
+		}
+	}
+	return out
+}
+
+func (a *PeerManagerImpl) ClearBans() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.blacklist = blacklist{}
+	a.reputation = make(map[Ip]*reputation)
+	return a.saveBans()
+}
+
+// non thread safe
+func (a *PeerManagerImpl) saveBans() error {
+	err := a.state.SaveBans(a.blacklist.list())
+	if err != nil {
+		zap.S().Errorf("Failed to save banned peers: %v", err)
+	}
+	return err
+}
+
+// non thread safe
+func (a *PeerManagerImpl) savePeers() {
+	if !a.bookChanged {
+		return
+	}
+	if err := a.state.SavePeers(a.book.list()); err != nil {
+		zap.S().Errorf("Failed to save known peers: %v", err)
+		return
+	}
+	a.bookChanged = false
+}
+
+func (a *PeerManagerImpl) AddAddress(ctx context.Context, addr string) {
+	a.mu.Lock()
+	if a.book.add(proto.NewTCPAddrFromString(addr), SourceManual, time.Now()) {
+		a.bookChanged = true
+		a.savePeers()
+	}
+	a.mu.Unlock()
 
 	go func() {
 		if err := a.spawner.SpawnOutgoing(ctx, proto.NewTCPAddrFromString(addr)); err != nil {
@@ -303,31 +511,42 @@ func (a *PeerManagerImpl) AddAddress(ctx context.Context, addr string) {
 	}()
 }
 
-func (a *PeerManagerImpl) UpdateKnownPeers(known []proto.TCPAddr) error {
-	if len(known) == 0 {
-		return nil
+func (a *PeerManagerImpl) UpdateKnownPeers(p peer.Peer, known []proto.TCPAddr) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	added := a.book.addReceived(known, p.ID(), time.Now())
+	if added > 0 {
+		a.bookChanged = true
+		zap.S().Debugf("[%s] Added %d new known peers", p.ID(), added)
 	}
-	return a.state.SavePeers(known)
+	return nil
 }
 
 func (a *PeerManagerImpl) KnownPeers() ([]proto.TCPAddr, error) {
-	rs, err := a.state.Peers()
-	if err != nil {
-		return nil, err
-	}
-	if len(rs) == 0 {
-		return nil, nil
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	now := time.Now()
+	var out []proto.TCPAddr
+	for _, p := range a.book.list() {
+		if !p.dead(now) {
+			out = append(out, p.Addr)
+		}
 	}
-	out := make([]proto.TCPAddr, len(rs))
-	copy(out, rs)
 	return out, nil
 }
 
+func (a *PeerManagerImpl) AddressBook() []KnownPeer {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.book.list()
+}
+
 func (a *PeerManagerImpl) Close() {
 	a.mu.Lock()
 	for _, v := range a.active {
 		v.peer.Close()
 	}
+	a.savePeers()
 	a.mu.Unlock()
 }
 
@@ -353,12 +572,6 @@ func (a *PeerManagerImpl) SpawnOutgoingConnections(ctx context.Context) {
 		return
 	}
 
-	known, err := a.KnownPeers()
-	if err != nil {
-		zap.S().Error(err)
-		return
-	}
-
 	active := map[proto.IpPort]struct{}{}
 	for _, p := range a.active {
 		if p.peer.Direction() == peer.Outgoing {
@@ -370,7 +583,13 @@ func (a *PeerManagerImpl) SpawnOutgoingConnections(ctx context.Context) {
 		}
 	}
 
-	for _, addr := range known {
+	// the most reliable peers are tried first, no more than the free outgoing slots at once
+	now := time.Now()
+	free := a.limitConnections - outCnt - len(a.spawned)
+	for _, addr := range a.book.candidates(now) {
+		if free <= 0 {
+			break
+		}
 		addrIpPort := addr.ToIpPort()
 		if _, ok := active[addrIpPort]; ok {
 			continue
@@ -378,19 +597,30 @@ func (a *PeerManagerImpl) SpawnOutgoingConnections(ctx context.Context) {
 		if _, ok := a.spawned[addrIpPort]; ok {
 			continue
 		}
-		if a.suspended.Blocked(addrIpPort, time.Now()) {
+		if a.suspended.Blocked(addrIpPort, now) || a.blacklist.Blocked(addrIpPort, now) {
 			continue
 		}
 
-		a.spawned[addr.ToIpPort()] = struct{}{}
+		a.spawned[addrIpPort] = struct{}{}
+		a.book.attempt(addr, now)
+		free--
 
 		go func(addr proto.TCPAddr) {
-			defer a.RemoveSpawned(addr)
+			defer a.spawnFinished(addr)
 			_ = a.spawner.SpawnOutgoing(ctx, addr)
 		}(addr)
 	}
 }
 
+// spawnFinished records the result of connection attempt when the outgoing connection is closed or failed.
+func (a *PeerManagerImpl) spawnFinished(addr proto.TCPAddr) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	delete(a.spawned, addr.ToIpPort())
+	a.book.finished(addr)
+	a.bookChanged = true
+}
+
 func (a *PeerManagerImpl) Spawned() []proto.IpPort {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
@@ -461,9 +691,13 @@ func (a *PeerManagerImpl) Connect(ctx context.Context, addr proto.TCPAddr) error
 	}
 
 	a.spawned[addr.ToIpPort()] = struct{}{}
+	if a.book.add(addr, SourceManual, time.Now()) {
+		a.bookChanged = true
+	}
+	a.book.attempt(addr, time.Now())
 
 	go func(addr proto.TCPAddr) {
-		defer a.RemoveSpawned(addr)
+		defer a.spawnFinished(addr)
 		err := a.spawner.SpawnOutgoing(ctx, addr)
 		if err != nil {
 			zap.S().Error(err)
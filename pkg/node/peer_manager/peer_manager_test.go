@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 )
 
@@ -36,3 +37,160 @@ func TestSuspended_Block(t *testing.T) {
 		require.True(t, b.Blocked(addr2, time.Now()), "should be suspended, ignore port")
 	})
 }
+
+func TestReputation_Add(t *testing.T) {
+	r := &reputation{}
+	for i := 0; i < 200; i++ {
+		ban, _ := r.add(UsefulBlock)
+		require.False(t, ban)
+	}
+	require.Equal(t, maxReputation, r.points)
+
+	// four invalid blocks to get from max reputation to ban
+	for i := 0; i < 3; i++ {
+		ban, _ := r.add(InvalidBlock)
+		require.False(t, ban)
+	}
+	ban, d := r.add(InvalidBlock)
+	require.True(t, ban)
+	require.Equal(t, banDuration, d)
+	require.Equal(t, 0, r.points)
+
+	r.add(InvalidBlock)
+	_, d = r.add(InvalidBlock)
+	require.Equal(t, 2*banDuration, d)
+	r.add(InvalidBlock)
+	_, d = r.add(InvalidBlock)
+	require.Equal(t, 4*banDuration, d)
+	r.add(InvalidBlock)
+	ban, d = r.add(InvalidBlock)
+	require.True(t, ban)
+	require.Equal(t, time.Duration(0), d, "permanent ban expected")
+}
+
+func TestPeerManagerImpl_ReportLimits(t *testing.T) {
+	m := NewPeerManager(nil, &MemoryPeerStorage{}, 10)
+	p := newAddrPeer("8.8.8.8-1", net.IPv4(8, 8, 8, 8))
+	m.Report(p, Timeout)
+	require.Len(t, m.reputation, 1)
+	// reputation is forgotten when it returns to zero
+	for i := 0; i < 10; i++ {
+		m.Report(p, UsefulBlock)
+	}
+	require.Len(t, m.reputation, 0)
+
+	connected := newAddrPeer("8.8.4.4-1", net.IPv4(8, 8, 4, 4))
+	require.NoError(t, m.NewConnection(connected))
+	m.Report(connected, Timeout)
+	banned := ipToKey(net.IPv4(1, 1, 1, 1))
+	m.reputation[banned] = &reputation{bans: 1}
+	for i := 0; len(m.reputation) < maxReputations; i++ {
+		m.reputation[ipToKey(net.IPv4(10, byte(i>>8), byte(i), 1))] = &reputation{points: -5}
+	}
+	m.Report(p, Timeout)
+	require.Len(t, m.reputation, maxReputations)
+	require.Contains(t, m.reputation, ipToKey(net.IPv4(8, 8, 8, 8)))
+	require.Contains(t, m.reputation, ipToKey(net.IPv4(8, 8, 4, 4)), "reputation of connected peer is kept")
+	require.Contains(t, m.reputation, banned, "reputation of banned peer is kept while there are others")
+}
+
+func TestPeerManagerImpl_Ban(t *testing.T) {
+	storage := &MemoryPeerStorage{}
+	m := NewPeerManager(nil, storage, 10)
+	ip := net.IPv4(8, 8, 8, 8)
+	addr := proto.NewTCPAddr(ip, 6868).ToIpPort()
+
+	require.NoError(t, m.Ban(ip, time.Hour, "test"))
+	require.NoError(t, m.Ban(net.IPv4(1, 1, 1, 1), 0, "permanent"))
+	require.Error(t, m.Ban(ip, -time.Hour, "test"))
+	require.True(t, m.blacklist.Blocked(addr, time.Now()))
+	require.False(t, m.blacklist.Blocked(addr, time.Now().Add(2*time.Hour)))
+	require.Len(t, m.Banned(), 2)
+
+	// bans are restored from storage
+	m = NewPeerManager(nil, storage, 10)
+	banned := m.Banned()
+	require.Len(t, banned, 2)
+	require.Equal(t, "test", banned[0].Reason)
+	require.True(t, banned[1].Permanent())
+
+	require.NoError(t, m.Unban(ip))
+	require.Error(t, m.Unban(ip))
+	require.Len(t, m.Banned(), 1)
+
+	require.NoError(t, m.ClearBans())
+	require.Len(t, m.Banned(), 0)
+	bans, err := storage.Bans()
+	require.NoError(t, err)
+	require.Len(t, bans, 0)
+}
+
+func TestConnectionLimits_Check(t *testing.T) {
+	limits := ConnectionLimits{PerIP: 2, PerSubnet: 3}
+	connected := []net.IP{net.IPv4(8, 8, 8, 8), net.IPv4(8, 8, 8, 9)}
+	require.NoError(t, limits.check(connected, net.IPv4(8, 8, 8, 8)))
+	require.NoError(t, limits.check(connected, net.IPv4(8, 8, 8, 10)))
+
+	connected = append(connected, net.IPv4(8, 8, 8, 8))
+	require.Error(t, limits.check(connected, net.IPv4(8, 8, 8, 8)), "per IP limit")
+	require.Error(t, limits.check(connected, net.IPv4(8, 8, 8, 10)), "per subnet limit")
+	require.NoError(t, limits.check(connected, net.IPv4(8, 8, 9, 8)))
+	require.NoError(t, ConnectionLimits{}.check(connected, net.IPv4(8, 8, 8, 8)), "unlimited")
+
+	loopback := []net.IP{net.IPv4(127, 0, 0, 1), net.IPv4(127, 0, 0, 1)}
+	require.NoError(t, limits.check(loopback, net.IPv4(127, 0, 0, 1)))
+	private := []net.IP{net.IPv4(192, 168, 1, 2), net.IPv4(192, 168, 1, 2), net.IPv4(192, 168, 1, 3)}
+	require.NoError(t, limits.check(private, net.IPv4(192, 168, 1, 2)))
+	require.NoError(t, limits.check(private, net.IPv4(10, 1, 2, 3)))
+	require.NoError(t, limits.check(private, net.ParseIP("fd00::1")))
+
+	v6 := []net.IP{net.ParseIP("2001:db8:1:1::1"), net.ParseIP("2001:db8:1:2::1"), net.ParseIP("2001:db8:1:3::1")}
+	require.Error(t, limits.check(v6, net.ParseIP("2001:db8:1:4::1")))
+	require.NoError(t, limits.check(v6, net.ParseIP("2001:db8:2::1")))
+}
+
+func TestPeerManagerImpl_NewConnection_Limits(t *testing.T) {
+	m := NewPeerManager(nil, &MemoryPeerStorage{}, 10)
+	m.SetConnectionLimits(ConnectionLimits{PerIP: 1, PerSubnet: 2})
+
+	require.NoError(t, m.NewConnection(newAddrPeer("8.8.8.8-1", net.IPv4(8, 8, 8, 8))))
+	second := newAddrPeer("8.8.8.8-2", net.IPv4(8, 8, 8, 8))
+	require.Error(t, m.NewConnection(second))
+	require.True(t, second.closed)
+	require.NoError(t, m.NewConnection(newAddrPeer("8.8.8.9-1", net.IPv4(8, 8, 8, 9))))
+	require.Error(t, m.NewConnection(newAddrPeer("8.8.8.10-1", net.IPv4(8, 8, 8, 10))))
+	require.NoError(t, m.NewConnection(newAddrPeer("8.8.9.10-1", net.IPv4(8, 8, 9, 10))))
+}
+
+// addrPeer provides only the methods used by peer manager to accept connection.
+type addrPeer struct {
+	peer.Peer
+	id     string
+	ip     net.IP
+	closed bool
+}
+
+func newAddrPeer(id string, ip net.IP) *addrPeer {
+	return &addrPeer{id: id, ip: ip}
+}
+
+func (a *addrPeer) ID() string {
+	return a.id
+}
+
+func (a *addrPeer) Direction() peer.Direction {
+	return peer.Incoming
+}
+
+func (a *addrPeer) RemoteAddr() proto.TCPAddr {
+	return proto.NewTCPAddr(a.ip, 6868)
+}
+
+func (a *addrPeer) Close() error {
+	a.closed = true
+	return nil
+}
+
+func (a *addrPeer) Handshake() proto.Handshake {
+	return proto.Handshake{}
+}
@@ -1,10 +1,8 @@
 package peer_manager
 
-import (
-	"github.com/wavesplatform/gowaves/pkg/proto"
-)
-
 type PeerStorage interface {
-	SavePeers([]proto.TCPAddr) error
-	Peers() ([]proto.TCPAddr, error)
+	SavePeers([]KnownPeer) error
+	Peers() ([]KnownPeer, error)
+	SaveBans([]Ban) error
+	Bans() ([]Ban, error)
 }
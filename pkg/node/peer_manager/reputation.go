@@ -0,0 +1,134 @@
+package peer_manager
+
+import (
+	"net"
+	"sort"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+// Event is the behaviour of peer that changes its reputation.
+type Event int
+
+const (
+	UsefulBlock Event = iota + 1
+	InvalidBlock
+	InvalidTransaction
+	ProtocolViolation
+	Timeout
+)
+
+var eventPoints = map[Event]int{
+	UsefulBlock:        1,
+	InvalidBlock:       -50,
+	InvalidTransaction: -5,
+	ProtocolViolation:  -25,
+	Timeout:            -10,
+}
+
+func (e Event) String() string {
+	switch e {
+	case UsefulBlock:
+		return "useful block"
+	case InvalidBlock:
+		return "invalid block"
+	case InvalidTransaction:
+		return "invalid transaction"
+	case ProtocolViolation:
+		return "protocol violation"
+	case Timeout:
+		return "timeout"
+	default:
+		return "unknown event"
+	}
+}
+
+const (
+	// reputation can't be gained above this value, so the peer can't accumulate credit for future misbehaviour
+	maxReputation = 100
+	// peer is banned when its reputation drops to this value
+	banReputation = -100
+	// duration of the first temporary ban, every next one is twice longer
+	banDuration = 30 * time.Minute
+	// peer is banned permanently after this number of temporary bans
+	maxTemporaryBans = 3
+	// max number of IP addresses the reputation is kept for
+	maxReputations = 10000
+)
+
+// Ban forbids connections with the IP address until the time it expires.
+type Ban struct {
+	IP     net.IP    `json:"ip"`
+	Since  time.Time `json:"since"`
+	Until  time.Time `json:"until"` // zero for permanent ban
+	Reason string    `json:"reason"`
+}
+
+func (a Ban) Permanent() bool {
+	return a.Until.IsZero()
+}
+
+func (a Ban) Active(now time.Time) bool {
+	return a.Permanent() || a.Until.After(now)
+}
+
+type reputation struct {
+	points int
+	// number of temporary bans already served
+	bans int
+}
+
+// add changes the points of reputation and tells whether peer should be banned and for how long,
+// zero duration means permanent ban.
+func (a *reputation) add(e Event) (bool, time.Duration) {
+	a.points += eventPoints[e]
+	if a.points > maxReputation {
+		a.points = maxReputation
+	}
+	if a.points > banReputation {
+		return false, 0
+	}
+	a.points = 0
+	a.bans++
+	if a.bans > maxTemporaryBans {
+		return true, 0
+	}
+	return true, banDuration << uint(a.bans-1)
+}
+
+type blacklist map[Ip]Ban
+
+func (a blacklist) Blocked(ipPort proto.IpPort, now time.Time) bool {
+	b, ok := a[ipPortToIp(ipPort)]
+	return ok && b.Active(now)
+}
+
+// clear removes expired bans and tells whether any was removed.
+func (a blacklist) clear(now time.Time) bool {
+	removed := false
+	for ip, b := range a {
+		if !b.Active(now) {
+			delete(a, ip)
+			removed = true
+		}
+	}
+	return removed
+}
+
+func (a blacklist) list() []Ban {
+	out := make([]Ban, 0, len(a))
+	for _, b := range a {
+		out = append(out, b)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Since.Before(out[j].Since)
+	})
+	return out
+}
+
+func ipToKey(ip net.IP) Ip {
+	out := Ip{}
+	copy(out[:], ip.To16())
+	return out
+}
@@ -30,9 +30,13 @@ type PeerSpawnerImpl struct {
 	nodeName     string
 	nodeNonce    uint64
 	version      proto.Version
+	capture      peer.Capture
+	connOptions  conn.Options
 }
 
-func NewPeerSpawner(pool bytespool.Pool, parent peer.Parent, WavesNetwork string, declAddr proto.TCPAddr, nodeName string, nodeNonce uint64, version proto.Version) *PeerSpawnerImpl {
+// NewPeerSpawner creates the spawner of peers, capture records traffic of peers if not nil.
+// Connections of peers count their traffic and limit inbound bandwidth according to connOptions.
+func NewPeerSpawner(pool bytespool.Pool, parent peer.Parent, WavesNetwork string, declAddr proto.TCPAddr, nodeName string, nodeNonce uint64, version proto.Version, capture peer.Capture, connOptions conn.Options) *PeerSpawnerImpl {
 	return &PeerSpawnerImpl{
 		pool:         pool,
 		skipFunc:     noSkip,
@@ -42,6 +46,8 @@ func NewPeerSpawner(pool bytespool.Pool, parent peer.Parent, WavesNetwork string
 		nodeName:     nodeName,
 		nodeNonce:    nodeNonce,
 		version:      version,
+		capture:      capture,
+		connOptions:  connOptions,
 	}
 }
 
@@ -55,6 +61,8 @@ func (a *PeerSpawnerImpl) SpawnOutgoing(ctx context.Context, address proto.TCPAd
 		Skip:         a.skipFunc,
 		NodeName:     a.nodeName,
 		NodeNonce:    a.nodeNonce,
+		Capture:      a.capture,
+		ConnOptions:  a.connOptions,
 	}
 
 	return outgoing.EstablishConnection(ctx, params, a.version)
@@ -69,9 +77,11 @@ func (a *PeerSpawnerImpl) SpawnIncoming(ctx context.Context, c net.Conn) error {
 		DeclAddr:     a.declAddr,
 		Pool:         a.pool,
 
-		NodeName:  a.nodeName,
-		NodeNonce: a.nodeNonce,
-		Version:   a.version,
+		NodeName:    a.nodeName,
+		NodeNonce:   a.nodeNonce,
+		Version:     a.version,
+		Capture:     a.capture,
+		ConnOptions: a.connOptions,
 	}
 
 	return incoming.RunIncomingPeer(ctx, params)
@@ -0,0 +1,89 @@
+package node
+
+import (
+	"reflect"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+type messageLimit struct {
+	count  int
+	period time.Duration
+}
+
+// messageLimits restricts how often a peer can request the data that is expensive to serve.
+var messageLimits = map[reflect.Type]messageLimit{
+	reflect.TypeOf(&proto.GetPeersMessage{}):      {count: 5, period: time.Minute},
+	reflect.TypeOf(&proto.GetSignaturesMessage{}): {count: 50, period: 10 * time.Second},
+	reflect.TypeOf(&proto.GetBlockIdsMessage{}):   {count: 50, period: 10 * time.Second},
+}
+
+// rate limiter forgets the expired windows of all peers when it tracks more peers than this
+const maxTrackedPeers = 1000
+
+type window struct {
+	start time.Time
+	count int
+}
+
+// rateLimiter counts messages of each peer in fixed windows. It's used only by node loop, so it's not synchronized.
+type rateLimiter struct {
+	limits  map[reflect.Type]messageLimit
+	windows map[peer.Peer]map[reflect.Type]*window
+}
+
+func newRateLimiter(limits map[reflect.Type]messageLimit) *rateLimiter {
+	return &rateLimiter{
+		limits:  limits,
+		windows: make(map[peer.Peer]map[reflect.Type]*window),
+	}
+}
+
+// allow counts the message and returns false if peer exceeded the limit of messages of this type.
+// The second value is true only for the first message over the limit in the window,
+// so the violation is reported once per window.
+func (a *rateLimiter) allow(p peer.Peer, m proto.Message, now time.Time) (bool, bool) {
+	t := reflect.TypeOf(m)
+	limit, ok := a.limits[t]
+	if !ok {
+		return true, false
+	}
+	windows, ok := a.windows[p]
+	if !ok {
+		if len(a.windows) >= maxTrackedPeers {
+			a.prune(now)
+		}
+		windows = make(map[reflect.Type]*window)
+		a.windows[p] = windows
+	}
+	w, ok := windows[t]
+	if !ok || now.Sub(w.start) >= limit.period {
+		w = &window{start: now}
+		windows[t] = w
+	}
+	w.count++
+	if w.count <= limit.count {
+		return true, false
+	}
+	return false, w.count == limit.count+1
+}
+
+// remove forgets the peer, it's called when the peer is disconnected.
+func (a *rateLimiter) remove(p peer.Peer) {
+	delete(a.windows, p)
+}
+
+func (a *rateLimiter) prune(now time.Time) {
+	for p, windows := range a.windows {
+		for t, w := range windows {
+			if now.Sub(w.start) >= a.limits[t].period {
+				delete(windows, t)
+			}
+		}
+		if len(windows) == 0 {
+			delete(a.windows, p)
+		}
+	}
+}
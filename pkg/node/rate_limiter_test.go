@@ -0,0 +1,47 @@
+package node
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func TestRateLimiter_Allow(t *testing.T) {
+	limiter := newRateLimiter(map[reflect.Type]messageLimit{
+		reflect.TypeOf(&proto.GetPeersMessage{}): {count: 2, period: time.Minute},
+	})
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	p1, p2 := mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl)
+	now := time.Unix(1600000000, 0)
+
+	allowed, _ := limiter.allow(p1, &proto.ScoreMessage{}, now)
+	require.True(t, allowed, "not limited message")
+	for i := 0; i < 2; i++ {
+		allowed, exceeded := limiter.allow(p1, &proto.GetPeersMessage{}, now)
+		require.True(t, allowed)
+		require.False(t, exceeded)
+	}
+	allowed, exceeded := limiter.allow(p1, &proto.GetPeersMessage{}, now.Add(time.Second))
+	require.False(t, allowed)
+	require.True(t, exceeded, "first violation is reported")
+	allowed, exceeded = limiter.allow(p1, &proto.GetPeersMessage{}, now.Add(2*time.Second))
+	require.False(t, allowed)
+	require.False(t, exceeded, "violation is reported once per window")
+
+	allowed, _ = limiter.allow(p2, &proto.GetPeersMessage{}, now)
+	require.True(t, allowed, "limits are per peer")
+
+	allowed, _ = limiter.allow(p1, &proto.GetPeersMessage{}, now.Add(time.Minute))
+	require.True(t, allowed, "new window")
+
+	limiter.remove(p1)
+	require.Len(t, limiter.windows, 1)
+	limiter.prune(now.Add(time.Minute))
+	require.Len(t, limiter.windows, 0)
+}
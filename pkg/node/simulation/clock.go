@@ -0,0 +1,89 @@
+package simulation
+
+import (
+	"container/heap"
+	"sync"
+	"time"
+)
+
+type event struct {
+	at  time.Time
+	seq uint64
+	f   func()
+}
+
+type events []event
+
+func (a events) Len() int { return len(a) }
+func (a events) Less(i, j int) bool {
+	if a[i].at.Equal(a[j].at) {
+		return a[i].seq < a[j].seq
+	}
+	return a[i].at.Before(a[j].at)
+}
+func (a events) Swap(i, j int)       { a[i], a[j] = a[j], a[i] }
+func (a *events) Push(x interface{}) { *a = append(*a, x.(event)) }
+func (a *events) Pop() interface{} {
+	old := *a
+	n := len(old)
+	out := old[n-1]
+	*a = old[:n-1]
+	return out
+}
+
+// Clock is the virtual time shared by all nodes of simulation, it implements types.Time.
+// Time moves forward only by Advance, the functions scheduled with AfterFunc are run by Advance
+// in the order of their time, functions scheduled for the same time are run in the order they were added.
+type Clock struct {
+	advance sync.Mutex
+	mu      sync.Mutex
+	now     time.Time
+	seq     uint64
+	events  events
+}
+
+func NewClock(start time.Time) *Clock {
+	return &Clock{now: start}
+}
+
+func (a *Clock) Now() time.Time {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.now
+}
+
+// AfterFunc schedules f to run after the duration of virtual time, non positive duration runs f immediately.
+func (a *Clock) AfterFunc(d time.Duration, f func()) {
+	if d <= 0 {
+		f()
+		return
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.seq++
+	heap.Push(&a.events, event{at: a.now.Add(d), seq: a.seq, f: f})
+}
+
+// Advance moves the time forward by the duration and runs all the functions scheduled up to the new time.
+func (a *Clock) Advance(d time.Duration) {
+	a.advance.Lock()
+	defer a.advance.Unlock()
+	a.mu.Lock()
+	target := a.now.Add(d)
+	for len(a.events) > 0 && !a.events[0].at.After(target) {
+		e := heap.Pop(&a.events).(event)
+		a.now = e.at
+		a.mu.Unlock()
+		e.f()
+		a.mu.Lock()
+	}
+	a.now = target
+	a.mu.Unlock()
+}
+
+// Pending returns the number of scheduled functions that were not run yet.
+func (a *Clock) Pending() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return len(a.events)
+}
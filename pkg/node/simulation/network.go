@@ -0,0 +1,334 @@
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+// endpoint is the network side of simulated node.
+type endpoint struct {
+	name      string
+	addr      proto.TCPAddr
+	parent    peer.Parent
+	handshake proto.Handshake
+}
+
+type link struct {
+	a, b string
+}
+
+func newLink(a, b string) link {
+	if a > b {
+		a, b = b, a
+	}
+	return link{a: a, b: b}
+}
+
+// Network connects simulated nodes in memory. Messages are serialized as on the wire and delivered
+// to the receiver after the latency of link measured by virtual clock.
+// Messages sent between partitioned nodes or over closed connections are lost.
+type Network struct {
+	clock *Clock
+
+	mu        sync.Mutex
+	endpoints map[string]*endpoint
+	latency   time.Duration
+	latencies map[link]time.Duration
+	// group of node by its name, nil map means no partitions
+	groups map[string]int
+	conns  []*connection
+}
+
+func NewNetwork(clock *Clock, latency time.Duration) *Network {
+	return &Network{
+		clock:     clock,
+		endpoints: make(map[string]*endpoint),
+		latency:   latency,
+		latencies: make(map[link]time.Duration),
+	}
+}
+
+func (a *Network) add(e *endpoint) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.endpoints[e.name] = e
+}
+
+// SetLatency sets the latency of link between two nodes, it overrides the default latency of network.
+func (a *Network) SetLatency(from, to string, d time.Duration) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.latencies[newLink(from, to)] = d
+}
+
+func (a *Network) linkLatency(from, to string) time.Duration {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if d, ok := a.latencies[newLink(from, to)]; ok {
+		return d
+	}
+	return a.latency
+}
+
+// Partition splits the network into the groups of nodes, nodes of different groups can't reach each other.
+// Nodes not listed in any group are isolated from all other nodes.
+func (a *Network) Partition(groups ...[]string) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.groups = make(map[string]int)
+	for i, g := range groups {
+		for _, name := range g {
+			a.groups[name] = i + 1
+		}
+	}
+}
+
+// Heal removes all partitions.
+func (a *Network) Heal() {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.groups = nil
+}
+
+func (a *Network) Reachable(from, to string) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.reachable(from, to)
+}
+
+func (a *Network) reachable(from, to string) bool {
+	if a.groups == nil {
+		return true
+	}
+	g := a.groups[from]
+	return g != 0 && g == a.groups[to]
+}
+
+// Dial connects two nodes, both nodes are notified about new peer like after successful handshake.
+func (a *Network) Dial(from, to string) error {
+	a.mu.Lock()
+	src, ok := a.endpoints[from]
+	if !ok {
+		a.mu.Unlock()
+		return errors.Errorf("unknown node %q", from)
+	}
+	dst, ok := a.endpoints[to]
+	if !ok {
+		a.mu.Unlock()
+		return errors.Errorf("unknown node %q", to)
+	}
+	if !a.reachable(from, to) {
+		a.mu.Unlock()
+		return errors.Errorf("node %q is unreachable from %q", to, from)
+	}
+	c := &connection{net: a, closed: atomic.NewBool(false)}
+	out := &simPeer{conn: c, local: src, remote: dst, direction: peer.Outgoing}
+	in := &simPeer{conn: c, local: dst, remote: src, direction: peer.Incoming}
+	out.other, in.other = in, out
+	c.peers = [2]*simPeer{out, in}
+	a.conns = append(a.conns, c)
+	a.mu.Unlock()
+
+	src.parent.InfoCh <- peer.InfoMessage{Peer: out, Value: &peer.Connected{Peer: out}}
+	dst.parent.InfoCh <- peer.InfoMessage{Peer: in, Value: &peer.Connected{Peer: in}}
+	return nil
+}
+
+// Disconnect closes all connections between two nodes.
+func (a *Network) Disconnect(from, to string) {
+	l := newLink(from, to)
+	a.mu.Lock()
+	var conns []*connection
+	for _, c := range a.conns {
+		if newLink(c.peers[0].local.name, c.peers[1].local.name) == l {
+			conns = append(conns, c)
+		}
+	}
+	a.mu.Unlock()
+	for _, c := range conns {
+		_ = c.Close()
+	}
+}
+
+func (a *Network) remove(c *connection) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for i, v := range a.conns {
+		if v == c {
+			a.conns = append(a.conns[:i], a.conns[i+1:]...)
+			return
+		}
+	}
+}
+
+func (a *Network) send(from *simPeer, bts []byte) {
+	a.clock.AfterFunc(a.linkLatency(from.local.name, from.remote.name), func() {
+		if from.conn.closed.Load() {
+			return
+		}
+		if !a.Reachable(from.local.name, from.remote.name) {
+			zap.S().Debugf("Simulation: message from %q to %q is lost because of partition", from.local.name, from.remote.name)
+			return
+		}
+		to := from.other
+		m, err := proto.UnmarshalMessage(bts)
+		if err != nil {
+			select {
+			case to.local.parent.InfoCh <- peer.InfoMessage{Peer: to, Value: &peer.InvalidMessageError{Err: err}}:
+			default:
+			}
+			return
+		}
+		select {
+		case to.local.parent.MessageCh <- peer.ProtoMessage{ID: to, Message: m}:
+		default:
+			zap.S().Debugf("Simulation: failed to deliver message to %q, channel is full: %T", to.local.name, m)
+		}
+	})
+}
+
+// spawner lets peer manager of node establish outgoing connections through the network.
+type spawner struct {
+	net  *Network
+	name string
+}
+
+func (a spawner) SpawnOutgoing(_ context.Context, addr proto.TCPAddr) error {
+	a.net.mu.Lock()
+	to := ""
+	for name, e := range a.net.endpoints {
+		if e.addr.String() == addr.String() {
+			to = name
+			break
+		}
+	}
+	a.net.mu.Unlock()
+	if to == "" {
+		return errors.Errorf("no node at address %s", addr.String())
+	}
+	return a.net.Dial(a.name, to)
+}
+
+func (a spawner) SpawnIncoming(context.Context, net.Conn) error {
+	return errors.New("incoming connections are not supported by simulation")
+}
+
+// connection is the in-memory connection between two nodes shared by peers on both sides.
+type connection struct {
+	net    *Network
+	peers  [2]*simPeer
+	closed *atomic.Bool
+}
+
+// Close closes the connection, both nodes get the error as if the remote side closed the socket.
+func (a *connection) Close() error {
+	if !a.closed.CAS(false, true) {
+		return nil
+	}
+	a.net.remove(a)
+	for _, p := range a.peers {
+		select {
+		case p.local.parent.InfoCh <- peer.InfoMessage{Peer: p, Value: io.EOF}:
+		default:
+		}
+	}
+	return nil
+}
+
+// Conn returns the stub that only provides addresses, data never goes through it.
+func (a *connection) Conn() net.Conn {
+	return addrConn{local: a.peers[0].local.addr, remote: a.peers[0].remote.addr}
+}
+
+func (a *connection) SendClosed() bool {
+	return a.closed.Load()
+}
+
+func (a *connection) ReceiveClosed() bool {
+	return a.closed.Load()
+}
+
+// Stats returns nil, traffic of simulated connections is not counted.
+func (a *connection) Stats() *conn.Stats {
+	return nil
+}
+
+var _ conn.Connection = (*connection)(nil)
+
+type simPeer struct {
+	conn      *connection
+	local     *endpoint
+	remote    *endpoint
+	other     *simPeer
+	direction peer.Direction
+}
+
+func (a *simPeer) Direction() peer.Direction {
+	return a.direction
+}
+
+func (a *simPeer) Close() error {
+	return a.conn.Close()
+}
+
+func (a *simPeer) SendMessage(m proto.Message) {
+	if a.conn.closed.Load() {
+		return
+	}
+	bts, err := m.MarshalBinary()
+	if err != nil {
+		zap.S().Error(err)
+		return
+	}
+	a.conn.net.send(a, bts)
+}
+
+func (a *simPeer) ID() string {
+	return fmt.Sprintf("%s-%d", a.remote.addr.IP.String(), a.remote.handshake.NodeNonce)
+}
+
+func (a *simPeer) Connection() conn.Connection {
+	return a.conn
+}
+
+func (a *simPeer) Handshake() proto.Handshake {
+	return a.remote.handshake
+}
+
+func (a *simPeer) RemoteAddr() proto.TCPAddr {
+	return a.remote.addr
+}
+
+func (a *simPeer) String() string {
+	return fmt.Sprintf("%s->%s", a.local.name, a.remote.name)
+}
+
+type addrConn struct {
+	local, remote proto.TCPAddr
+}
+
+func (a addrConn) Read([]byte) (int, error)  { return 0, io.ErrClosedPipe }
+func (a addrConn) Write([]byte) (int, error) { return 0, io.ErrClosedPipe }
+func (a addrConn) Close() error              { return nil }
+func (a addrConn) LocalAddr() net.Addr {
+	addr := net.TCPAddr(a.local)
+	return &addr
+}
+func (a addrConn) RemoteAddr() net.Addr {
+	addr := net.TCPAddr(a.remote)
+	return &addr
+}
+func (a addrConn) SetDeadline(time.Time) error      { return nil }
+func (a addrConn) SetReadDeadline(time.Time) error  { return nil }
+func (a addrConn) SetWriteDeadline(time.Time) error { return nil }
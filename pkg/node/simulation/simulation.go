@@ -0,0 +1,462 @@
+// Package simulation runs several full nodes in one process connected by in-memory network with virtual clock.
+// Nodes use real state, UTX pool, miner and FSM, blocks are generated on demand in dev mining mode,
+// so it's possible to reproduce forks and rollbacks in tests.
+//
+// Goroutines of nodes are still scheduled by Go runtime, so the helpers wait for the expected result
+// instead of checking it once.
+package simulation
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/libs/microblock_cache"
+	"github.com/wavesplatform/gowaves/pkg/libs/runner"
+	"github.com/wavesplatform/gowaves/pkg/miner"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
+	"github.com/wavesplatform/gowaves/pkg/miner/scheduler"
+	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
+	"github.com/wavesplatform/gowaves/pkg/node"
+	"github.com/wavesplatform/gowaves/pkg/node/blocks_applier"
+	"github.com/wavesplatform/gowaves/pkg/node/messages"
+	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/ng"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/services"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+	"github.com/wavesplatform/gowaves/pkg/signer"
+	"github.com/wavesplatform/gowaves/pkg/state"
+	"github.com/wavesplatform/gowaves/pkg/util/genesis_generator"
+	"github.com/wavesplatform/gowaves/pkg/wallet"
+)
+
+const (
+	defaultBlockInterval = time.Minute
+	defaultWaitTimeout   = 10 * time.Second
+	pollInterval         = 10 * time.Millisecond
+	// balance of each miner in genesis block, enough to generate blocks
+	minerBalance = 100000 * proto.PriceConstant
+	port         = 6868
+)
+
+var version = proto.Version{Major: 1, Minor: 2, Patch: 3}
+
+type Config struct {
+	// Number of nodes, each node has its own miner account with balance in genesis block.
+	Nodes int
+	// Directory for states of nodes, temporary directory is created and removed on Close if empty.
+	Dir string
+	// Initial time of virtual clock and timestamp of genesis block, current time is used if zero.
+	Start time.Time
+	// Default latency of all links.
+	Latency time.Duration
+	// Virtual time passed before each block is mined, one minute if zero.
+	BlockInterval time.Duration
+	// How long helpers wait for nodes in real time, 10 seconds if zero.
+	WaitTimeout time.Duration
+}
+
+// Node is a full node of simulation.
+type Node struct {
+	Name      string
+	KeyPair   proto.KeyPair
+	State     state.State
+	Peers     *peer_manager.PeerManagerImpl
+	Scheduler *scheduler.SchedulerImpl
+	UtxPool   *utxpool.UtxImpl
+	Services  services.Services
+
+	node *node.Node
+}
+
+func (a *Node) Height() (uint64, error) {
+	return a.State.Height()
+}
+
+// StateHash returns the state hash at the height, state hashes are always built by nodes of simulation.
+func (a *Node) StateHash(height uint64) (*proto.StateHash, error) {
+	return a.State.StateHashAtHeight(height)
+}
+
+type Simulation struct {
+	Clock    *Clock
+	Network  *Network
+	Settings *settings.BlockchainSettings
+	Nodes    []*Node
+
+	conf    Config
+	tempDir bool
+	cancel  context.CancelFunc
+}
+
+// Settings returns blockchain settings with dev mining enabled and genesis block that gives balance to each key pair.
+func Settings(start time.Time, kps []proto.KeyPair) (*settings.BlockchainSettings, error) {
+	s := *settings.DefaultCustomSettings
+	s.DevMining = true
+	s.BlockRewardTerm = 100000
+	s.InitialBlockReward = 600000000
+	s.BlockRewardIncrement = 50000000
+	s.BlockRewardVotingPeriod = 10000
+	args := make([]interface{}, 0, 2*len(kps))
+	for _, kp := range kps {
+		args = append(args, kp, minerBalance)
+	}
+	genesis, err := genesis_generator.Generate(proto.NewTimestampFromTime(start), s.AddressSchemeCharacter, args...)
+	if err != nil {
+		return nil, err
+	}
+	s.Genesis = *genesis
+	return &s, nil
+}
+
+// New creates and starts the nodes of simulation, nodes are not connected to each other.
+func New(conf Config) (*Simulation, error) {
+	if conf.Nodes <= 0 {
+		return nil, errors.New("at least one node is required")
+	}
+	if conf.Start.IsZero() {
+		conf.Start = time.Now()
+	}
+	if conf.BlockInterval == 0 {
+		conf.BlockInterval = defaultBlockInterval
+	}
+	if conf.WaitTimeout == 0 {
+		conf.WaitTimeout = defaultWaitTimeout
+	}
+	tempDir := false
+	if conf.Dir == "" {
+		dir, err := ioutil.TempDir(os.TempDir(), "simulation")
+		if err != nil {
+			return nil, err
+		}
+		conf.Dir = dir
+		tempDir = true
+	}
+	kps := make([]proto.KeyPair, conf.Nodes)
+	for i := range kps {
+		kps[i] = proto.MustKeyPair([]byte(nodeName(i)))
+	}
+	s, err := Settings(conf.Start, kps)
+	if err != nil {
+		return nil, err
+	}
+	clock := NewClock(conf.Start)
+	ctx, cancel := context.WithCancel(context.Background())
+	sim := &Simulation{
+		Clock:    clock,
+		Network:  NewNetwork(clock, conf.Latency),
+		Settings: s,
+		conf:     conf,
+		tempDir:  tempDir,
+		cancel:   cancel,
+	}
+	for i, kp := range kps {
+		n, err := sim.newNode(ctx, i, kp)
+		if err != nil {
+			sim.Close()
+			return nil, errors.Wrapf(err, "failed to create node %q", nodeName(i))
+		}
+		sim.Nodes = append(sim.Nodes, n)
+	}
+	return sim, nil
+}
+
+func nodeName(i int) string {
+	return fmt.Sprintf("node%d", i)
+}
+
+func (a *Simulation) newNode(ctx context.Context, i int, kp proto.KeyPair) (*Node, error) {
+	name := nodeName(i)
+	params := state.DefaultTestingStateParams()
+	params.BuildStateHashes = true
+	params.Time = a.Clock
+	st, err := state.NewState(filepath.Join(a.conf.Dir, name), params, a.Settings)
+	if err != nil {
+		return nil, err
+	}
+
+	w := wallet.NewWallet()
+	if err := w.AddSeed([]byte(name)); err != nil {
+		return nil, err
+	}
+	sgn := signer.NewLocalSigner(w, a.Settings.AddressSchemeCharacter)
+	sch, err := scheduler.NewDevScheduler(st, sgn, a.Settings, a.Clock)
+	if err != nil {
+		return nil, err
+	}
+	voting, err := miner.NewVoting(st, miner.InitialVotes{}, "")
+	if err != nil {
+		return nil, err
+	}
+
+	addr := proto.TCPAddr(net.TCPAddr{IP: net.IPv4(10, 0, 0, byte(i+1)), Port: port})
+	ep := &endpoint{
+		name:   name,
+		addr:   addr,
+		parent: peer.NewParent(),
+		handshake: proto.Handshake{
+			AppName:      "waves" + string(a.Settings.AddressSchemeCharacter),
+			Version:      version,
+			NodeName:     name,
+			NodeNonce:    uint64(i + 1),
+			DeclaredAddr: proto.HandshakeTCPAddr(addr),
+			Timestamp:    proto.NewTimestampFromTime(a.Conf().Start),
+		},
+	}
+	a.Network.add(ep)
+	peers := peer_manager.NewPeerManager(spawner{net: a.Network, name: name}, &peer_manager.MemoryPeerStorage{}, 10)
+	// all nodes share the same subnet
+	peers.SetConnectionLimits(peer_manager.ConnectionLimits{})
+
+	internalCh := messages.NewInternalChannel()
+	utx := utxpool.New(10000, utxpool.NewValidator(st, a.Clock), st, a.Settings)
+	srv := services.Services{
+		State:           st,
+		Peers:           peers,
+		Scheduler:       sch,
+		BlocksApplier:   blocks_applier.NewBlocksApplier(),
+		UtxPool:         utx,
+		MicroblockStats: microblock.NewStats(),
+		Scheme:          a.Settings.AddressSchemeCharacter,
+		InvRequester:    ng.NewInvRequester(),
+		LoggableRunner:  runner.NewLogRunner(runner.NewAsync()),
+		Time:            a.Clock,
+		Wallet:          wallet.NewEmbeddedWallet(nil, w, a.Settings.AddressSchemeCharacter),
+		Signer:          sgn,
+		MicroBlockCache: microblock_cache.NewMicroblockCache(),
+		Voting:          voting,
+		FsmStatus:       status.NewTracker(status.DefaultHistorySize),
+		InternalChannel: internalCh,
+	}
+
+	go miner.Run(ctx, miner.NewMicroblockMiner(srv), sch, internalCh)
+	// empty declared address, so node doesn't listen on real port
+	n := node.NewNode(srv, proto.TCPAddr{}, proto.TCPAddr{}, proto.NewTimestampFromUSeconds(4*3600))
+	go n.Run(ctx, ep.parent, internalCh)
+
+	return &Node{
+		Name:      name,
+		KeyPair:   kp,
+		State:     st,
+		Peers:     peers,
+		Scheduler: sch,
+		UtxPool:   utx,
+		Services:  srv,
+		node:      n,
+	}, nil
+}
+
+func (a *Simulation) Conf() Config {
+	return a.conf
+}
+
+// Connect connects the node to other nodes.
+func (a *Simulation) Connect(from int, to ...int) error {
+	for _, i := range to {
+		if err := a.Network.Dial(a.Nodes[from].Name, a.Nodes[i].Name); err != nil {
+			return err
+		}
+	}
+	return a.WaitFor(func() error {
+		for _, i := range to {
+			if err := a.connected(from, i); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// ConnectAll connects each pair of nodes.
+func (a *Simulation) ConnectAll() error {
+	for i := range a.Nodes {
+		for j := i + 1; j < len(a.Nodes); j++ {
+			if err := a.Connect(i, j); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Simulation) connected(i, j int) error {
+	if !a.hasPeer(i, j) || !a.hasPeer(j, i) {
+		return errors.Errorf("nodes %q and %q are not connected", a.Nodes[i].Name, a.Nodes[j].Name)
+	}
+	return nil
+}
+
+func (a *Simulation) hasPeer(i, j int) bool {
+	found := false
+	a.Nodes[i].Peers.EachConnected(func(p peer.Peer, _ *proto.Score) {
+		if p.(*simPeer).remote.name == a.Nodes[j].Name {
+			found = true
+		}
+	})
+	return found
+}
+
+// Partition splits nodes into groups by their indexes, see Network.Partition.
+func (a *Simulation) Partition(groups ...[]int) {
+	names := make([][]string, len(groups))
+	for i, g := range groups {
+		for _, n := range g {
+			names[i] = append(names[i], a.Nodes[n].Name)
+		}
+	}
+	a.Network.Partition(names...)
+}
+
+// Heal removes partitions.
+func (a *Simulation) Heal() {
+	a.Network.Heal()
+}
+
+// Advance moves virtual clock forward, the messages due to this time are delivered.
+func (a *Simulation) Advance(d time.Duration) {
+	a.Clock.Advance(d)
+}
+
+// Mine advances the clock by block interval and generates the block on top of the last block of node.
+// It waits until the block is applied by node.
+func (a *Simulation) Mine(i int) (*proto.Block, error) {
+	n := a.Nodes[i]
+	h, err := n.Height()
+	if err != nil {
+		return nil, err
+	}
+	a.Advance(a.conf.BlockInterval)
+	if err := n.Scheduler.MineNow(); err != nil {
+		return nil, err
+	}
+	err = a.WaitFor(func() error {
+		cur, err := n.Height()
+		if err != nil {
+			return err
+		}
+		if cur <= h {
+			return errors.Errorf("block is not applied by node %q at height %d", n.Name, h)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return n.State.TopBlock(), nil
+}
+
+// WaitFor calls the function until it returns no error or wait timeout expires, the last error is returned.
+// Pending messages are delivered between the calls by advancing the clock to the nearest scheduled event.
+func (a *Simulation) WaitFor(f func() error) error {
+	deadline := time.Now().Add(a.conf.WaitTimeout)
+	for {
+		err := f()
+		if err == nil {
+			return nil
+		}
+		if time.Now().After(deadline) {
+			return err
+		}
+		time.Sleep(pollInterval)
+		a.Advance(a.step())
+	}
+}
+
+// step is the time to the nearest message in flight, so the messages are delivered one by one.
+func (a *Simulation) step() time.Duration {
+	a.Clock.mu.Lock()
+	defer a.Clock.mu.Unlock()
+	if len(a.Clock.events) == 0 {
+		return 0
+	}
+	return a.Clock.events[0].at.Sub(a.Clock.now)
+}
+
+// WaitHeight waits until all given nodes (all nodes if none) reach the height.
+func (a *Simulation) WaitHeight(height uint64, nodes ...int) error {
+	return a.WaitFor(func() error {
+		for _, i := range a.indexes(nodes) {
+			h, err := a.Nodes[i].Height()
+			if err != nil {
+				return err
+			}
+			if h != height {
+				return errors.Errorf("node %q is at height %d, expected %d", a.Nodes[i].Name, h, height)
+			}
+		}
+		return nil
+	})
+}
+
+// WaitSameState waits until all given nodes (all nodes if none) have the same height, last block and state hash.
+func (a *Simulation) WaitSameState(nodes ...int) error {
+	return a.WaitFor(func() error {
+		return a.SameState(nodes...)
+	})
+}
+
+// SameState checks that all given nodes (all nodes if none) have the same height, last block and state hash.
+func (a *Simulation) SameState(nodes ...int) error {
+	idx := a.indexes(nodes)
+	first := a.Nodes[idx[0]]
+	height, err := first.Height()
+	if err != nil {
+		return err
+	}
+	hash, err := first.StateHash(height)
+	if err != nil {
+		return err
+	}
+	for _, i := range idx[1:] {
+		n := a.Nodes[i]
+		h, err := n.Height()
+		if err != nil {
+			return err
+		}
+		if h != height {
+			return errors.Errorf("node %q is at height %d, node %q is at height %d", first.Name, height, n.Name, h)
+		}
+		sh, err := n.StateHash(h)
+		if err != nil {
+			return err
+		}
+		if sh.BlockID != hash.BlockID {
+			return errors.Errorf("nodes %q and %q have different blocks '%s' and '%s' at height %d",
+				first.Name, n.Name, hash.BlockID.String(), sh.BlockID.String(), height)
+		}
+		if sh.SumHash != hash.SumHash {
+			return errors.Errorf("nodes %q and %q have different state hashes at height %d", first.Name, n.Name, height)
+		}
+	}
+	return nil
+}
+
+func (a *Simulation) indexes(nodes []int) []int {
+	if len(nodes) > 0 {
+		return nodes
+	}
+	out := make([]int, len(a.Nodes))
+	for i := range out {
+		out[i] = i
+	}
+	return out
+}
+
+// Close stops all nodes and removes temporary directory.
+func (a *Simulation) Close() {
+	for _, n := range a.Nodes {
+		n.node.Close()
+	}
+	a.cancel()
+	if a.tempDir {
+		_ = os.RemoveAll(a.conf.Dir)
+	}
+}
@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClock_Advance(t *testing.T) {
+	start := time.Unix(1000, 0)
+	c := NewClock(start)
+	var order []int
+	c.AfterFunc(2*time.Second, func() { order = append(order, 2) })
+	c.AfterFunc(time.Second, func() { order = append(order, 1) })
+	c.AfterFunc(2*time.Second, func() { order = append(order, 3) })
+	c.AfterFunc(0, func() { order = append(order, 0) })
+	require.Equal(t, []int{0}, order)
+	require.Equal(t, 3, c.Pending())
+
+	c.Advance(time.Second)
+	require.Equal(t, []int{0, 1}, order)
+	require.Equal(t, start.Add(time.Second), c.Now())
+
+	c.Advance(5 * time.Second)
+	require.Equal(t, []int{0, 1, 2, 3}, order)
+	require.Equal(t, start.Add(6*time.Second), c.Now())
+	require.Zero(t, c.Pending())
+}
+
+func TestSimulation_Fork(t *testing.T) {
+	sim, err := New(Config{Nodes: 3, Latency: 100 * time.Millisecond})
+	require.NoError(t, err)
+	defer sim.Close()
+	require.NoError(t, sim.ConnectAll())
+
+	_, err = sim.Mine(0)
+	require.NoError(t, err)
+	require.NoError(t, sim.WaitHeight(2))
+	require.NoError(t, sim.WaitSameState())
+
+	// both sides of partition build their own chains
+	sim.Partition([]int{0}, []int{1, 2})
+	forked, err := sim.Mine(0)
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = sim.Mine(1)
+		require.NoError(t, err)
+	}
+	require.NoError(t, sim.WaitHeight(4, 1, 2))
+	require.NoError(t, sim.WaitSameState(1, 2))
+	require.Error(t, sim.SameState())
+
+	// after the partition is healed node with the shorter chain rolls back and switches to the longer one
+	sim.Heal()
+	_, err = sim.Mine(2)
+	require.NoError(t, err)
+	require.NoError(t, sim.WaitHeight(5))
+	require.NoError(t, sim.WaitSameState())
+	id, err := sim.Nodes[0].State.HeightToBlockID(3)
+	require.NoError(t, err)
+	require.NotEqual(t, forked.BlockID(), id)
+}
@@ -1,18 +1,23 @@
 package state_fsm
 
 import (
+	"fmt"
+	"math/rand"
 	"time"
 
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/libs/microblock_cache"
 	"github.com/wavesplatform/gowaves/pkg/miner"
 	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
 	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/ng"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
 	. "github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer/extension"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/services"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	storage "github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/types"
 )
@@ -49,15 +54,23 @@ type BaseInfo struct {
 	types.Scheduler
 
 	microMiner *miner.MicroMiner
+	signer     signer.Signer
 
 	MicroBlockCache    services.MicroBlockCache
 	MicroBlockInvCache services.MicroBlockInvCache
 
 	actions Actions
 
-	utx types.UtxPool
+	utx           types.UtxPool
+	utxMaintainer *utxpool.Maintainer
 }
 
+const (
+	utxMaintenanceInterval = 30 * time.Second
+	// number of peers long pending transactions are sent to
+	rebroadcastPeers = 3
+)
+
 func (a *BaseInfo) BroadcastTransaction(t proto.Transaction, receivedFrom peer.Peer) {
 	a.peers.EachConnected(func(p peer.Peer, score *proto.Score) {
 		if p != receivedFrom {
@@ -66,6 +79,49 @@ func (a *BaseInfo) BroadcastTransaction(t proto.Transaction, receivedFrom peer.P
 	})
 }
 
+// broadcastTransactionToSubset sends transaction to the limited number of randomly chosen connected peers.
+func (a *BaseInfo) broadcastTransactionToSubset(t proto.Transaction, limit int) {
+	var connected []peer.Peer
+	a.peers.EachConnected(func(p peer.Peer, score *proto.Score) {
+		connected = append(connected, p)
+	})
+	rand.Shuffle(len(connected), func(i, j int) {
+		connected[i], connected[j] = connected[j], connected[i]
+	})
+	for i := 0; i < len(connected) && i < limit; i++ {
+		_ = extension.NewPeerExtension(connected[i], a.scheme).SendTransaction(t)
+	}
+}
+
+// MaintainUtx expires and revalidates transactions in the pool and rebroadcasts the long pending ones.
+func (a *BaseInfo) MaintainUtx() {
+	if a.utxMaintainer == nil {
+		return
+	}
+	for _, t := range a.utxMaintainer.Maintain() {
+		a.broadcastTransactionToSubset(t.T, rebroadcastPeers)
+	}
+}
+
+// reportTransaction lowers the reputation of peer that sent the transaction with invalid signature or data.
+// Other validation errors are not reported, peer could relay the transaction valid against its own state.
+// Transactions broadcast by node itself have no peer.
+func (a *BaseInfo) reportTransaction(p peer.Peer, err error) {
+	if p != nil && storage.IsTxDataError(err) {
+		a.peers.Report(p, peer_manager.InvalidTransaction)
+	}
+}
+
+// reportBlock changes the reputation of peer that sent the block depending on the result of applying it.
+func (a *BaseInfo) reportBlock(p peer.Peer, err error) {
+	switch {
+	case err == nil:
+		a.peers.Report(p, peer_manager.UsefulBlock)
+	case storage.IsValidationError(err):
+		a.peers.Report(p, peer_manager.InvalidBlock)
+	}
+}
+
 func (a *BaseInfo) CleanUtx() {
 	utxpool.NewCleaner(a.storage, a.utx, a.tm).Clean()
 }
@@ -79,7 +135,7 @@ type FSM interface {
 	PeerError(p peer.Peer, e error) (FSM, Async, error)
 	Score(p peer.Peer, score *proto.Score) (FSM, Async, error)
 	Block(p peer.Peer, block *proto.Block) (FSM, Async, error)
-	MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error)
+	MinedBlock(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error)
 
 	// Received signatures after asking by GetSignatures
 	BlockIDs(peer.Peer, []proto.BlockID) (FSM, Async, error)
@@ -95,6 +151,24 @@ type FSM interface {
 	Halt() (FSM, Async, error)
 }
 
+// Status returns the name of FSM state and the progress of synchronization if it's in progress.
+func Status(fsm FSM) (string, *status.Sync) {
+	switch t := fsm.(type) {
+	case *IdleFsm:
+		return "Idle", nil
+	case *SyncFsm:
+		return "Sync", t.status()
+	case *NGFsm:
+		return "NG", nil
+	case *PersistFsm:
+		return "Persist", nil
+	case *HaltFSM:
+		return "Halt", nil
+	default:
+		return fmt.Sprintf("%T", fsm), nil
+	}
+}
+
 func NewFsm(
 	services services.Services,
 	outdatePeriod proto.Timestamp,
@@ -116,13 +190,15 @@ func NewFsm(
 		Scheduler: services.Scheduler,
 
 		microMiner: miner.NewMicroMiner(services),
+		signer:     services.Signer,
 
 		MicroBlockCache:    services.MicroBlockCache,
 		MicroBlockInvCache: microblock_cache.NewMicroblockInvCache(),
 
 		actions: &ActionsImpl{services: services},
 
-		utx: services.UtxPool,
+		utx:           services.UtxPool,
+		utxMaintainer: services.UtxMaintainer,
 	}
 
 	b.Scheduler.Reschedule()
@@ -133,6 +209,9 @@ func NewFsm(
 		NewAskPeersTask(5 * time.Minute),
 		NewPingTask(),
 	}
+	if b.utxMaintainer != nil {
+		tasks = append(tasks, NewMaintainUtxTask(utxMaintenanceInterval))
+	}
 
 	return NewIdleFsm(b), tasks, nil
 }
@@ -79,8 +79,8 @@ func sendScore(p Peer, storage state.State) {
 }
 
 // TODO send micro block
-//func handleMineMicro(a FromBaseInfo, base BaseInfo, minedBlock *proto.Block, rest miner.MiningLimits, blocks ng.Blocks, keyPair proto.KeyPair) (FSM, Async, error) {
-//	block, micro, rest, err := base.microMiner.Micro(rest, minedBlock, blocks, keyPair)
+//func handleMineMicro(a FromBaseInfo, base BaseInfo, minedBlock *proto.Block, rest miner.MiningLimits, blocks ng.Blocks, pk crypto.PublicKey) (FSM, Async, error) {
+//	block, micro, rest, err := base.microMiner.Micro(rest, minedBlock, blocks, pk)
 //	if err != nil {
 //		return a, nil, err
 //	}
@@ -1,6 +1,7 @@
 package state_fsm
 
 import (
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
@@ -34,7 +35,7 @@ func (a HaltFSM) Block(peer peer.Peer, block *proto.Block) (FSM, Async, error) {
 	return noop(a)
 }
 
-func (a HaltFSM) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
+func (a HaltFSM) MinedBlock(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
 	return noop(a)
 }
 
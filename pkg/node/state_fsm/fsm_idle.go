@@ -2,6 +2,7 @@ package state_fsm
 
 import (
 	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	. "github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
@@ -22,6 +23,7 @@ func (a *IdleFsm) Transaction(p peer.Peer, t proto.Transaction) (FSM, Async, err
 	if err != nil {
 		a.baseInfo.BroadcastTransaction(t, p)
 	}
+	a.baseInfo.reportTransaction(p, err)
 	return a, nil, err
 }
 
@@ -29,8 +31,8 @@ func (a *IdleFsm) Halt() (FSM, Async, error) {
 	return HaltTransition(a.baseInfo)
 }
 
-func (a *IdleFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
-	return MinedBlockNgTransition(a.baseInfo, block, limits, keyPair, vrf)
+func (a *IdleFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
+	return MinedBlockNgTransition(a.baseInfo, block, limits, pk, vrf)
 }
 
 func (a *IdleFsm) MicroBlock(p peer.Peer, micro *proto.MicroBlock) (FSM, Async, error) {
@@ -49,6 +51,9 @@ func (a *IdleFsm) Task(task AsyncTask) (FSM, Async, error) {
 	case ASK_PEERS:
 		a.baseInfo.peers.AskPeers()
 		return a, nil, nil
+	case MaintainUtx:
+		a.baseInfo.MaintainUtx()
+		return a, nil, nil
 	default:
 		return a, nil, errors.Errorf("IdleFsm Task: unknown task type %d, data %+v", task.TaskType, task.Data)
 	}
@@ -1,9 +1,8 @@
 package state_fsm
 
 import (
-	"time"
-
 	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/miner"
 	. "github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
@@ -21,6 +20,7 @@ func (a *NGFsm) Transaction(p peer.Peer, t proto.Transaction) (FSM, Async, error
 	if err != nil {
 		a.BroadcastTransaction(t, p)
 	}
+	a.reportTransaction(p, err)
 	return a, nil, err
 }
 
@@ -31,9 +31,12 @@ func (a *NGFsm) Task(task AsyncTask) (FSM, Async, error) {
 	case ASK_PEERS:
 		a.peers.AskPeers()
 		return a, nil, nil
+	case MaintainUtx:
+		a.MaintainUtx()
+		return a, nil, nil
 	case MINE_MICRO:
 		t := task.Data.(MineMicroTaskData)
-		return a.mineMicro(t.Block, t.Limits, t.KeyPair, t.Vrf)
+		return a.mineMicro(t.Block, t.Limits, t.PublicKey, t.Vrf)
 	default:
 		return a, nil, errors.Errorf("NGFsm Task: unknown task type %d, data %+v", task.TaskType, task.Data)
 	}
@@ -66,16 +69,18 @@ func (a *NGFsm) Score(p peer.Peer, score *proto.Score) (FSM, Async, error) {
 
 func (a *NGFsm) Block(peer peer.Peer, block *proto.Block) (FSM, Async, error) {
 	err := a.blocksApplier.Apply(a.storage, []*proto.Block{block})
+	a.reportBlock(peer, err)
 	if err != nil {
 		return a, nil, err
 	}
+	a.microMiner.Stats().Block(block.Parent)
 	a.Scheduler.Reschedule()
 	a.actions.SendScore(a.storage)
 	a.CleanUtx()
 	return NewNGFsm12(a.BaseInfo), nil, nil
 }
 
-func (a *NGFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
+func (a *NGFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
 	err := a.storage.Map(func(state state.NonThreadSafeState) error {
 		return a.blocksApplier.Apply(state, []*proto.Block{block})
 	})
@@ -87,7 +92,8 @@ func (a *NGFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPai
 	a.actions.SendBlock(block)
 	a.actions.SendScore(a.storage)
 	a.CleanUtx()
-	return NewNGFsm12(a.BaseInfo), Tasks(NewMineMicroTask(5*time.Second, block, limits, keyPair, vrf)), nil
+	a.microMiner.Stats().KeyBlock(block.BlockID())
+	return NewNGFsm12(a.BaseInfo), Tasks(NewMineMicroTask(a.microMiner.Settings().MinDelay, block, limits, pk, vrf)), nil
 }
 
 func (a *NGFsm) BlockIDs(peer peer.Peer, sigs []proto.BlockID) (FSM, Async, error) {
@@ -124,11 +130,11 @@ func (a *NGFsm) MicroBlock(p peer.Peer, micro *proto.MicroBlock) (FSM, Async, er
 	return a, nil, nil
 }
 
-func (a *NGFsm) mineMicro(minedBlock *proto.Block, rest proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
+func (a *NGFsm) mineMicro(minedBlock *proto.Block, rest proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
 	defer a.Reschedule()
-	block, micro, rest, err := a.microMiner.Micro(minedBlock, rest, keyPair, vrf)
+	block, micro, rest, err := a.microMiner.Micro(minedBlock, rest, pk, vrf)
 	if err == miner.NoTransactionsErr {
-		return a, Tasks(NewMineMicroTask(5*time.Second, minedBlock, rest, keyPair, vrf)), nil
+		return a, Tasks(NewMineMicroTask(a.microMiner.Settings().Interval, minedBlock, rest, pk, vrf)), nil
 	}
 	if err != nil {
 		return a, nil, errors.Wrap(err, "NGFsm.mineMicro")
@@ -143,7 +149,7 @@ func (a *NGFsm) mineMicro(minedBlock *proto.Block, rest proto.MiningLimits, keyP
 		micro.SenderPK,
 		block.ID,
 		micro.Reference)
-	err = inv.Sign(keyPair.Secret, a.scheme)
+	err = a.signer.SignMicroBlockInv(pk, inv)
 	if err != nil {
 		return a, nil, err
 	}
@@ -161,7 +167,7 @@ func (a *NGFsm) mineMicro(minedBlock *proto.Block, rest proto.MiningLimits, keyP
 			},
 		)
 	})
-	return a, Tasks(NewMineMicroTask(5*time.Second, block, rest, keyPair, vrf)), nil
+	return a, Tasks(NewMineMicroTask(a.microMiner.Settings().Interval, block, rest, pk, vrf)), nil
 }
 
 func (a *NGFsm) microBlockByID(micro *proto.MicroBlock) (FSM, Async, error) {
@@ -205,6 +211,6 @@ func (a *NGFsm) MicroBlockInv(p peer.Peer, inv *proto.MicroBlockInv) (FSM, Async
 	return a, nil, nil
 }
 
-func MinedBlockNgTransition(info BaseInfo, block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
-	return NewNGFsm12(info).MinedBlock(block, limits, keyPair, vrf)
+func MinedBlockNgTransition(info BaseInfo, block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
+	return NewNGFsm12(info).MinedBlock(block, limits, pk, vrf)
 }
@@ -3,6 +3,7 @@ package state_fsm
 import (
 	"context"
 
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
 	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
@@ -31,7 +32,7 @@ func (a *PersistFsm) Block(p peer.Peer, block *proto.Block) (FSM, Async, error)
 	return noop(a)
 }
 
-func (a *PersistFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
+func (a *PersistFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
 	return noop(a)
 }
 
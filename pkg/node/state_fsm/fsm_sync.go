@@ -4,7 +4,11 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/consensus"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/libs/signatures"
+	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/sync_internal"
 	. "github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
 	. "github.com/wavesplatform/gowaves/pkg/p2p/peer"
@@ -16,6 +20,8 @@ import (
 
 type conf struct {
 	peerSyncWith Peer
+	// distributes requests of blocks between peerSyncWith and other peers with the same chain
+	downloader *sync_internal.Downloader
 	// if nothing happens more than N duration, means we stalled, so go to idle and again
 	lastReceiveTime time.Time
 
@@ -25,6 +31,7 @@ type conf struct {
 func (c conf) Now() conf {
 	return conf{
 		peerSyncWith:    c.peerSyncWith,
+		downloader:      c.downloader,
 		lastReceiveTime: time.Now(),
 		timeout:         c.timeout,
 	}
@@ -60,11 +67,19 @@ func (a *SyncFsm) Task(task AsyncTask) (FSM, Async, error) {
 	case ASK_PEERS:
 		a.baseInfo.peers.AskPeers()
 		return a, nil, nil
+	case MaintainUtx:
+		a.baseInfo.MaintainUtx()
+		return a, nil, nil
 	case PING:
 		timeout := a.conf.lastReceiveTime.Add(a.conf.timeout).Before(a.baseInfo.tm.Now())
 		if timeout {
+			a.baseInfo.peers.Report(a.conf.peerSyncWith, peer_manager.Timeout)
 			return NewIdleFsm(a.baseInfo), nil, TimeoutErr
 		}
+		for _, p := range a.conf.downloader.CheckTimeouts(a.baseInfo.tm.Now()) {
+			zap.S().Debugf("SyncFsm: peer %s is too slow to download blocks from", p.ID())
+			a.baseInfo.peers.Report(p, peer_manager.Timeout)
+		}
 		return a, nil, nil
 	default:
 		return a, nil, errors.Errorf("SyncFsm Task: unknown task type %d, data %+v", task.TaskType, task.Data)
@@ -82,6 +97,11 @@ func (noopWrapper) AskBlock(proto.BlockID) {
 
 func (a *SyncFsm) PeerError(p Peer, e error) (FSM, Async, error) {
 	a.baseInfo.peers.Disconnect(p)
+	if a.conf.peerSyncWith != p && a.conf.downloader.Has(p) {
+		// helper is lost, its blocks are requested from the other peers
+		a.conf.downloader.RemovePeer(p)
+		return a, nil, nil
+	}
 	if a.conf.peerSyncWith == p {
 		_, blocks, _ := a.internal.Blocks(noopWrapper{})
 		if len(blocks) > 0 {
@@ -96,9 +116,11 @@ func (a *SyncFsm) PeerError(p Peer, e error) (FSM, Async, error) {
 
 func (a *SyncFsm) BlockIDs(peer Peer, sigs []proto.BlockID) (FSM, Async, error) {
 	if a.conf.peerSyncWith != peer {
+		// helper tells which blocks it has
+		a.conf.downloader.BlockIDs(peer, sigs)
 		return a, nil, nil
 	}
-	internal, err := a.internal.BlockIDs(extension.NewPeerExtension(peer, a.baseInfo.scheme), sigs)
+	internal, err := a.internal.BlockIDs(a.conf.downloader, sigs)
 	if err != nil {
 		return newSyncFsm(a.baseInfo, a.conf, internal), nil, err
 	}
@@ -120,9 +142,10 @@ func (a *SyncFsm) Score(p Peer, score *proto.Score) (FSM, Async, error) {
 }
 
 func (a *SyncFsm) Block(p Peer, block *proto.Block) (FSM, Async, error) {
-	if p != a.conf.peerSyncWith {
+	if !a.conf.downloader.Has(p) {
 		return a, nil, nil
 	}
+	a.conf.downloader.Received(block.BlockID())
 	internal, err := a.internal.Block(block)
 	if err != nil {
 		return newSyncFsm(a.baseInfo, a.conf, internal), nil, err
@@ -130,7 +153,7 @@ func (a *SyncFsm) Block(p Peer, block *proto.Block) (FSM, Async, error) {
 	return a.applyBlocks(a.baseInfo, a.conf.Now(), internal)
 }
 
-func (a *SyncFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) (FSM, Async, error) {
+func (a *SyncFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) (FSM, Async, error) {
 	err := a.baseInfo.blocksApplier.Apply(a.baseInfo.storage, []*proto.Block{block})
 	if err != nil {
 		return a, nil, err
@@ -140,22 +163,39 @@ func (a *SyncFsm) MinedBlock(block *proto.Block, limits proto.MiningLimits, keyP
 	// first we should send block
 	a.baseInfo.actions.SendBlock(block)
 	a.baseInfo.actions.SendScore(a.baseInfo.storage)
-	return a, Tasks(NewMineMicroTask(5*time.Second, block, limits, keyPair, vrf)), nil
+	a.baseInfo.microMiner.Stats().KeyBlock(block.BlockID())
+	return a, Tasks(NewMineMicroTask(a.baseInfo.microMiner.Settings().MinDelay, block, limits, pk, vrf)), nil
 }
 
 func (a *SyncFsm) Halt() (FSM, Async, error) {
 	return HaltTransition(a.baseInfo)
 }
 
-// TODO suspend peer on state error
 func (a *SyncFsm) applyBlocks(baseInfo BaseInfo, conf conf, internal sync_internal.Internal) (FSM, Async, error) {
-	internal, blocks, eof := internal.Blocks(extension.NewPeerExtension(conf.peerSyncWith, a.baseInfo.scheme))
+	if conf.downloader.Probing() {
+		probe := internal.Available()
+		if len(probe) == 0 || (len(probe) < conf.downloader.ProbeSize() && len(probe) < internal.WaitingCount()) {
+			return newSyncFsm(baseInfo, conf, internal), nil, nil
+		}
+		if err := a.checkHeaders(probe); err != nil {
+			return a.invalidChain(conf, err)
+		}
+		conf.downloader.Probed()
+	}
+	if a.waitForFork(internal) {
+		return newSyncFsm(baseInfo, conf, internal), nil, nil
+	}
+	internal, blocks, eof := internal.Blocks(conf.downloader)
 	if len(blocks) == 0 {
 		return newSyncFsm(baseInfo, conf, internal), nil, nil
 	}
+	if err := a.checkHeaders(blocks); err != nil {
+		return a.invalidChain(conf, err)
+	}
 	err := a.baseInfo.storage.Map(func(s state.NonThreadSafeState) error {
 		return a.baseInfo.blocksApplier.Apply(s, blocks)
 	})
+	a.baseInfo.reportBlock(conf.peerSyncWith, err)
 	if err != nil {
 		return NewIdleFsm(a.baseInfo), nil, err
 	}
@@ -178,6 +218,63 @@ func (a *SyncFsm) applyBlocks(baseInfo BaseInfo, conf conf, internal sync_intern
 	return newSyncFsm(baseInfo, conf, internal), nil, nil
 }
 
+func (a *SyncFsm) status() *status.Sync {
+	out := &status.Sync{
+		Peer:          a.conf.peerSyncWith.ID(),
+		BlocksPending: a.internal.WaitingCount(),
+		Helpers:       len(a.conf.downloader.Peers()) - 1,
+	}
+	if score, err := a.baseInfo.peers.Score(a.conf.peerSyncWith); err == nil {
+		out.TargetScore = score.String()
+	}
+	return out
+}
+
+// waitForFork tells if the last blocks start the fork and some of them are not received yet.
+// Blocks of fork are applied at once, otherwise the first of them may have lower score than the current chain.
+func (a *SyncFsm) waitForFork(internal sync_internal.Internal) bool {
+	if !internal.NearEnd() || internal.AvailableCount() == internal.WaitingCount() {
+		return false
+	}
+	first, ok := internal.First()
+	if !ok {
+		return false
+	}
+	return first.Parent != a.baseInfo.storage.TopBlock().BlockID()
+}
+
+// invalidChain stops the sync with the peer whose blocks failed the check of headers,
+// its chain is invalid and there is no reason to download it further.
+func (a *SyncFsm) invalidChain(conf conf, err error) (FSM, Async, error) {
+	a.baseInfo.peers.Report(conf.peerSyncWith, peer_manager.InvalidBlock)
+	a.baseInfo.peers.Suspend(conf.peerSyncWith, err.Error())
+	return NewIdleFsm(a.baseInfo), nil, err
+}
+
+// checkHeaders validates consensus of blocks before their transactions are applied.
+// Blocks may start from the fork, so they are checked on top of their parent instead of the last block.
+// Failures of local state are not errors of peer, such blocks are validated by state as usual.
+func (a *SyncFsm) checkHeaders(blocks []*proto.Block) error {
+	if len(blocks) == 0 {
+		return nil
+	}
+	height, err := a.baseInfo.storage.BlockIDToHeight(blocks[0].Parent)
+	if err != nil {
+		zap.S().Errorf("SyncFsm: failed to check headers: %v", err)
+		return nil
+	}
+	cv, err := consensus.NewHeadersValidator(a.baseInfo.storage, a.baseInfo.tm)
+	if err != nil {
+		zap.S().Errorf("SyncFsm: failed to check headers: %v", err)
+		return nil
+	}
+	headers := make([]proto.BlockHeader, len(blocks))
+	for i, b := range blocks {
+		headers[i] = b.BlockHeader
+	}
+	return errors.Wrap(cv.CheckHeaders(headers, height), "invalid headers")
+}
+
 func NewSyncFsm(baseInfo BaseInfo, conf2 conf, internal sync_internal.Internal) (FSM, Async, error) {
 	return newSyncFsm(baseInfo, conf2, internal), nil, nil
 }
@@ -198,7 +295,25 @@ func NewIdleToSyncTransition(baseInfo BaseInfo, p Peer) (FSM, Async, error) {
 	internal := sync_internal.InternalFromLastSignatures(extension.NewPeerExtension(p, baseInfo.scheme), lastSigs)
 	c := conf{
 		peerSyncWith: p,
+		downloader:   sync_internal.NewDownloader(p, helpers(baseInfo, p), baseInfo.scheme, sync_internal.DefaultDownloadSettings),
 		timeout:      30 * time.Second,
 	}
 	return NewSyncFsm(baseInfo, c.Now(), internal)
 }
+
+// helpers returns connected peers besides the sync one which are ahead of us, blocks are downloaded from them too
+// if they announce the same blocks as the sync peer.
+func helpers(baseInfo BaseInfo, syncWith Peer) []Peer {
+	score, err := baseInfo.storage.CurrentScore()
+	if err != nil {
+		zap.S().Debugf("SyncFsm: failed to get current score: %v", err)
+		return nil
+	}
+	var out []Peer
+	baseInfo.peers.EachConnected(func(p Peer, s *proto.Score) {
+		if p != syncWith && s.Cmp(score) > 0 {
+			out = append(out, p)
+		}
+	})
+	return out
+}
@@ -1,14 +1,20 @@
 package state_fsm
 
 import (
+	"math/big"
 	"testing"
 
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/libs/ntptime"
+	"github.com/wavesplatform/gowaves/pkg/libs/ordered_blocks"
+	"github.com/wavesplatform/gowaves/pkg/libs/signatures"
 	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
 	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/sync_internal"
 	. "github.com/wavesplatform/gowaves/pkg/node/state_fsm/tasks"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 )
 
@@ -21,6 +27,8 @@ func TestSyncFsm_Sync(t *testing.T) {
 	mockState := mock.NewMockState(ctrl)
 
 	mockState.EXPECT().Height().Return(proto.Height(0), nil)
+	mockState.EXPECT().CurrentScore().Return(big.NewInt(10), nil)
+	mockManager.EXPECT().EachConnected(gomock.Any())
 
 	mockPeer.EXPECT().Handshake().Return(proto.Handshake{Version: proto.NewVersion(1, 2, 0)})
 	mockPeer.EXPECT().SendMessage(gomock.Any())
@@ -39,9 +47,11 @@ func TestSyncFsm_SignaturesTimeout(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 	p := mock.NewMockPeer(ctrl)
+	mockManager := mock.NewMockPeerManager(ctrl)
+	mockManager.EXPECT().Report(p, peer_manager.Timeout)
 
 	conf := conf{peerSyncWith: p}
-	fsm, async, err := NewSyncFsm(BaseInfo{tm: ntptime.Stub{}}, conf, sync_internal.Internal{})
+	fsm, async, err := NewSyncFsm(BaseInfo{peers: mockManager, tm: ntptime.Stub{}}, conf, sync_internal.Internal{})
 	require.NoError(t, err)
 	require.Len(t, async, 0)
 	require.NotNil(t, fsm)
@@ -52,3 +62,58 @@ func TestSyncFsm_SignaturesTimeout(t *testing.T) {
 
 	require.IsType(t, &IdleFsm{}, fsm)
 }
+
+func TestSyncFsm_Helpers(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	syncWith := mock.NewMockPeer(ctrl)
+	ahead := mock.NewMockPeer(ctrl)
+	behind := mock.NewMockPeer(ctrl)
+	mockManager := mock.NewMockPeerManager(ctrl)
+	mockState := mock.NewMockState(ctrl)
+
+	mockState.EXPECT().CurrentScore().Return(big.NewInt(10), nil)
+	mockManager.EXPECT().EachConnected(gomock.Any()).Do(func(f func(p peer.Peer, s *proto.Score)) {
+		f(syncWith, big.NewInt(20))
+		f(ahead, big.NewInt(11))
+		f(behind, big.NewInt(10))
+	})
+
+	require.Equal(t, []peer.Peer{ahead}, helpers(BaseInfo{peers: mockManager, storage: mockState}, syncWith))
+}
+
+func TestSyncFsm_WaitForFork(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	sig1 := crypto.MustSignatureFromBase58("31jt6L3pDU2mkow3kDK7kUZjQbqJsMnE5gC6As7Cz27xjqAaZpiNqopf6NJWbtwrV9VcjShKFfhgLmjpr8Ybuv41")
+	sig2 := crypto.MustSignatureFromBase58("53wmPSc2n5DwpDcJUfNCV7j2wCuc227M9onwrs72orKuyKy5iPkcvKzE4a1Bikr2ixTG8N6GRrM8grn8sQ7qaC8w")
+	top := &proto.Block{BlockHeader: proto.BlockHeader{BlockSignature: sig2}}
+	forkParent := proto.NewBlockIDFromSignature(crypto.Signature{})
+	mockState := mock.NewMockState(ctrl)
+	mockState.EXPECT().TopBlock().Return(top).AnyTimes()
+	a := &SyncFsm{baseInfo: BaseInfo{storage: mockState}}
+
+	internalWith := func(parent proto.BlockID, nearEnd, second bool) sync_internal.Internal {
+		ids := []proto.BlockID{proto.NewBlockIDFromSignature(sig1), proto.NewBlockIDFromSignature(crypto.Signature{1})}
+		o := ordered_blocks.NewOrderedBlocks()
+		for _, id := range ids {
+			o.Add(id)
+		}
+		o.SetBlock(&proto.Block{BlockHeader: proto.BlockHeader{BlockSignature: sig1, Parent: parent}})
+		if second {
+			o.SetBlock(&proto.Block{BlockHeader: proto.BlockHeader{BlockSignature: crypto.Signature{1}, Parent: ids[0]}})
+		}
+		return sync_internal.NewInternal(o, signatures.NewSignatures().Revert(), false, nearEnd)
+	}
+
+	// first block of fork is received, the next one is not
+	require.True(t, a.waitForFork(internalWith(forkParent, true, false)))
+	// all blocks of fork are received
+	require.False(t, a.waitForFork(internalWith(forkParent, true, true)))
+	// blocks continue the current chain
+	require.False(t, a.waitForFork(internalWith(top.BlockID(), true, false)))
+	// far from the end blocks are applied as usual
+	require.False(t, a.waitForFork(internalWith(forkParent, false, false)))
+}
@@ -0,0 +1,106 @@
+package status
+
+import (
+	"sync"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+// DefaultHistorySize is the number of recent transitions kept by tracker.
+const DefaultHistorySize = 100
+
+// Sync describes the progress of synchronization.
+type Sync struct {
+	Peer          string `json:"peer"`
+	TargetScore   string `json:"target_score"`
+	BlocksPending int    `json:"blocks_pending"`
+	Helpers       int    `json:"helpers"`
+}
+
+// Status is the current state of FSM.
+type Status struct {
+	State string `json:"state"`
+	// time the state entered, in milliseconds
+	Since uint64 `json:"since"`
+	Sync  *Sync  `json:"sync,omitempty"`
+}
+
+// Transition is the change of FSM state caused by event.
+type Transition struct {
+	From      string `json:"from"`
+	To        string `json:"to"`
+	Event     string `json:"event"`
+	Peer      string `json:"peer,omitempty"`
+	Error     string `json:"error,omitempty"`
+	Timestamp uint64 `json:"timestamp"`
+}
+
+// Tracker keeps the current state of FSM and the bounded history of its transitions.
+// It's updated by node after every event and read by API, so it's thread safe.
+type Tracker struct {
+	mu      sync.Mutex
+	status  Status
+	history []Transition
+	// position of the oldest transition when history is full
+	next int
+	size int
+}
+
+func NewTracker(size int) *Tracker {
+	return &Tracker{
+		history: make([]Transition, 0, size),
+		size:    size,
+	}
+}
+
+// Update sets the current state of FSM after the event, the transition is recorded only if the state has changed.
+func (a *Tracker) Update(state string, sync *Sync, event string, peer string, err error, now time.Time) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.status.Sync = sync
+	if a.status.State == state {
+		return
+	}
+	t := Transition{
+		From:      a.status.State,
+		To:        state,
+		Event:     event,
+		Peer:      peer,
+		Timestamp: proto.NewTimestampFromTime(now),
+	}
+	if err != nil {
+		t.Error = err.Error()
+	}
+	a.status.State = state
+	a.status.Since = t.Timestamp
+	if a.size == 0 {
+		return
+	}
+	if len(a.history) < a.size {
+		a.history = append(a.history, t)
+		return
+	}
+	a.history[a.next] = t
+	a.next = (a.next + 1) % a.size
+}
+
+func (a *Tracker) Status() Status {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out := a.status
+	if out.Sync != nil {
+		s := *out.Sync
+		out.Sync = &s
+	}
+	return out
+}
+
+// Transitions returns recent transitions, the oldest first.
+func (a *Tracker) Transitions() []Transition {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	out := make([]Transition, 0, len(a.history))
+	out = append(out, a.history[a.next:]...)
+	return append(out, a.history[:a.next]...)
+}
@@ -0,0 +1,37 @@
+package status
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func TestTracker_Update(t *testing.T) {
+	tr := NewTracker(2)
+	now := time.Now()
+
+	tr.Update("Idle", nil, "Init", "", nil, now)
+	require.Equal(t, Status{State: "Idle", Since: proto.NewTimestampFromTime(now)}, tr.Status())
+
+	sync := &Sync{Peer: "peer", TargetScore: "100", BlocksPending: 10}
+	tr.Update("Sync", sync, "Score", "peer", nil, now.Add(time.Second))
+	// same state is not a transition
+	sync2 := &Sync{Peer: "peer", TargetScore: "100", BlocksPending: 5}
+	tr.Update("Sync", sync2, "Block", "peer", nil, now.Add(2*time.Second))
+	require.Equal(t, Status{State: "Sync", Since: proto.NewTimestampFromTime(now.Add(time.Second)), Sync: sync2}, tr.Status())
+
+	tr.Update("Idle", nil, "Task Ping", "", errors.New("timeout"), now.Add(3*time.Second))
+	transitions := tr.Transitions()
+	require.Len(t, transitions, 2)
+	require.Equal(t, "Score", transitions[0].Event)
+	require.Equal(t, Transition{
+		From:      "Sync",
+		To:        "Idle",
+		Event:     "Task Ping",
+		Error:     "timeout",
+		Timestamp: proto.NewTimestampFromTime(now.Add(3 * time.Second)),
+	}, transitions[1])
+}
@@ -0,0 +1,265 @@
+package sync_internal
+
+import (
+	"sort"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer/extension"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+type DownloadSettings struct {
+	// Max number of blocks requested from one peer and not received yet.
+	Window int
+	// Block not received in this period is requested from another peer.
+	Timeout time.Duration
+	// Max number of peers besides the main one the blocks are downloaded from.
+	MaxHelpers int
+	// Number of the first blocks downloaded from the main peer only, other blocks are not requested until
+	// the headers of these blocks are checked. Zero disables the check.
+	Probe int
+}
+
+var DefaultDownloadSettings = DownloadSettings{
+	Window:     20,
+	Timeout:    15 * time.Second,
+	MaxHelpers: 4,
+	Probe:      10,
+}
+
+type request struct {
+	peer peer.Peer
+	// position of block in the order of requesting, used to request the blocks again in the same order
+	seq  uint64
+	sent time.Time
+}
+
+type queued struct {
+	id  proto.BlockID
+	seq uint64
+}
+
+// Downloader distributes the requests of blocks between the main sync peer and the helper peers that share its chain.
+// Block IDs asked from the main peer are asked from the helpers too, a helper is asked only for the blocks it announced.
+// The same block ID means the same chain up to the block, so the helper on other chain or behind gets nothing to do.
+// Each peer has at most a window of block requests in flight, the main peer is loaded first. Helpers that don't
+// respond in time are dropped and their requests are passed to the other peers. Until the first blocks are checked
+// only they are requested and only from the main peer, so the invalid chain is not downloaded in parallel.
+// Downloader is not thread safe, it's used by FSM only.
+type Downloader struct {
+	conf      DownloadSettings
+	main      peer.Peer
+	peers     []peer.Peer
+	extension func(p peer.Peer) PeerExtension
+	queue     []queued
+	pending   map[proto.BlockID]request
+	inFlight  map[peer.Peer]int
+	// block IDs announced by helpers and not received yet
+	announced map[peer.Peer]map[proto.BlockID]struct{}
+	seq       uint64
+	probed    bool
+}
+
+func NewDownloader(main peer.Peer, helpers []peer.Peer, scheme proto.Scheme, conf DownloadSettings) *Downloader {
+	return newDownloader(main, helpers, conf, func(p peer.Peer) PeerExtension {
+		return extension.NewPeerExtension(p, scheme)
+	})
+}
+
+func newDownloader(main peer.Peer, helpers []peer.Peer, conf DownloadSettings, ext func(p peer.Peer) PeerExtension) *Downloader {
+	if len(helpers) > conf.MaxHelpers {
+		helpers = helpers[:conf.MaxHelpers]
+	}
+	peers := append([]peer.Peer{main}, helpers...)
+	return &Downloader{
+		conf:      conf,
+		main:      main,
+		peers:     peers,
+		extension: ext,
+		pending:   make(map[proto.BlockID]request),
+		inFlight:  make(map[peer.Peer]int),
+		announced: make(map[peer.Peer]map[proto.BlockID]struct{}),
+		probed:    conf.Probe <= 0,
+	}
+}
+
+// AskBlocksIDs asks all peers, the main one gives the blocks to download, the helpers tell which of them they have.
+func (a *Downloader) AskBlocksIDs(ids []proto.BlockID) {
+	for _, p := range a.peers {
+		a.extension(p).AskBlocksIDs(ids)
+	}
+}
+
+// BlockIDs records the block IDs announced by helper, the queued blocks among them are requested from it.
+func (a *Downloader) BlockIDs(p peer.Peer, ids []proto.BlockID) {
+	if p == a.main || !a.Has(p) {
+		return
+	}
+	known, ok := a.announced[p]
+	if !ok {
+		known = make(map[proto.BlockID]struct{}, len(ids))
+		a.announced[p] = known
+	}
+	for _, id := range ids {
+		known[id] = struct{}{}
+	}
+	a.dispatch(time.Now())
+}
+
+func (a *Downloader) hasBlock(p peer.Peer, id proto.BlockID) bool {
+	if p == a.main {
+		return true
+	}
+	_, ok := a.announced[p][id]
+	return ok
+}
+
+// AskBlock puts the block in queue, it's requested as soon as some peer has room in its window.
+func (a *Downloader) AskBlock(id proto.BlockID) {
+	a.queue = append(a.queue, queued{id: id, seq: a.seq})
+	a.seq++
+	a.dispatch(time.Now())
+}
+
+// Has tells whether the blocks are downloaded from the peer.
+func (a *Downloader) Has(p peer.Peer) bool {
+	for _, v := range a.peers {
+		if v == p {
+			return true
+		}
+	}
+	return false
+}
+
+func (a *Downloader) Peers() []peer.Peer {
+	return a.peers
+}
+
+// Probing tells whether the first blocks are not checked yet, ProbeSize of them are requested from the main peer.
+func (a *Downloader) Probing() bool {
+	return !a.probed
+}
+
+func (a *Downloader) ProbeSize() int {
+	return a.conf.Probe
+}
+
+// Probed tells that the first blocks passed the check, the rest of blocks are requested from all peers.
+func (a *Downloader) Probed() {
+	if a.probed {
+		return
+	}
+	a.probed = true
+	a.dispatch(time.Now())
+}
+
+// Received marks the block as received and requests the next ones.
+func (a *Downloader) Received(id proto.BlockID) {
+	r, ok := a.pending[id]
+	if !ok {
+		return
+	}
+	delete(a.pending, id)
+	a.inFlight[r.peer]--
+	for _, known := range a.announced {
+		delete(known, id)
+	}
+	a.dispatch(time.Now())
+}
+
+// RemovePeer stops downloading from the helper peer, its requests are passed to the other peers.
+// The main peer can't be removed.
+func (a *Downloader) RemovePeer(p peer.Peer) {
+	if p == a.main || !a.Has(p) {
+		return
+	}
+	for i, v := range a.peers {
+		if v == p {
+			a.peers = append(a.peers[:i:i], a.peers[i+1:]...)
+			break
+		}
+	}
+	a.requeue(func(r request) bool { return r.peer == p })
+	delete(a.inFlight, p)
+	delete(a.announced, p)
+	a.dispatch(time.Now())
+}
+
+// CheckTimeouts requests again the blocks that were not received in time and returns the helper peers that were
+// dropped because of it. The blocks requested from the main peer are requested from it again.
+func (a *Downloader) CheckTimeouts(now time.Time) []peer.Peer {
+	slow := make(map[peer.Peer]bool)
+	for _, r := range a.pending {
+		if now.Sub(r.sent) >= a.conf.Timeout {
+			slow[r.peer] = true
+		}
+	}
+	if len(slow) == 0 {
+		return nil
+	}
+	var dropped []peer.Peer
+	peers := a.peers[:0]
+	for _, p := range a.peers {
+		if slow[p] && p != a.main {
+			dropped = append(dropped, p)
+			delete(a.inFlight, p)
+			delete(a.announced, p)
+			continue
+		}
+		peers = append(peers, p)
+	}
+	a.peers = peers
+	a.requeue(func(r request) bool { return slow[r.peer] })
+	if slow[a.main] {
+		a.inFlight[a.main] = 0
+		for _, r := range a.pending {
+			if r.peer == a.main {
+				a.inFlight[a.main]++
+			}
+		}
+	}
+	a.dispatch(now)
+	return dropped
+}
+
+// requeue puts the pending requests selected by the function back to the head of queue in the original order.
+func (a *Downloader) requeue(f func(r request) bool) {
+	var back []queued
+	for id, r := range a.pending {
+		if f(r) {
+			back = append(back, queued{id: id, seq: r.seq})
+			delete(a.pending, id)
+		}
+	}
+	sort.Slice(back, func(i, j int) bool {
+		return back[i].seq < back[j].seq
+	})
+	a.queue = append(back, a.queue...)
+}
+
+// dispatch gives the queued blocks to the peers in order, so every peer gets the first blocks it has up to its window.
+func (a *Downloader) dispatch(now time.Time) {
+	for _, p := range a.peers {
+		if len(a.queue) == 0 {
+			return
+		}
+		n := a.conf.Window - a.inFlight[p]
+		if n <= 0 || (!a.probed && p != a.main) {
+			continue
+		}
+		ext := a.extension(p)
+		rest := a.queue[:0]
+		for _, q := range a.queue {
+			if n == 0 || !a.hasBlock(p, q.id) || (!a.probed && q.seq >= uint64(a.conf.Probe)) {
+				rest = append(rest, q)
+				continue
+			}
+			a.pending[q.id] = request{peer: p, seq: q.seq, sent: now}
+			ext.AskBlock(q.id)
+			a.inFlight[p]++
+			n--
+		}
+		a.queue = rest
+	}
+}
@@ -0,0 +1,178 @@
+package sync_internal
+
+import (
+	"testing"
+	"time"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/mock"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+type askedBlocks struct {
+	blocks []proto.BlockID
+	ids    int
+}
+
+func (a *askedBlocks) AskBlocksIDs([]proto.BlockID) {
+	a.ids++
+}
+
+func (a *askedBlocks) AskBlock(id proto.BlockID) {
+	a.blocks = append(a.blocks, id)
+}
+
+func ids(n int) []proto.BlockID {
+	out := make([]proto.BlockID, n)
+	for i := range out {
+		out[i] = proto.NewBlockIDFromSignature(crypto.Signature{byte(i + 1)})
+	}
+	return out
+}
+
+func testDownloader(main peer.Peer, helpers ...peer.Peer) (*Downloader, map[peer.Peer]*askedBlocks) {
+	asked := make(map[peer.Peer]*askedBlocks)
+	conf := DownloadSettings{Window: 2, Timeout: time.Second, MaxHelpers: 2}
+	d := newDownloader(main, helpers, conf, func(p peer.Peer) PeerExtension {
+		if asked[p] == nil {
+			asked[p] = &askedBlocks{}
+		}
+		return asked[p]
+	})
+	return d, asked
+}
+
+func TestDownloader_Windows(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	main, helper := mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl)
+	d, asked := testDownloader(main, helper)
+	blocks := ids(5)
+
+	d.AskBlocksIDs(nil)
+	require.Equal(t, 1, asked[main].ids)
+	require.Equal(t, 1, asked[helper].ids)
+
+	d.BlockIDs(helper, blocks)
+	for _, id := range blocks {
+		d.AskBlock(id)
+	}
+	require.Equal(t, blocks[:2], asked[main].blocks)
+	require.Equal(t, blocks[2:4], asked[helper].blocks)
+
+	d.Received(blocks[2])
+	require.Equal(t, blocks[2:5], asked[helper].blocks)
+	// unknown block doesn't free the window
+	d.Received(blocks[2])
+	require.Equal(t, blocks[2:5], asked[helper].blocks)
+}
+
+func TestDownloader_Timeouts(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	main, helper := mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl)
+	d, asked := testDownloader(main, helper)
+	blocks := ids(4)
+	d.BlockIDs(helper, blocks)
+	for _, id := range blocks {
+		d.AskBlock(id)
+	}
+	d.Received(blocks[0])
+	d.Received(blocks[1])
+
+	require.Empty(t, d.CheckTimeouts(time.Now()))
+	require.Equal(t, []peer.Peer{helper}, d.CheckTimeouts(time.Now().Add(2*time.Second)))
+	require.False(t, d.Has(helper))
+	// blocks of slow helper are requested from main peer in the same order
+	require.Equal(t, blocks, asked[main].blocks)
+
+	// main peer is never dropped, blocks are requested from it again
+	require.Empty(t, d.CheckTimeouts(time.Now().Add(4*time.Second)))
+	require.True(t, d.Has(main))
+	require.Equal(t, append(blocks, blocks[2:]...), asked[main].blocks)
+}
+
+func TestDownloader_RemovePeer(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	main, helper1, helper2, helper3 := mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl)
+	d, asked := testDownloader(main, helper1, helper2, helper3)
+	require.False(t, d.Has(helper3), "number of helpers is limited")
+	blocks := ids(7)
+	d.BlockIDs(helper1, blocks)
+	d.BlockIDs(helper2, blocks)
+	for _, id := range blocks {
+		d.AskBlock(id)
+	}
+	d.Received(blocks[0])
+	d.Received(blocks[1])
+	require.Equal(t, blocks[6:], asked[main].blocks[2:])
+
+	d.RemovePeer(main)
+	require.True(t, d.Has(main))
+
+	d.RemovePeer(helper1)
+	require.False(t, d.Has(helper1))
+	require.Equal(t, blocks[4:6], asked[helper2].blocks)
+	d.Received(blocks[6])
+	require.Equal(t, blocks[2:4], asked[main].blocks[3:])
+}
+
+func TestDownloader_Announced(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	main, helper := mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl)
+	d, asked := testDownloader(main, helper)
+	blocks := ids(6)
+	for _, id := range blocks {
+		d.AskBlock(id)
+	}
+	// helper gets nothing until it announces the blocks of main peer
+	require.Equal(t, blocks[:2], asked[main].blocks)
+	require.Empty(t, asked[helper].blocks)
+
+	// helper on the other chain or behind announces other blocks
+	d.BlockIDs(helper, append(ids(1), blocks[3], blocks[5]))
+	require.Equal(t, []proto.BlockID{blocks[3], blocks[5]}, asked[helper].blocks)
+	d.Received(blocks[0])
+	require.Equal(t, blocks[2], asked[main].blocks[2])
+	d.Received(blocks[3])
+	require.Len(t, asked[helper].blocks, 2, "block 4 was not announced by helper")
+
+	// block IDs from main peer or unknown peer are ignored
+	d.BlockIDs(main, blocks)
+	d.BlockIDs(mock.NewMockPeer(ctrl), blocks)
+	require.Len(t, asked[helper].blocks, 2)
+}
+
+func TestDownloader_Probe(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	main, helper := mock.NewMockPeer(ctrl), mock.NewMockPeer(ctrl)
+	asked := map[peer.Peer]*askedBlocks{main: {}, helper: {}}
+	conf := DownloadSettings{Window: 2, Timeout: time.Second, MaxHelpers: 2, Probe: 3}
+	d := newDownloader(main, []peer.Peer{helper}, conf, func(p peer.Peer) PeerExtension {
+		return asked[p]
+	})
+	blocks := ids(6)
+	d.BlockIDs(helper, blocks)
+	for _, id := range blocks {
+		d.AskBlock(id)
+	}
+	require.True(t, d.Probing())
+	// only the first blocks are requested and only from main peer
+	require.Equal(t, blocks[:2], asked[main].blocks)
+	require.Empty(t, asked[helper].blocks)
+	d.Received(blocks[0])
+	d.Received(blocks[1])
+	require.Equal(t, blocks[:3], asked[main].blocks)
+	require.Empty(t, asked[helper].blocks)
+
+	d.Probed()
+	require.False(t, d.Probing())
+	require.Equal(t, blocks[:4], asked[main].blocks)
+	require.Equal(t, blocks[4:], asked[helper].blocks)
+}
@@ -107,6 +107,21 @@ func (a Internal) Blocks(p peerExtension) (Internal, Blocks, Eof) {
 	return NewInternal(a.orderedBlocks, a.respondedSignatures, a.waitingForSignatures, a.nearEnd), blocks, false
 }
 
+// First returns the first block in order if it's received.
+func (a Internal) First() (*proto.Block, bool) {
+	return a.orderedBlocks.First()
+}
+
+// Available returns the blocks in order that can be applied without removing them.
+func (a Internal) Available() Blocks {
+	return a.orderedBlocks.Available()
+}
+
 func (a Internal) AvailableCount() int {
 	return a.orderedBlocks.AvailableCount()
 }
+
+// WaitingCount is the number of requested blocks not received yet.
+func (a Internal) WaitingCount() int {
+	return a.orderedBlocks.WaitingCount()
+}
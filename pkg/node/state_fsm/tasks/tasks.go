@@ -4,6 +4,7 @@ import (
 	"context"
 	"time"
 
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 )
 
@@ -14,6 +15,8 @@ const (
 	MINE_MICRO
 
 	PersistComplete
+
+	MaintainUtx
 )
 
 type TaskType int
@@ -23,6 +26,22 @@ type AsyncTask struct {
 	Data     interface{}
 }
 
+var taskNames = map[int]string{
+	PING:            "Ping",
+	ASK_PEERS:       "AskPeers",
+	MINE_MICRO:      "MineMicro",
+	PersistComplete: "PersistComplete",
+	MaintainUtx:     "MaintainUtx",
+}
+
+// Name is the name of task type for logs and debug info.
+func (a AsyncTask) Name() string {
+	if name, ok := taskNames[a.TaskType]; ok {
+		return name
+	}
+	return "Unknown"
+}
+
 type Task interface {
 	Run(ctx context.Context, output chan AsyncTask) error
 	Type() int
@@ -96,11 +115,38 @@ func (PingTask) Run(ctx context.Context, output chan AsyncTask) error {
 	}
 }
 
+type MaintainUtxTask struct {
+	d time.Duration
+}
+
+func NewMaintainUtxTask(d time.Duration) Task {
+	return MaintainUtxTask{d: d}
+}
+
+func (MaintainUtxTask) Type() int {
+	return MaintainUtx
+}
+
+func (a MaintainUtxTask) Run(ctx context.Context, output chan AsyncTask) error {
+	t := time.NewTicker(a.d)
+	defer t.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-t.C:
+			output <- AsyncTask{
+				TaskType: MaintainUtx,
+			}
+		}
+	}
+}
+
 type MineMicroTaskData struct {
-	Block   *proto.Block
-	Limits  proto.MiningLimits
-	KeyPair proto.KeyPair
-	Vrf     []byte
+	Block     *proto.Block
+	Limits    proto.MiningLimits
+	PublicKey crypto.PublicKey
+	Vrf       []byte
 }
 
 type MineMicroTask struct {
@@ -108,17 +154,17 @@ type MineMicroTask struct {
 	MineMicroTaskData MineMicroTaskData
 }
 
-func NewMineMicroTask(timeout time.Duration, block *proto.Block, limits proto.MiningLimits, keyPair proto.KeyPair, vrf []byte) MineMicroTask {
+func NewMineMicroTask(timeout time.Duration, block *proto.Block, limits proto.MiningLimits, pk crypto.PublicKey, vrf []byte) MineMicroTask {
 	if block == nil {
 		panic("NewMineMicroTask block is nil")
 	}
 	return MineMicroTask{
 		timeout: timeout,
 		MineMicroTaskData: MineMicroTaskData{
-			Block:   block,
-			Limits:  limits,
-			KeyPair: keyPair,
-			Vrf:     vrf,
+			Block:     block,
+			Limits:    limits,
+			PublicKey: pk,
+			Vrf:       vrf,
 		},
 	}
 }
@@ -3,6 +3,7 @@ package tasks
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -20,3 +21,16 @@ func TestMineMicroTask_Run(t *testing.T) {
 
 	require.IsType(t, MineMicroTaskData{}, (<-ch).Data.(MineMicroTaskData))
 }
+
+func TestMaintainUtxTask_Run(t *testing.T) {
+	task := NewMaintainUtxTask(time.Millisecond)
+	require.Equal(t, MaintainUtx, task.Type())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	ch := mkch()
+	go func() {
+		_ = task.Run(ctx, ch)
+	}()
+	require.Equal(t, MaintainUtx, (<-ch).TaskType)
+	cancel()
+}
@@ -0,0 +1,233 @@
+// Package capture records network messages of node to the file and reads them back for replay.
+//
+// The file is gzip compressed stream that starts with the magic and version followed by records.
+// Each record is the kind byte, varint timestamp in milliseconds, varint length and ID of peer,
+// varint length and bytes of message as they are sent over the wire.
+// For Connected records the bytes are the handshake of peer.
+package capture
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+const (
+	version = 1
+	// limit of single message, the biggest network messages are blocks and microblocks
+	maxMessageSize = 4 * 1024 * 1024
+	maxIDSize      = 256
+)
+
+var magic = []byte("WCAP")
+
+type Kind byte
+
+const (
+	Connected Kind = iota + 1
+	Received
+	Sent
+)
+
+func (a Kind) String() string {
+	switch a {
+	case Connected:
+		return "Connected"
+	case Received:
+		return "Received"
+	case Sent:
+		return "Sent"
+	default:
+		return "Unknown"
+	}
+}
+
+type Record struct {
+	Kind      Kind
+	Timestamp time.Time
+	Peer      string
+	Data      []byte
+}
+
+// Message parses the message of Received or Sent record.
+func (a Record) Message() (proto.Message, error) {
+	if a.Kind != Received && a.Kind != Sent {
+		return nil, errors.Errorf("record of kind %s has no message", a.Kind)
+	}
+	return proto.UnmarshalMessage(a.Data)
+}
+
+// Handshake parses the handshake of Connected record.
+func (a Record) Handshake() (proto.Handshake, error) {
+	if a.Kind != Connected {
+		return proto.Handshake{}, errors.Errorf("record of kind %s has no handshake", a.Kind)
+	}
+	h := proto.Handshake{}
+	if _, err := h.ReadFrom(bytes.NewReader(a.Data)); err != nil {
+		return proto.Handshake{}, err
+	}
+	return h, nil
+}
+
+// Writer writes records to the file, it's not thread safe.
+type Writer struct {
+	f   *os.File
+	gz  *gzip.Writer
+	buf *bufio.Writer
+	tmp [binary.MaxVarintLen64]byte
+}
+
+func Create(path string) (*Writer, error) {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0644)
+	if err != nil {
+		return nil, err
+	}
+	gz := gzip.NewWriter(f)
+	w := &Writer{f: f, gz: gz, buf: bufio.NewWriter(gz)}
+	if _, err := w.buf.Write(append(magic, version)); err != nil {
+		_ = f.Close()
+		return nil, err
+	}
+	return w, nil
+}
+
+func (a *Writer) writeUvarint(v uint64) error {
+	n := binary.PutUvarint(a.tmp[:], v)
+	_, err := a.buf.Write(a.tmp[:n])
+	return err
+}
+
+func (a *Writer) writeBytes(b []byte) error {
+	if err := a.writeUvarint(uint64(len(b))); err != nil {
+		return err
+	}
+	_, err := a.buf.Write(b)
+	return err
+}
+
+func (a *Writer) Write(r Record) error {
+	if len(r.Peer) > maxIDSize {
+		return errors.Errorf("too long peer ID %q", r.Peer)
+	}
+	if err := a.buf.WriteByte(byte(r.Kind)); err != nil {
+		return err
+	}
+	if err := a.writeUvarint(uint64(proto.NewTimestampFromTime(r.Timestamp))); err != nil {
+		return err
+	}
+	if err := a.writeBytes([]byte(r.Peer)); err != nil {
+		return err
+	}
+	return a.writeBytes(r.Data)
+}
+
+// Close flushes buffered records and closes the file.
+func (a *Writer) Close() error {
+	if err := a.buf.Flush(); err != nil {
+		_ = a.f.Close()
+		return err
+	}
+	if err := a.gz.Close(); err != nil {
+		_ = a.f.Close()
+		return err
+	}
+	return a.f.Close()
+}
+
+type Reader struct {
+	f  *os.File
+	gz *gzip.Reader
+	r  *bufio.Reader
+}
+
+func Open(path string) (*Reader, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		_ = f.Close()
+		return nil, errors.Wrap(err, "not a capture file")
+	}
+	r := &Reader{f: f, gz: gz, r: bufio.NewReader(gz)}
+	header := make([]byte, len(magic)+1)
+	if _, err := io.ReadFull(r.r, header); err != nil {
+		_ = r.Close()
+		return nil, errors.Wrap(err, "not a capture file")
+	}
+	if !bytes.Equal(header[:len(magic)], magic) {
+		_ = r.Close()
+		return nil, errors.New("not a capture file")
+	}
+	if header[len(magic)] != version {
+		_ = r.Close()
+		return nil, errors.Errorf("unsupported version of capture file %d", header[len(magic)])
+	}
+	return r, nil
+}
+
+func (a *Reader) readBytes(limit uint64) ([]byte, error) {
+	l, err := binary.ReadUvarint(a.r)
+	if err != nil {
+		return nil, err
+	}
+	if l > limit {
+		return nil, errors.Errorf("invalid length %d", l)
+	}
+	b := make([]byte, l)
+	if _, err := io.ReadFull(a.r, b); err != nil {
+		return nil, err
+	}
+	return b, nil
+}
+
+// Next reads the next record, io.EOF is returned at the end of file.
+// Truncated last record is reported as io.ErrUnexpectedEOF, for example if node was killed.
+func (a *Reader) Next() (Record, error) {
+	kind, err := a.r.ReadByte()
+	if err != nil {
+		return Record{}, err
+	}
+	r, err := a.next(Kind(kind))
+	if err == io.EOF {
+		return Record{}, io.ErrUnexpectedEOF
+	}
+	return r, err
+}
+
+func (a *Reader) next(kind Kind) (Record, error) {
+	if kind < Connected || kind > Sent {
+		return Record{}, errors.Errorf("invalid kind of record %d", kind)
+	}
+	ts, err := binary.ReadUvarint(a.r)
+	if err != nil {
+		return Record{}, err
+	}
+	id, err := a.readBytes(maxIDSize)
+	if err != nil {
+		return Record{}, err
+	}
+	data, err := a.readBytes(maxMessageSize)
+	if err != nil {
+		return Record{}, err
+	}
+	return Record{
+		Kind:      kind,
+		Timestamp: time.Unix(0, int64(ts)*int64(time.Millisecond)),
+		Peer:      string(id),
+		Data:      data,
+	}, nil
+}
+
+func (a *Reader) Close() error {
+	_ = a.gz.Close()
+	return a.f.Close()
+}
@@ -0,0 +1,139 @@
+package capture
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func tempFile(t *testing.T) (string, func()) {
+	dir, err := ioutil.TempDir(os.TempDir(), "capture")
+	require.NoError(t, err)
+	return filepath.Join(dir, "capture.bin"), func() {
+		_ = os.RemoveAll(dir)
+	}
+}
+
+func messageBytes(t *testing.T, m proto.Message) []byte {
+	b, err := m.MarshalBinary()
+	require.NoError(t, err)
+	return b
+}
+
+func TestRecorder(t *testing.T) {
+	path, cleanup := tempFile(t)
+	defer cleanup()
+
+	h := proto.Handshake{AppName: "wavesW", Version: proto.NewVersion(1, 2, 3), NodeName: "node", NodeNonce: 10}
+	p := &handshakePeer{h: h}
+
+	ts := time.Unix(1600000000, 123000000)
+	rec, err := NewRecorder(path)
+	require.NoError(t, err)
+	rec.now = func() time.Time { return ts }
+	getPeers := messageBytes(t, &proto.GetPeersMessage{})
+	score := messageBytes(t, &proto.ScoreMessage{Score: []byte{1, 2, 3}})
+	rec.Connected(p)
+	rec.Sent(p, getPeers)
+	rec.Received(p, score)
+	require.NoError(t, rec.Close())
+	// nothing is written after close
+	rec.Received(p, score)
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer r.Close()
+
+	c, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Connected, c.Kind)
+	require.Equal(t, "10.0.0.1-10", c.Peer)
+	require.True(t, ts.Equal(c.Timestamp))
+	readH, err := c.Handshake()
+	require.NoError(t, err)
+	require.Equal(t, h.NodeName, readH.NodeName)
+	require.Equal(t, h.Version, readH.Version)
+
+	s, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Sent, s.Kind)
+	require.Equal(t, getPeers, s.Data)
+
+	m, err := r.Next()
+	require.NoError(t, err)
+	require.Equal(t, Received, m.Kind)
+	msg, err := m.Message()
+	require.NoError(t, err)
+	require.Equal(t, &proto.ScoreMessage{Score: []byte{1, 2, 3}}, msg)
+
+	_, err = r.Next()
+	require.Equal(t, io.EOF, err)
+}
+
+func TestOpen_NotCapture(t *testing.T) {
+	path, cleanup := tempFile(t)
+	defer cleanup()
+	require.NoError(t, ioutil.WriteFile(path, []byte("not a capture"), 0644))
+	_, err := Open(path)
+	require.Error(t, err)
+}
+
+func TestReplay(t *testing.T) {
+	path, cleanup := tempFile(t)
+	defer cleanup()
+	w, err := Create(path)
+	require.NoError(t, err)
+	h := proto.Handshake{AppName: "wavesW", Version: proto.NewVersion(1, 2, 3), NodeName: "node", NodeNonce: 10}
+	hp := &handshakePeer{h: h}
+	rec := &Recorder{w: w, now: func() time.Time { return time.Unix(1600000000, 0) }}
+	rec.Connected(hp)
+	rec.Sent(hp, messageBytes(t, &proto.GetPeersMessage{}))
+	rec.Received(hp, messageBytes(t, &proto.ScoreMessage{Score: []byte{1}}))
+	rec.Received(hp, []byte{1, 2, 3})
+	require.NoError(t, rec.Close())
+
+	r, err := Open(path)
+	require.NoError(t, err)
+	defer r.Close()
+	parent := peer.NewParent()
+	replay := NewReplay(parent)
+	stats, err := replay.Run(context.Background(), r)
+	require.NoError(t, err)
+	require.Equal(t, ReplayStats{Connected: 1, Received: 2, Skipped: 1}, stats)
+	require.True(t, time.Unix(1600000000, 0).Equal(replay.Now()))
+
+	info := <-parent.InfoCh
+	connected := info.Value.(*peer.Connected)
+	require.Equal(t, "10.0.0.1-10", connected.Peer.ID())
+	require.Equal(t, "10.0.0.1", connected.Peer.RemoteAddr().IP.String())
+	require.Equal(t, h.NodeName, connected.Peer.Handshake().NodeName)
+
+	m := <-parent.MessageCh
+	require.Equal(t, connected.Peer, m.ID)
+	require.Equal(t, &proto.ScoreMessage{Score: []byte{1}}, m.Message)
+
+	invalid := <-parent.InfoCh
+	require.IsType(t, &peer.InvalidMessageError{}, invalid.Value)
+}
+
+// handshakePeer provides only the methods used by recorder.
+type handshakePeer struct {
+	peer.Peer
+	h proto.Handshake
+}
+
+func (a *handshakePeer) ID() string {
+	return "10.0.0.1-10"
+}
+
+func (a *handshakePeer) Handshake() proto.Handshake {
+	return a.h
+}
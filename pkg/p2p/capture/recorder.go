@@ -0,0 +1,66 @@
+package capture
+
+import (
+	"bytes"
+	"sync"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"go.uber.org/zap"
+)
+
+// Recorder captures traffic of all peers into the file, it implements peer.Capture.
+// Recording stops after the first failure to write, the node keeps working.
+type Recorder struct {
+	mu     sync.Mutex
+	w      *Writer
+	failed bool
+	now    func() time.Time
+}
+
+func NewRecorder(path string) (*Recorder, error) {
+	w, err := Create(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{w: w, now: time.Now}, nil
+}
+
+func (a *Recorder) Connected(p peer.Peer) {
+	h := p.Handshake()
+	buf := new(bytes.Buffer)
+	if _, err := h.WriteTo(buf); err != nil {
+		zap.S().Errorf("Capture: failed to serialize handshake of peer %s: %v", p.ID(), err)
+		return
+	}
+	a.write(Connected, p.ID(), buf.Bytes())
+}
+
+func (a *Recorder) Received(p peer.Peer, msg []byte) {
+	a.write(Received, p.ID(), msg)
+}
+
+func (a *Recorder) Sent(p peer.Peer, msg []byte) {
+	a.write(Sent, p.ID(), msg)
+}
+
+func (a *Recorder) write(kind Kind, id string, data []byte) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.failed {
+		return
+	}
+	err := a.w.Write(Record{Kind: kind, Timestamp: a.now(), Peer: id, Data: data})
+	if err != nil {
+		a.failed = true
+		zap.S().Errorf("Capture: recording is stopped: %v", err)
+	}
+}
+
+// Close stops recording and flushes the file.
+func (a *Recorder) Close() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.failed = true
+	return a.w.Close()
+}
@@ -0,0 +1,184 @@
+package capture
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
+	"github.com/wavesplatform/gowaves/pkg/p2p/peer"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"go.uber.org/atomic"
+	"go.uber.org/zap"
+)
+
+type ReplayStats struct {
+	Connected int
+	Received  int
+	// messages of peers closed by node
+	Dropped int
+	// messages sent by node during capture are not replayed
+	Skipped int
+}
+
+func (a ReplayStats) String() string {
+	return fmt.Sprintf("connected %d, received %d, dropped %d, skipped %d", a.Connected, a.Received, a.Dropped, a.Skipped)
+}
+
+// Replay feeds the messages received by node during capture to the parent as if they came from network.
+// Replay implements types.Time, the time is the timestamp of the last replayed record,
+// so the node sees the same time as during capture.
+type Replay struct {
+	parent peer.Parent
+	// Speed of replay relative to captured timestamps, records are replayed without delays if zero.
+	Speed float64
+	now   *atomic.Int64
+	peers map[string]*replayPeer
+}
+
+func NewReplay(parent peer.Parent) *Replay {
+	return &Replay{
+		parent: parent,
+		now:    atomic.NewInt64(0),
+		peers:  make(map[string]*replayPeer),
+	}
+}
+
+func (a *Replay) Now() time.Time {
+	return time.Unix(0, a.now.Load())
+}
+
+// Run replays all records of reader. Messages sent by node to the replayed peers are discarded.
+func (a *Replay) Run(ctx context.Context, r *Reader) (ReplayStats, error) {
+	stats := ReplayStats{}
+	var prev time.Time
+	for {
+		rec, err := r.Next()
+		if err == io.EOF {
+			return stats, nil
+		}
+		if err != nil {
+			return stats, err
+		}
+		if a.Speed > 0 && !prev.IsZero() && rec.Timestamp.After(prev) {
+			select {
+			case <-ctx.Done():
+				return stats, ctx.Err()
+			case <-time.After(time.Duration(float64(rec.Timestamp.Sub(prev)) / a.Speed)):
+			}
+		}
+		prev = rec.Timestamp
+		a.now.Store(rec.Timestamp.UnixNano())
+		if err := a.replay(ctx, rec, &stats); err != nil {
+			return stats, err
+		}
+	}
+}
+
+func (a *Replay) replay(ctx context.Context, rec Record, stats *ReplayStats) error {
+	switch rec.Kind {
+	case Connected:
+		h, err := rec.Handshake()
+		if err != nil {
+			return errors.Wrapf(err, "invalid handshake of peer %s", rec.Peer)
+		}
+		p := newReplayPeer(rec.Peer, h, a.parent)
+		a.peers[rec.Peer] = p
+		stats.Connected++
+		return a.info(ctx, peer.InfoMessage{Peer: p, Value: &peer.Connected{Peer: p}})
+	case Received:
+		p, ok := a.peers[rec.Peer]
+		if !ok || p.closed.Load() {
+			stats.Dropped++
+			return nil
+		}
+		stats.Received++
+		m, err := rec.Message()
+		if err != nil {
+			return a.info(ctx, peer.InfoMessage{Peer: p, Value: &peer.InvalidMessageError{Err: err}})
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case a.parent.MessageCh <- peer.ProtoMessage{ID: p, Message: m}:
+			return nil
+		}
+	default:
+		stats.Skipped++
+		return nil
+	}
+}
+
+func (a *Replay) info(ctx context.Context, m peer.InfoMessage) error {
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case a.parent.InfoCh <- m:
+		return nil
+	}
+}
+
+// replayPeer is the captured peer, it discards all messages sent to it.
+type replayPeer struct {
+	id        string
+	handshake proto.Handshake
+	addr      proto.TCPAddr
+	parent    peer.Parent
+	closed    *atomic.Bool
+}
+
+func newReplayPeer(id string, h proto.Handshake, parent peer.Parent) *replayPeer {
+	// ID of peer is its IP address and nonce separated by dash
+	ip := net.IPv4zero
+	if i := strings.LastIndex(id, "-"); i > 0 {
+		if v := net.ParseIP(id[:i]); v != nil {
+			ip = v
+		}
+	}
+	return &replayPeer{
+		id:        id,
+		handshake: h,
+		addr:      proto.TCPAddr(net.TCPAddr{IP: ip, Port: h.DeclaredAddr.Port}),
+		parent:    parent,
+		closed:    atomic.NewBool(false),
+	}
+}
+
+func (a *replayPeer) Direction() peer.Direction {
+	return peer.Incoming
+}
+
+func (a *replayPeer) Close() error {
+	if !a.closed.CAS(false, true) {
+		return nil
+	}
+	select {
+	case a.parent.InfoCh <- peer.InfoMessage{Peer: a, Value: io.EOF}:
+	default:
+	}
+	return nil
+}
+
+func (a *replayPeer) SendMessage(m proto.Message) {
+	zap.S().Debugf("Replay: node sent %T to %s", m, a.id)
+}
+
+func (a *replayPeer) ID() string {
+	return a.id
+}
+
+func (a *replayPeer) Connection() conn.Connection {
+	return nil
+}
+
+func (a *replayPeer) Handshake() proto.Handshake {
+	return a.handshake
+}
+
+func (a *replayPeer) RemoteAddr() proto.TCPAddr {
+	return a.addr
+}
@@ -6,6 +6,7 @@ import (
 	"io/ioutil"
 	"net"
 	"strings"
+	"time"
 
 	"github.com/wavesplatform/gowaves/pkg/libs/bytespool"
 	"github.com/wavesplatform/gowaves/pkg/proto"
@@ -20,6 +21,8 @@ type Connection interface {
 	Conn() net.Conn
 	SendClosed() bool
 	ReceiveClosed() bool
+	// Stats returns the traffic counters of connection, may be nil if traffic is not counted.
+	Stats() *Stats
 }
 
 func handleErr(err error, errCh chan<- error) {
@@ -31,7 +34,7 @@ func handleErr(err error, errCh chan<- error) {
 }
 
 // send to remote
-func sendToRemote(closed *atomic.Bool, conn io.Writer, ctx context.Context, toRemoteCh chan []byte, errCh chan error) {
+func sendToRemote(closed *atomic.Bool, conn io.Writer, ctx context.Context, toRemoteCh chan []byte, errCh chan error, stats *Stats) {
 	defer closed.Store(true)
 	for {
 		select {
@@ -41,6 +44,10 @@ func sendToRemote(closed *atomic.Bool, conn io.Writer, ctx context.Context, toRe
 			_, err := conn.Write(bts)
 			if err != nil {
 				handleErr(err, errCh)
+				continue
+			}
+			if len(bts) > proto.HeaderContentIDPosition {
+				stats.addSent(bts[proto.HeaderContentIDPosition], len(bts))
 			}
 		}
 	}
@@ -63,7 +70,7 @@ func nonRecoverableError(err error) bool {
 // if returned type is `true`, then network message will be skipped.
 type SkipFilter func(proto.Header) bool
 
-func recvFromRemote(stopped *atomic.Bool, pool bytespool.Pool, conn io.Reader, fromRemoteCh chan []byte, errCh chan error, skip SkipFilter) {
+func recvFromRemote(stopped *atomic.Bool, pool bytespool.Pool, conn io.Reader, ctx context.Context, fromRemoteCh chan []byte, errCh chan error, skip SkipFilter, stats *Stats, limit *throttle) {
 	defer stopped.Store(true)
 	for {
 		header := proto.Header{}
@@ -75,6 +82,19 @@ func recvFromRemote(stopped *atomic.Bool, pool bytespool.Pool, conn io.Reader, f
 			}
 			continue
 		}
+		// skipped and too long messages are counted too, they are read from network anyway
+		size := int(header.HeaderLength() + header.PayloadLength)
+		stats.addReceived(header.ContentID, size)
+		if d := limit.delay(size, time.Now()); d > 0 {
+			// throttled connection is closed without waiting for the delay to pass
+			timer := time.NewTimer(d)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+		}
 
 		if skip(header) {
 			_, err = io.CopyN(ioutil.Discard, conn, int64(header.PayloadLength))
@@ -127,6 +147,7 @@ type ConnectionImpl struct {
 	receiveClosed *atomic.Bool
 	conn          net.Conn
 	cancel        context.CancelFunc
+	stats         *Stats
 }
 
 func (a *ConnectionImpl) Close() error {
@@ -145,3 +166,7 @@ func (a *ConnectionImpl) SendClosed() bool {
 func (a *ConnectionImpl) ReceiveClosed() bool {
 	return a.receiveClosed.Load()
 }
+
+func (a *ConnectionImpl) Stats() *Stats {
+	return a.stats
+}
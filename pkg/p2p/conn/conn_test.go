@@ -2,6 +2,7 @@ package conn
 
 import (
 	"bytes"
+	"context"
 	"net"
 	"testing"
 	"time"
@@ -61,10 +62,34 @@ func TestRecvFromRemote_Transaction(t *testing.T) {
 	pool := bytespool.NewNoOpBytesPool(len(messBytes))
 	fromRemoteCh := make(chan []byte, 2)
 
-	recvFromRemote(atomic.NewBool(false), pool, bytes.NewReader(messBytes), fromRemoteCh, make(chan error, 1), func(headerBytes proto.Header) bool {
+	stats := NewStats(nil)
+	recvFromRemote(atomic.NewBool(false), pool, bytes.NewReader(messBytes), context.Background(), fromRemoteCh, make(chan error, 1), func(headerBytes proto.Header) bool {
 		return false
-	})
+	}, stats, nil)
 
 	retBytes := <-fromRemoteCh
 	assert.Equal(t, messBytes, retBytes)
+	assert.Equal(t, Counter{Messages: 1, Bytes: uint64(len(messBytes))}, stats.Traffic().Received["Transaction"])
+}
+
+func TestRecvFromRemote_ThrottledClose(t *testing.T) {
+	messBytes := byte_helpers.TransferWithSig.MessageBytes
+	pool := bytespool.NewNoOpBytesPool(len(messBytes))
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := atomic.NewBool(false)
+	done := make(chan struct{})
+	go func() {
+		// one byte per second delays the message for minutes
+		recvFromRemote(stopped, pool, bytes.NewReader(messBytes), ctx, make(chan []byte, 2), make(chan error, 1), func(headerBytes proto.Header) bool {
+			return false
+		}, NewStats(nil), newThrottle(1))
+		close(done)
+	}()
+	cancel()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("throttled receive is not stopped")
+	}
+	assert.True(t, stopped.Load())
 }
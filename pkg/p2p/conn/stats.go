@@ -0,0 +1,156 @@
+package conn
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+var contentNames = map[uint8]string{
+	proto.ContentIDGetPeers:          "GetPeers",
+	proto.ContentIDPeers:             "Peers",
+	proto.ContentIDGetSignatures:     "GetSignatures",
+	proto.ContentIDSignatures:        "Signatures",
+	proto.ContentIDGetBlock:          "GetBlock",
+	proto.ContentIDBlock:             "Block",
+	proto.ContentIDScore:             "Score",
+	proto.ContentIDTransaction:       "Transaction",
+	proto.ContentIDInvMicroblock:     "MicroBlockInv",
+	proto.ContentIDCheckpoint:        "Checkpoint",
+	proto.ContentIDMicroblockRequest: "MicroBlockRequest",
+	proto.ContentIDMicroblock:        "MicroBlock",
+	proto.ContentIDPBBlock:           "PBBlock",
+	proto.ContentIDPBMicroBlock:      "PBMicroBlock",
+	proto.ContentIDPBTransaction:     "PBTransaction",
+	proto.ContentIDGetBlockIds:       "GetBlockIds",
+	proto.ContentIDBlockIds:          "BlockIds",
+}
+
+func contentName(id uint8) string {
+	if name, ok := contentNames[id]; ok {
+		return name
+	}
+	return fmt.Sprintf("Unknown(0x%x)", id)
+}
+
+// Counter is the number of messages and their size in bytes including headers.
+type Counter struct {
+	Messages uint64 `json:"messages"`
+	Bytes    uint64 `json:"bytes"`
+}
+
+// Traffic is the snapshot of Stats, counters are grouped by the name of message.
+type Traffic struct {
+	Received map[string]Counter `json:"received"`
+	Sent     map[string]Counter `json:"sent"`
+	// Dropped is the number of received messages dropped because node was too busy to handle them.
+	Dropped uint64 `json:"dropped"`
+}
+
+// Stats counts messages of connection by content ID in both directions, it is safe for concurrent use.
+// Counts are added to parent too, so the parent Stats gets the total traffic of all connections.
+// Methods of nil Stats do nothing.
+type Stats struct {
+	mu       sync.Mutex
+	received [256]Counter
+	sent     [256]Counter
+	dropped  uint64
+	parent   *Stats
+}
+
+func NewStats(parent *Stats) *Stats {
+	return &Stats{parent: parent}
+}
+
+func (a *Stats) addReceived(id uint8, size int) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	a.received[id].Messages++
+	a.received[id].Bytes += uint64(size)
+	a.mu.Unlock()
+	a.parent.addReceived(id, size)
+}
+
+func (a *Stats) addSent(id uint8, size int) {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	a.sent[id].Messages++
+	a.sent[id].Bytes += uint64(size)
+	a.mu.Unlock()
+	a.parent.addSent(id, size)
+}
+
+// AddDropped counts the received message that was not handled.
+func (a *Stats) AddDropped() {
+	if a == nil {
+		return
+	}
+	a.mu.Lock()
+	a.dropped++
+	a.mu.Unlock()
+	a.parent.AddDropped()
+}
+
+func (a *Stats) Traffic() Traffic {
+	out := Traffic{Received: map[string]Counter{}, Sent: map[string]Counter{}}
+	if a == nil {
+		return out
+	}
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	for id := range a.received {
+		if c := a.received[id]; c.Messages > 0 {
+			out.Received[contentName(uint8(id))] = c
+		}
+		if c := a.sent[id]; c.Messages > 0 {
+			out.Sent[contentName(uint8(id))] = c
+		}
+	}
+	out.Dropped = a.dropped
+	return out
+}
+
+// throttle limits the inbound bandwidth of connection with the token bucket holding one second of traffic.
+// It's used only by the reading goroutine of connection, so it's not synchronized.
+type throttle struct {
+	// bytes per second
+	rate   float64
+	tokens float64
+	last   time.Time
+}
+
+// newThrottle returns nil, that doesn't limit anything, if rate is not positive.
+func newThrottle(rate int) *throttle {
+	if rate <= 0 {
+		return nil
+	}
+	return &throttle{rate: float64(rate)}
+}
+
+// delay takes size bytes from the bucket and returns the time to wait before reading the next message.
+// Messages larger than bucket are allowed, the following reads are delayed accordingly.
+func (a *throttle) delay(size int, now time.Time) time.Duration {
+	if a == nil {
+		return 0
+	}
+	if a.last.IsZero() {
+		a.tokens = a.rate
+	} else {
+		a.tokens += now.Sub(a.last).Seconds() * a.rate
+		if a.tokens > a.rate {
+			a.tokens = a.rate
+		}
+	}
+	a.last = now
+	a.tokens -= float64(size)
+	if a.tokens >= 0 {
+		return 0
+	}
+	return time.Duration(-a.tokens / a.rate * float64(time.Second))
+}
@@ -0,0 +1,53 @@
+package conn
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func TestStats(t *testing.T) {
+	totals := NewStats(nil)
+	s1 := NewStats(totals)
+	s2 := NewStats(totals)
+	s1.addReceived(proto.ContentIDBlock, 100)
+	s1.addReceived(proto.ContentIDBlock, 50)
+	s1.addSent(proto.ContentIDGetBlock, 10)
+	s2.addReceived(proto.ContentIDBlock, 200)
+	s2.addReceived(0xff, 1)
+	s2.AddDropped()
+
+	require.Equal(t, Traffic{
+		Received: map[string]Counter{"Block": {Messages: 2, Bytes: 150}},
+		Sent:     map[string]Counter{"GetBlock": {Messages: 1, Bytes: 10}},
+	}, s1.Traffic())
+	require.Equal(t, Traffic{
+		Received: map[string]Counter{"Block": {Messages: 3, Bytes: 350}, "Unknown(0xff)": {Messages: 1, Bytes: 1}},
+		Sent:     map[string]Counter{"GetBlock": {Messages: 1, Bytes: 10}},
+		Dropped:  1,
+	}, totals.Traffic())
+
+	var none *Stats
+	none.addReceived(proto.ContentIDBlock, 1)
+	none.AddDropped()
+	require.Empty(t, none.Traffic().Received)
+}
+
+func TestThrottle_Delay(t *testing.T) {
+	require.Equal(t, time.Duration(0), newThrottle(0).delay(1000, time.Now()), "no limit")
+
+	now := time.Unix(1600000000, 0)
+	th := newThrottle(1000)
+	require.Equal(t, time.Duration(0), th.delay(600, now))
+	require.Equal(t, time.Duration(0), th.delay(400, now))
+	require.Equal(t, 500*time.Millisecond, th.delay(500, now))
+	// after waiting the bucket is empty
+	now = now.Add(500 * time.Millisecond)
+	require.Equal(t, 100*time.Millisecond, th.delay(100, now))
+	// bucket holds no more than one second of traffic
+	now = now.Add(time.Hour)
+	require.Equal(t, time.Duration(0), th.delay(1000, now))
+	require.Equal(t, 2*time.Second, th.delay(2000, now), "message larger than bucket")
+}
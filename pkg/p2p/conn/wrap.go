@@ -6,11 +6,19 @@ import (
 	"io"
 	"net"
 
-	. "github.com/wavesplatform/gowaves/pkg/libs/bytespool"
+	"github.com/wavesplatform/gowaves/pkg/libs/bytespool"
 	"go.uber.org/atomic"
 )
 
-func WrapConnection(conn net.Conn, pool Pool, toRemoteCh chan []byte, fromRemoteCh chan []byte, errCh chan error, skip SkipFilter) Connection {
+// Options of wrapped connection, zero value doesn't limit the bandwidth.
+type Options struct {
+	// Totals gets the traffic of connection in addition to its own Stats, if not nil.
+	Totals *Stats
+	// InboundLimit is the max number of bytes per second read from connection, zero means no limit.
+	InboundLimit int
+}
+
+func WrapConnection(conn net.Conn, pool bytespool.Pool, toRemoteCh chan []byte, fromRemoteCh chan []byte, errCh chan error, skip SkipFilter, opts Options) Connection {
 	return wrapConnection(wrapParams{
 		conn:         conn,
 		pool:         pool,
@@ -20,18 +28,22 @@ func WrapConnection(conn net.Conn, pool Pool, toRemoteCh chan []byte, fromRemote
 		sendFunc:     sendToRemote,
 		recvFunc:     recvFromRemote,
 		skip:         skip,
+		stats:        NewStats(opts.Totals),
+		limit:        newThrottle(opts.InboundLimit),
 	})
 }
 
 type wrapParams struct {
 	conn         net.Conn
-	pool         Pool
+	pool         bytespool.Pool
 	toRemoteCh   chan []byte
 	fromRemoteCh chan []byte
 	errCh        chan error
-	sendFunc     func(closed *atomic.Bool, conn io.Writer, ctx context.Context, toRemoteCh chan []byte, errCh chan error)
-	recvFunc     func(closed *atomic.Bool, pool Pool, reader io.Reader, fromRemoteCh chan []byte, errCh chan error, skip SkipFilter)
+	sendFunc     func(closed *atomic.Bool, conn io.Writer, ctx context.Context, toRemoteCh chan []byte, errCh chan error, stats *Stats)
+	recvFunc     func(closed *atomic.Bool, pool bytespool.Pool, reader io.Reader, ctx context.Context, fromRemoteCh chan []byte, errCh chan error, skip SkipFilter, stats *Stats, limit *throttle)
 	skip         SkipFilter
+	stats        *Stats
+	limit        *throttle
 }
 
 func wrapConnection(params wrapParams) *ConnectionImpl {
@@ -42,12 +54,13 @@ func wrapConnection(params wrapParams) *ConnectionImpl {
 		conn:          params.conn,
 		receiveClosed: atomic.NewBool(false),
 		sendClosed:    atomic.NewBool(false),
+		stats:         params.stats,
 	}
 
 	bufReader := bufio.NewReader(params.conn)
 
-	go params.recvFunc(impl.receiveClosed, params.pool, bufReader, params.fromRemoteCh, params.errCh, params.skip)
-	go params.sendFunc(impl.sendClosed, params.conn, ctx, params.toRemoteCh, params.errCh)
+	go params.recvFunc(impl.receiveClosed, params.pool, bufReader, ctx, params.fromRemoteCh, params.errCh, params.skip, params.stats, params.limit)
+	go params.sendFunc(impl.sendClosed, params.conn, ctx, params.toRemoteCh, params.errCh, params.stats)
 
 	return impl
 }
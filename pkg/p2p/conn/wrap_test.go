@@ -36,7 +36,7 @@ func TestWrapConnection(t *testing.T) {
 	ch := make(chan []byte, 1)
 	wrapped := WrapConnection(conn, pool, nil, ch, nil, func(bytes proto.Header) bool {
 		return false
-	})
+	}, Options{})
 
 	select {
 	case <-time.After(10 * time.Millisecond):
@@ -23,10 +23,16 @@ type IncomingPeerParams struct {
 	NodeName     string
 	NodeNonce    uint64
 	Version      proto.Version
+	Capture      peer.Capture
+	ConnOptions  conn.Options
 }
 
 func RunIncomingPeer(ctx context.Context, params IncomingPeerParams) error {
 	c := params.Conn
+	if err := c.SetDeadline(time.Now().Add(peer.HandshakeTimeout)); err != nil {
+		c.Close()
+		return err
+	}
 
 	readHandshake := proto.Handshake{}
 	_, err := readHandshake.ReadFrom(c)
@@ -35,6 +41,11 @@ func RunIncomingPeer(ctx context.Context, params IncomingPeerParams) error {
 		c.Close()
 		return err
 	}
+	if err := peer.CheckHandshake(readHandshake, params.WavesNetwork, params.Version); err != nil {
+		zap.S().Debugf("rejected %s: %v", c.RemoteAddr(), err)
+		c.Close()
+		return err
+	}
 
 	select {
 	case <-ctx.Done():
@@ -58,6 +69,10 @@ func RunIncomingPeer(ctx context.Context, params IncomingPeerParams) error {
 		c.Close()
 		return err
 	}
+	if err := c.SetDeadline(time.Time{}); err != nil {
+		c.Close()
+		return err
+	}
 
 	select {
 	case <-ctx.Done():
@@ -67,8 +82,11 @@ func RunIncomingPeer(ctx context.Context, params IncomingPeerParams) error {
 	}
 
 	remote := peer.NewRemote()
-	connection := conn.WrapConnection(c, params.Pool, remote.ToCh, remote.FromCh, remote.ErrCh, params.Skip)
-	peerImpl := peer.NewPeerImpl(readHandshake, connection, peer.Incoming, remote)
+	connection := conn.WrapConnection(c, params.Pool, remote.ToCh, remote.FromCh, remote.ErrCh, params.Skip, params.ConnOptions)
+	peerImpl := peer.NewPeerImpl(readHandshake, connection, peer.Incoming, remote, params.Capture)
+	if params.Capture != nil {
+		params.Capture.Connected(peerImpl)
+	}
 
 	out := peer.InfoMessage{
 		Peer: peerImpl,
@@ -86,5 +104,6 @@ func RunIncomingPeer(ctx context.Context, params IncomingPeerParams) error {
 		Parent:     params.Parent,
 		Pool:       params.Pool,
 		Peer:       peerImpl,
+		Capture:    params.Capture,
 	})
 }
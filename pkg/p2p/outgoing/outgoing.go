@@ -22,6 +22,8 @@ type EstablishParams struct {
 	Skip         conn.SkipFilter
 	NodeName     string
 	NodeNonce    uint64
+	Capture      peer.Capture
+	ConnOptions  conn.Options
 }
 
 func EstablishConnection(ctx context.Context, params EstablishParams, v proto.Version) error {
@@ -33,7 +35,7 @@ func EstablishConnection(ctx context.Context, params EstablishParams, v proto.Ve
 		remote: remote,
 	}
 
-	c, err := net.Dial("tcp", params.Address.String())
+	c, err := net.DialTimeout("tcp", params.Address.String(), peer.HandshakeTimeout)
 	if err != nil {
 		return err
 	}
@@ -45,7 +47,10 @@ func EstablishConnection(ctx context.Context, params EstablishParams, v proto.Ve
 	}
 	p.connection = connection
 
-	peerImpl := peer.NewPeerImpl(*handshake, connection, peer.Outgoing, remote)
+	peerImpl := peer.NewPeerImpl(*handshake, connection, peer.Outgoing, remote, params.Capture)
+	if params.Capture != nil {
+		params.Capture.Connected(peerImpl)
+	}
 
 	connected := peer.InfoMessage{
 		Peer: peerImpl,
@@ -64,6 +69,7 @@ func EstablishConnection(ctx context.Context, params EstablishParams, v proto.Ve
 		Parent:     params.Parent,
 		Pool:       params.Pool,
 		Peer:       peerImpl,
+		Capture:    params.Capture,
 	})
 }
 
@@ -84,6 +90,10 @@ func (a *connector) connect(ctx context.Context, c net.Conn, v proto.Version) (c
 		Timestamp:    proto.NewTimestampFromTime(time.Now()),
 	}
 
+	if err := c.SetDeadline(time.Now().Add(peer.HandshakeTimeout)); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
 	_, err := handshake.WriteTo(c)
 	if err != nil {
 		zap.S().Error("failed to send handshake: ", err, a.params.Address)
@@ -107,5 +117,13 @@ func (a *connector) connect(ctx context.Context, c net.Conn, v proto.Version) (c
 			return nil, nil, err
 		}
 	}
-	return conn.WrapConnection(c, a.params.Pool, a.remote.ToCh, a.remote.FromCh, a.remote.ErrCh, a.params.Skip), &handshake, nil
+	if err := peer.CheckHandshake(handshake, a.params.WavesNetwork, v); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+	if err := c.SetDeadline(time.Time{}); err != nil {
+		c.Close()
+		return nil, nil, err
+	}
+	return conn.WrapConnection(c, a.params.Pool, a.remote.ToCh, a.remote.FromCh, a.remote.ErrCh, a.params.Skip, a.params.ConnOptions), &handshake, nil
 }
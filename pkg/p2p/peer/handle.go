@@ -10,14 +10,15 @@ import (
 	"go.uber.org/zap"
 )
 
-func bytesToMessage(b []byte, id string, resendTo chan ProtoMessage, pool bytespool.Pool, p Peer) error {
+// bytesToMessage returns false if the message was dropped because parent channel is full.
+func bytesToMessage(b []byte, id string, resendTo chan ProtoMessage, pool bytespool.Pool, p Peer) (bool, error) {
 	defer func() {
 		pool.Put(b)
 	}()
 
 	m, err := proto.UnmarshalMessage(b)
 	if err != nil {
-		return err
+		return false, err
 	}
 
 	mess := ProtoMessage{
@@ -27,10 +28,11 @@ func bytesToMessage(b []byte, id string, resendTo chan ProtoMessage, pool bytesp
 
 	select {
 	case resendTo <- mess:
+		return true, nil
 	default:
 		zap.S().Debugf("failed to resend to Parent, channel is full: %s, %T", id, m)
+		return false, nil
 	}
-	return nil
 }
 
 type HandlerParams struct {
@@ -41,6 +43,8 @@ type HandlerParams struct {
 	Parent     Parent
 	Pool       bytespool.Pool
 	Peer       Peer
+	// optional
+	Capture Capture
 }
 
 // for Handle doesn't matter outgoing or incoming Connection, it just send and receive messages
@@ -52,11 +56,17 @@ func Handle(params HandlerParams) error {
 			return errors.Wrap(params.Ctx.Err(), "Handle")
 
 		case bts := <-params.Remote.FromCh:
-			err := bytesToMessage(bts, params.ID, params.Parent.MessageCh, params.Pool, params.Peer)
+			if params.Capture != nil {
+				params.Capture.Received(params.Peer, bts)
+			}
+			delivered, err := bytesToMessage(bts, params.ID, params.Parent.MessageCh, params.Pool, params.Peer)
+			if err == nil && !delivered {
+				params.Connection.Stats().AddDropped()
+			}
 			if err != nil {
 				out := InfoMessage{
 					Peer:  params.Peer,
-					Value: err,
+					Value: &InvalidMessageError{Err: err},
 				}
 				select {
 				case params.Parent.InfoCh <- out:
@@ -10,6 +10,7 @@ import (
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/wavesplatform/gowaves/pkg/libs/bytespool"
+	"github.com/wavesplatform/gowaves/pkg/p2p/conn"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/util/byte_helpers"
 )
@@ -35,6 +36,10 @@ func (a *mockConnection) Conn() net.Conn {
 	return nil
 }
 
+func (a *mockConnection) Stats() *conn.Stats {
+	return nil
+}
+
 func TestHHandleStopContext(t *testing.T) {
 	ctx, cancel := context.WithCancel(context.Background())
 	go func() {
@@ -0,0 +1,28 @@
+package peer
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+// HandshakeTimeout limits the time of connection establishment and handshake exchange,
+// so the remote side can't hold the connection slot without completing the handshake.
+const HandshakeTimeout = 30 * time.Second
+
+// minSupportedMinor is the oldest supported minor version of protocol for each major version, as in the reference node.
+var minSupportedMinor = map[uint32]uint32{0: 13, 1: 0}
+
+// CheckHandshake rejects peers of other networks and peers with incompatible version of protocol:
+// the major version must be the same as ours and the minor version must be not older than the supported one.
+func CheckHandshake(h proto.Handshake, network string, v proto.Version) error {
+	if h.AppName != network {
+		return errors.Errorf("peer %q is from foreign network %q", h.NodeName, h.AppName)
+	}
+	floor, ok := minSupportedMinor[h.Version.Major]
+	if h.Version.Major != v.Major || !ok || h.Version.Minor < floor {
+		return errors.Errorf("peer %q has unsupported version %s", h.NodeName, h.Version.String())
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package peer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+func TestCheckHandshake(t *testing.T) {
+	v := proto.NewVersion(1, 2, 3)
+	h := func(network string, major, minor uint32) proto.Handshake {
+		return proto.Handshake{AppName: network, Version: proto.NewVersion(major, minor, 0), NodeName: "node"}
+	}
+	require.NoError(t, CheckHandshake(h("wavesW", 1, 2), "wavesW", v))
+	require.NoError(t, CheckHandshake(h("wavesW", 1, 0), "wavesW", v))
+	require.NoError(t, CheckHandshake(h("wavesW", 1, 4), "wavesW", v), "newer minor version")
+	require.Error(t, CheckHandshake(h("wavesT", 1, 2), "wavesW", v), "foreign network")
+	require.Error(t, CheckHandshake(h("wavesW", 0, 2), "wavesW", v), "other major version")
+	require.Error(t, CheckHandshake(h("wavesW", 2, 0), "wavesW", v), "other major version")
+	old := proto.NewVersion(0, 17, 0)
+	require.NoError(t, CheckHandshake(h("wavesW", 0, 13), "wavesW", old))
+	require.Error(t, CheckHandshake(h("wavesW", 0, 12), "wavesW", old), "too old minor version")
+}
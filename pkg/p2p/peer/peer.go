@@ -40,3 +40,13 @@ type Peer interface {
 	Handshake() proto.Handshake
 	RemoteAddr() proto.TCPAddr
 }
+
+// Capture records traffic of peers, messages are given as they are sent over the wire.
+// Received messages are recorded after the SkipFilter of connection, so the messages
+// the node drops without reading them are not recorded and replay does not need them.
+// Sent messages are recorded once they are queued to connection.
+type Capture interface {
+	Connected(p Peer)
+	Received(p Peer, msg []byte)
+	Sent(p Peer, msg []byte)
+}
@@ -17,15 +17,18 @@ type PeerImpl struct {
 	direction Direction
 	remote    Remote
 	id        string
+	capture   Capture
 }
 
-func NewPeerImpl(handshake proto.Handshake, conn conn.Connection, direction Direction, remote Remote) *PeerImpl {
+// NewPeerImpl creates the peer, capture is optional.
+func NewPeerImpl(handshake proto.Handshake, conn conn.Connection, direction Direction, remote Remote, capture Capture) *PeerImpl {
 	return &PeerImpl{
 		handshake: handshake,
 		conn:      conn,
 		direction: direction,
 		remote:    remote,
 		id:        id(conn.Conn().RemoteAddr().String(), handshake.NodeNonce),
+		capture:   capture,
 	}
 }
 
@@ -45,6 +48,10 @@ func (a *PeerImpl) SendMessage(m proto.Message) {
 	}
 	select {
 	case a.remote.ToCh <- b:
+		// only messages actually passed to connection are recorded
+		if a.capture != nil {
+			a.capture.Sent(a, b)
+		}
 	default:
 		a.remote.ErrCh <- errors.Errorf("remote, chan is full id %s, name %s", a.ID(), a.handshake.NodeName)
 	}
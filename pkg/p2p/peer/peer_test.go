@@ -1,10 +1,39 @@
 package peer
 
 import (
-	"github.com/magiconair/properties/assert"
 	"testing"
+
+	"github.com/magiconair/properties/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/proto"
 )
 
 func TestID(t *testing.T) {
 	assert.Equal(t, "127.0.0.1-100500", id("127.0.0.1:6868", 100500))
 }
+
+type sentCapture struct {
+	sent int
+}
+
+func (a *sentCapture) Connected(Peer) {}
+
+func (a *sentCapture) Received(Peer, []byte) {}
+
+func (a *sentCapture) Sent(Peer, []byte) {
+	a.sent++
+}
+
+func TestPeerImpl_SendMessageCapture(t *testing.T) {
+	c := &sentCapture{}
+	p := &PeerImpl{
+		remote:  Remote{ToCh: make(chan []byte, 1), ErrCh: make(chan error, 1)},
+		capture: c,
+	}
+	p.SendMessage(&proto.GetPeersMessage{})
+	require.Equal(t, 1, c.sent)
+	// channel is full, the dropped message is not recorded
+	p.SendMessage(&proto.GetPeersMessage{})
+	require.Equal(t, 1, c.sent)
+	require.Len(t, p.remote.ErrCh, 1)
+}
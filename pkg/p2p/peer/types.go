@@ -18,6 +18,15 @@ type InfoMessage struct {
 	Value interface{}
 }
 
+// InvalidMessageError is reported to parent when the message received from peer can't be parsed.
+type InvalidMessageError struct {
+	Err error
+}
+
+func (a *InvalidMessageError) Error() string {
+	return "invalid message: " + a.Err.Error()
+}
+
 type Direction int
 
 const Incoming Direction = 1
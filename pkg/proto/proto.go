@@ -1502,12 +1502,12 @@ type CheckpointItem struct {
 // CheckPointMessage represents a CheckPoint message
 type CheckPointMessage struct {
 	Checkpoints []CheckpointItem
+	// Signature of checkpoints made with the key of checkpoints issuer
+	Signature crypto.Signature
 }
 
-// MarshalBinary encodes CheckPointMessage to binary form
-func (m *CheckPointMessage) MarshalBinary() ([]byte, error) {
-	body := make([]byte, 4, 4+len(m.Checkpoints)*72+100)
-
+func (m *CheckPointMessage) bodyBytes() []byte {
+	body := make([]byte, 4, 4+len(m.Checkpoints)*72+crypto.SignatureSize)
 	binary.BigEndian.PutUint32(body[0:4], uint32(len(m.Checkpoints)))
 	for _, c := range m.Checkpoints {
 		var height [8]byte
@@ -1515,6 +1515,28 @@ func (m *CheckPointMessage) MarshalBinary() ([]byte, error) {
 		body = append(body, height[:]...)
 		body = append(body, c.Signature[:]...)
 	}
+	return body
+}
+
+// Sign signs checkpoints with the secret key of issuer.
+func (m *CheckPointMessage) Sign(sk crypto.SecretKey) error {
+	sig, err := crypto.Sign(sk, m.bodyBytes())
+	if err != nil {
+		return err
+	}
+	m.Signature = sig
+	return nil
+}
+
+// Verify checks that checkpoints are signed by issuer with the public key.
+func (m *CheckPointMessage) Verify(pk crypto.PublicKey) bool {
+	return crypto.Verify(pk, m.Signature, m.bodyBytes())
+}
+
+// MarshalBinary encodes CheckPointMessage to binary form
+func (m *CheckPointMessage) MarshalBinary() ([]byte, error) {
+	body := m.bodyBytes()
+	body = append(body, m.Signature[:]...)
 
 	var h Header
 	h.Length = MaxHeaderLength + uint32(len(body)) - 4
@@ -1568,6 +1590,10 @@ func (m *CheckPointMessage) UnmarshalBinary(data []byte) error {
 		data = data[72:]
 		m.Checkpoints = append(m.Checkpoints, ci)
 	}
+	if len(data) < crypto.SignatureSize {
+		return fmt.Errorf("checkpoint message signature is missing")
+	}
+	copy(m.Signature[:], data[:crypto.SignatureSize])
 
 	return nil
 }
@@ -140,7 +140,7 @@ func (m *CheckPointMessage) Equal(d comparable) bool {
 	if !ok {
 		return false
 	}
-	if len(m.Checkpoints) != len(p.Checkpoints) {
+	if len(m.Checkpoints) != len(p.Checkpoints) || m.Signature != p.Signature {
 		return false
 	}
 	for i := 0; i < len(m.Checkpoints); i++ {
@@ -218,9 +218,10 @@ var tests = []protocolMarshallingTest{
 		"0000000f  12345678       19         00000002      c2426c62   6642",
 	},
 	{
-		&CheckPointMessage{[]CheckpointItem{{0xdeadbeef, crypto.Signature{0x10, 0x11}}}},
+		&CheckPointMessage{[]CheckpointItem{{0xdeadbeef, crypto.Signature{0x10, 0x11}}}, crypto.Signature{0x12}},
 		//P. Len |    Magic | ContentID | Payload Length | PayloadCsum | Payload
-		"00000059  12345678       64         0000004c      fcb6b02a   00000001 00000000 deadbeef 10110000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000",
+		"00000099  12345678       64         0000008c      da4a3df1   00000001 00000000 deadbeef 10110000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 " +
+			"12000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000 00000000",
 	},
 }
 
@@ -244,6 +245,17 @@ func TestProtocolMarshalling(t *testing.T) {
 	}
 }
 
+func TestCheckPointMessage_Verify(t *testing.T) {
+	sk, pk, err := crypto.GenerateKeyPair([]byte("checkpoints"))
+	require.NoError(t, err)
+	m := &CheckPointMessage{Checkpoints: []CheckpointItem{{Height: 10, Signature: crypto.Signature{1}}}}
+	require.NoError(t, m.Sign(sk))
+	require.True(t, m.Verify(pk))
+
+	m.Checkpoints[0].Height = 11
+	require.False(t, m.Verify(pk))
+}
+
 func TestTransactionMessageUnmarshalBinary(t *testing.T) {
 	p := TransactionMessage{
 		Transaction: []byte("transaction"),
@@ -1,6 +1,8 @@
 package proto
 
 import (
+	"encoding/binary"
+
 	"github.com/pkg/errors"
 	"github.com/wavesplatform/gowaves/pkg/crypto"
 	g "github.com/wavesplatform/gowaves/pkg/grpc/generated/waves"
@@ -562,6 +564,95 @@ func (c *ProtobufConverter) SponsorshipScriptActions(sponsorships []*g.InvokeScr
 	return res, nil
 }
 
+// LeaseScriptActions converts leases, it returns nil if there are none, as NewScriptResult does.
+func (c *ProtobufConverter) LeaseScriptActions(scheme byte, leases []*g.InvokeScriptResult_Lease) ([]*LeaseScriptAction, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	var res []*LeaseScriptAction
+	for _, x := range leases {
+		rcp, err := c.Recipient(scheme, x.Recipient)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, &LeaseScriptAction{
+			ID:        c.digest(x.LeaseId),
+			Recipient: rcp,
+			Amount:    x.Amount,
+			Nonce:     x.Nonce,
+		})
+		if c.err != nil {
+			return nil, c.err
+		}
+	}
+	return res, nil
+}
+
+// LeaseCancelScriptActions converts lease cancellations, it returns nil if there are none, as NewScriptResult does.
+func (c *ProtobufConverter) LeaseCancelScriptActions(cancels []*g.InvokeScriptResult_LeaseCancel) ([]*LeaseCancelScriptAction, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	var res []*LeaseCancelScriptAction
+	for _, x := range cancels {
+		res = append(res, &LeaseCancelScriptAction{LeaseID: c.digest(x.LeaseId)})
+		if c.err != nil {
+			return nil, c.err
+		}
+	}
+	return res, nil
+}
+
+// InvocationScriptResults converts the results of nested invocations, it returns nil if there are none.
+func (c *ProtobufConverter) InvocationScriptResults(scheme byte, invocations []*g.InvokeScriptResult_Invocation) ([]*InvocationScriptResult, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+	var res []*InvocationScriptResult
+	for _, x := range invocations {
+		dApp, err := c.Address(scheme, x.DApp)
+		if err != nil {
+			return nil, err
+		}
+		if x.Call == nil || x.StateChanges == nil {
+			return nil, errors.New("empty invocation call or state changes")
+		}
+		// arguments are stored one by one, they are unmarshalled as the list
+		size := 4
+		for _, b := range x.Call.ArgsBytes {
+			size += len(b)
+		}
+		buf := make([]byte, 4, size)
+		binary.BigEndian.PutUint32(buf, uint32(len(x.Call.ArgsBytes)))
+		for _, b := range x.Call.ArgsBytes {
+			buf = append(buf, b...)
+		}
+		args := Arguments{}
+		if err := args.UnmarshalBinary(buf); err != nil {
+			return nil, err
+		}
+		payments := make(ScriptPayments, len(x.Payments))
+		for i, p := range x.Payments {
+			asset, amount := c.convertAmount(p)
+			payments[i] = ScriptPayment{Amount: amount, Asset: asset}
+		}
+		if c.err != nil {
+			return nil, c.err
+		}
+		changes := &ScriptResult{}
+		if err := changes.FromProtobuf(scheme, x.StateChanges); err != nil {
+			return nil, err
+		}
+		res = append(res, &InvocationScriptResult{
+			DApp:         dApp,
+			Call:         FunctionCall{Default: x.Call.Function == "", Name: x.Call.Function, Arguments: args},
+			Payments:     payments,
+			StateChanges: changes,
+		})
+	}
+	return res, nil
+}
+
 func (c *ProtobufConverter) ErrorMessage(msg *g.InvokeScriptResult_ErrorMessage) (*ScriptErrorMessage, error) {
 	if c.err != nil {
 		return nil, c.err
@@ -148,6 +148,95 @@ func (a *SponsorshipScriptAction) ToProtobuf() *g.InvokeScriptResult_SponsorFee
 	}
 }
 
+// LeaseScriptAction is an action to lease Waves to other account in response to script invocation.
+type LeaseScriptAction struct {
+	ID        crypto.Digest
+	Recipient Recipient
+	Amount    int64
+	Nonce     int64
+}
+
+func (a LeaseScriptAction) scriptAction() {}
+
+func (a *LeaseScriptAction) ToProtobuf() (*g.InvokeScriptResult_Lease, error) {
+	rcp, err := a.Recipient.ToProtobuf()
+	if err != nil {
+		return nil, err
+	}
+	return &g.InvokeScriptResult_Lease{
+		Recipient: rcp,
+		Amount:    a.Amount,
+		Nonce:     a.Nonce,
+		LeaseId:   a.ID.Bytes(),
+	}, nil
+}
+
+// GenerateLeaseScriptActionID calculates the ID of lease created by script, the ID depends on the invoke transaction ID
+// and the nonce, so the same script can create multiple leases with the same recipient and amount.
+func GenerateLeaseScriptActionID(recipient Recipient, amount, nonce int64, txID crypto.Digest) (crypto.Digest, error) {
+	rb, err := recipient.MarshalBinary()
+	if err != nil {
+		return crypto.Digest{}, err
+	}
+	buf := make([]byte, crypto.DigestSize+len(rb)+8+8)
+	pos := 0
+	copy(buf[pos:], txID[:])
+	pos += crypto.DigestSize
+	copy(buf[pos:], rb)
+	pos += len(rb)
+	binary.BigEndian.PutUint64(buf[pos:], uint64(amount))
+	pos += 8
+	binary.BigEndian.PutUint64(buf[pos:], uint64(nonce))
+	return crypto.FastHash(buf)
+}
+
+// LeaseCancelScriptAction is an action to cancel lease created by the same script.
+type LeaseCancelScriptAction struct {
+	LeaseID crypto.Digest
+}
+
+func (a LeaseCancelScriptAction) scriptAction() {}
+
+func (a *LeaseCancelScriptAction) ToProtobuf() *g.InvokeScriptResult_LeaseCancel {
+	return &g.InvokeScriptResult_LeaseCancel{
+		LeaseId: a.LeaseID.Bytes(),
+	}
+}
+
+// InvocationScriptResult is the result of DApp invocation made by script with invoke or reentrantInvoke functions
+// of RIDE V5. State changes include the results of nested invocations made by the invoked function.
+type InvocationScriptResult struct {
+	DApp         Address
+	Call         FunctionCall
+	Payments     ScriptPayments
+	StateChanges *ScriptResult
+}
+
+func (r *InvocationScriptResult) ToProtobuf() (*g.InvokeScriptResult_Invocation, error) {
+	args := make([][]byte, len(r.Call.Arguments))
+	for i, arg := range r.Call.Arguments {
+		b, err := arg.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		args[i] = b
+	}
+	payments := make([]*g.Amount, len(r.Payments))
+	for i, p := range r.Payments {
+		payments[i] = &g.Amount{AssetId: p.Asset.ToID(), Amount: int64(p.Amount)}
+	}
+	changes, err := r.StateChanges.ToProtobuf()
+	if err != nil {
+		return nil, err
+	}
+	return &g.InvokeScriptResult_Invocation{
+		DApp:         r.DApp.Body(),
+		Call:         &g.InvokeScriptResult_Call{Function: r.Call.Name, ArgsBytes: args},
+		Payments:     payments,
+		StateChanges: changes,
+	}, nil
+}
+
 type ScriptErrorMessage struct {
 	Code TxFailureReason
 	Text string
@@ -167,6 +256,9 @@ type ScriptResult struct {
 	Reissues     []*ReissueScriptAction
 	Burns        []*BurnScriptAction
 	Sponsorships []*SponsorshipScriptAction
+	Leases       []*LeaseScriptAction
+	LeaseCancels []*LeaseCancelScriptAction
+	Invocations  []*InvocationScriptResult
 	ErrorMsg     ScriptErrorMessage
 }
 
@@ -178,6 +270,9 @@ func NewScriptResult(actions []ScriptAction, msg ScriptErrorMessage) (*ScriptRes
 	reissues := make([]*ReissueScriptAction, 0)
 	burns := make([]*BurnScriptAction, 0)
 	sponsorships := make([]*SponsorshipScriptAction, 0)
+	// Leases appeared in RIDE V5, they stay nil if there are none, as after unmarshalling.
+	var leases []*LeaseScriptAction
+	var leaseCancels []*LeaseCancelScriptAction
 	for _, a := range actions {
 		switch ta := a.(type) {
 		case *DataEntryScriptAction:
@@ -192,6 +287,10 @@ func NewScriptResult(actions []ScriptAction, msg ScriptErrorMessage) (*ScriptRes
 			burns = append(burns, ta)
 		case *SponsorshipScriptAction:
 			sponsorships = append(sponsorships, ta)
+		case *LeaseScriptAction:
+			leases = append(leases, ta)
+		case *LeaseCancelScriptAction:
+			leaseCancels = append(leaseCancels, ta)
 		default:
 			return nil, errors.Errorf("unsupported action type '%T'", a)
 		}
@@ -203,10 +302,13 @@ func NewScriptResult(actions []ScriptAction, msg ScriptErrorMessage) (*ScriptRes
 		Reissues:     reissues,
 		Burns:        burns,
 		Sponsorships: sponsorships,
+		Leases:       leases,
+		LeaseCancels: leaseCancels,
 		ErrorMsg:     msg,
 	}, nil
 }
 
+// ToProtobuf converts the result to protobuf message.
 func (sr *ScriptResult) ToProtobuf() (*g.InvokeScriptResult, error) {
 	data := make([]*g.DataTransactionData_DataEntry, len(sr.DataEntries))
 	for i, e := range sr.DataEntries {
@@ -236,6 +338,26 @@ func (sr *ScriptResult) ToProtobuf() (*g.InvokeScriptResult, error) {
 	for i := range sr.Sponsorships {
 		sponsorships[i] = sr.Sponsorships[i].ToProtobuf()
 	}
+	var leases []*g.InvokeScriptResult_Lease
+	for _, l := range sr.Leases {
+		lease, err := l.ToProtobuf()
+		if err != nil {
+			return nil, err
+		}
+		leases = append(leases, lease)
+	}
+	var leaseCancels []*g.InvokeScriptResult_LeaseCancel
+	for _, c := range sr.LeaseCancels {
+		leaseCancels = append(leaseCancels, c.ToProtobuf())
+	}
+	var invocations []*g.InvokeScriptResult_Invocation
+	for _, r := range sr.Invocations {
+		invocation, err := r.ToProtobuf()
+		if err != nil {
+			return nil, err
+		}
+		invocations = append(invocations, invocation)
+	}
 	return &g.InvokeScriptResult{
 		Data:         data,
 		Transfers:    transfers,
@@ -243,6 +365,9 @@ func (sr *ScriptResult) ToProtobuf() (*g.InvokeScriptResult, error) {
 		Reissues:     reissues,
 		Burns:        burns,
 		SponsorFees:  sponsorships,
+		Leases:       leases,
+		LeaseCancels: leaseCancels,
+		Invokes:      invocations,
 		ErrorMessage: sr.ErrorMsg.ToProtobuf(),
 	}, nil
 }
@@ -282,6 +407,18 @@ func (sr *ScriptResult) FromProtobuf(scheme byte, msg *g.InvokeScriptResult) err
 	if err != nil {
 		return err
 	}
+	sr.Leases, err = c.LeaseScriptActions(scheme, msg.Leases)
+	if err != nil {
+		return err
+	}
+	sr.LeaseCancels, err = c.LeaseCancelScriptActions(msg.LeaseCancels)
+	if err != nil {
+		return err
+	}
+	sr.Invocations, err = c.InvocationScriptResults(scheme, msg.Invokes)
+	if err != nil {
+		return err
+	}
 	errMsg, err := c.ErrorMessage(msg.ErrorMessage)
 	if err != nil {
 		return err
@@ -373,6 +510,24 @@ func ValidateActions(actions []ScriptAction, restrictions ActionsValidationRestr
 				return errors.New("negative minimal fee")
 			}
 
+		case *LeaseScriptAction:
+			otherActionsCount++
+			if otherActionsCount > maxScriptActions {
+				return errors.Errorf("number of actions produced by script is more than allowed %d", maxScriptActions)
+			}
+			if ta.Amount <= 0 {
+				return errors.New("negative or zero lease amount")
+			}
+			if ta.Recipient.Address != nil && ta.Recipient.Address.Eq(restrictions.ScriptAddress) {
+				return errors.New("leasing to DApp itself is forbidden")
+			}
+
+		case *LeaseCancelScriptAction:
+			otherActionsCount++
+			if otherActionsCount > maxScriptActions {
+				return errors.Errorf("number of actions produced by script is more than allowed %d", maxScriptActions)
+			}
+
 		default:
 			return errors.Errorf("unsupported script action type '%T'", a)
 		}
@@ -124,6 +124,42 @@ func TestScriptResultBinaryRoundTrip(t *testing.T) {
 				{AssetID: asset1.ID, MinFee: 0},
 			},
 		},
+		{
+			DataEntries:  emptyDataEntries,
+			Transfers:    emptyTransfers,
+			Issues:       emptyIssues,
+			Reissues:     emptyReissues,
+			Burns:        emptyBurns,
+			Sponsorships: emptySponsorships,
+			Leases: []*LeaseScriptAction{
+				{ID: asset0.ID, Recipient: rcp, Amount: 100500, Nonce: 1},
+				{ID: asset1.ID, Recipient: NewRecipientFromAlias(*NewAlias('W', "alias")), Amount: 1, Nonce: 0},
+			},
+			LeaseCancels: []*LeaseCancelScriptAction{{LeaseID: asset1.ID}},
+			Invocations: []*InvocationScriptResult{
+				{
+					DApp:     addr0,
+					Call:     FunctionCall{Name: "call", Arguments: Arguments{&IntegerArgument{Value: 1}, &StringArgument{Value: "s"}}},
+					Payments: ScriptPayments{{Amount: 10, Asset: *waves}, {Amount: 1, Asset: *asset0}},
+					StateChanges: &ScriptResult{
+						DataEntries:  []*DataEntryScriptAction{{&IntegerDataEntry{"nested", 1}}},
+						Transfers:    emptyTransfers,
+						Issues:       emptyIssues,
+						Reissues:     emptyReissues,
+						Burns:        emptyBurns,
+						Sponsorships: emptySponsorships,
+						Invocations: []*InvocationScriptResult{
+							{
+								DApp:         addr0,
+								Call:         FunctionCall{Default: true, Arguments: Arguments{}},
+								Payments:     ScriptPayments{},
+								StateChanges: &ScriptResult{DataEntries: emptyDataEntries, Transfers: emptyTransfers, Issues: emptyIssues, Reissues: emptyReissues, Burns: emptyBurns, Sponsorships: emptySponsorships},
+							},
+						},
+					},
+				},
+			},
+		},
 	} {
 		if msg, err := test.ToProtobuf(); assert.NoError(t, err) {
 			if b, err := MarshalToProtobufDeterministic(msg); assert.NoError(t, err) {
@@ -4,6 +4,8 @@ import (
 	"bytes"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 
 	"github.com/mr-tron/base58/base58"
 	"github.com/pkg/errors"
@@ -28,6 +30,7 @@ type Script struct {
 	Verifier   Expr
 	DApp       DApp
 	dApp       bool
+	program    *Program
 }
 
 func (a *Script) HasVerifier() bool {
@@ -51,96 +54,198 @@ func protoArgToArgExpr(arg proto.Argument) (Expr, error) {
 		return &StringExpr{a.Value}, nil
 	case *proto.BinaryArgument:
 		return &BytesExpr{a.Value}, nil
+	case *proto.ArrayArgument:
+		items := make(Exprs, len(a.Items))
+		for i, item := range a.Items {
+			e, err := protoArgToArgExpr(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = e
+		}
+		return items, nil
 	default:
 		return nil, errors.New("unknown argument type")
 	}
 }
 
+func argExprToProtoArg(e Expr) (proto.Argument, error) {
+	switch a := e.(type) {
+	case *LongExpr:
+		return proto.NewIntegerArgument(a.Value), nil
+	case *BooleanExpr:
+		return proto.NewBooleanArgument(a.Value), nil
+	case *StringExpr:
+		return proto.NewStringArgument(a.Value), nil
+	case *BytesExpr:
+		return proto.NewBinaryArgument(a.Value), nil
+	case Exprs:
+		items := make(proto.Arguments, len(a))
+		for i, item := range a {
+			arg, err := argExprToProtoArg(item)
+			if err != nil {
+				return nil, err
+			}
+			items[i] = arg
+		}
+		return proto.NewArrayArgument(items), nil
+	default:
+		return nil, errors.Errorf("unsupported type of argument '%T'", e)
+	}
+}
+
+// Invocation describes a call of DApp's callable function. The call is made either by InvokeScript transaction
+// or by other DApp, in the later case the origin caller is the sender of the transaction.
+type Invocation struct {
+	Caller                proto.Address
+	CallerPublicKey       crypto.PublicKey
+	OriginCaller          proto.Address
+	OriginCallerPublicKey crypto.PublicKey
+	Call                  proto.FunctionCall
+	Payments              proto.ScriptPayments
+}
+
+// NewInvocationFromTransaction creates the invocation of DApp by the sender of transaction.
+func NewInvocationFromTransaction(scheme proto.Scheme, tx *proto.InvokeScriptWithProofs) (*Invocation, error) {
+	addr, err := proto.NewAddressFromPublicKey(scheme, tx.SenderPK)
+	if err != nil {
+		return nil, err
+	}
+	return &Invocation{
+		Caller:                addr,
+		CallerPublicKey:       tx.SenderPK,
+		OriginCaller:          addr,
+		OriginCallerPublicKey: tx.SenderPK,
+		Call:                  tx.FunctionCall,
+		Payments:              tx.Payments,
+	}, nil
+}
+
 func (a *Script) CallFunction(scheme proto.Scheme, state types.SmartState, tx *proto.InvokeScriptWithProofs, this, lastBlock Expr) (bool, []proto.ScriptAction, error) {
+	invocation, err := NewInvocationFromTransaction(scheme, tx)
+	if err != nil {
+		return false, nil, err
+	}
+	ok, actions, _, err := a.InvokeFunction(scheme, state, tx, invocation, this, lastBlock, nil)
+	return ok, actions, err
+}
+
+// InvokeFunction calls the callable function of DApp described by the invocation. The invoker is used to perform
+// the nested invocations of other DApps, it could be nil for scripts below version 5.
+// Besides the actions the returned value of the function is returned, it is Unit for functions that return only actions.
+func (a *Script) InvokeFunction(scheme proto.Scheme, state types.SmartState, tx *proto.InvokeScriptWithProofs, invocation *Invocation, this, lastBlock Expr, invoker Invoker) (bool, []proto.ScriptAction, Expr, error) {
 	if !a.IsDapp() {
-		return false, nil, errors.New("can't call Script.CallFunction on non DApp")
+		return false, nil, nil, errors.New("can't call Script.CallFunction on non DApp")
 	}
 	txObj, err := NewVariablesFromTransaction(scheme, tx)
 	if err != nil {
-		return false, nil, errors.Wrap(err, "failed to convert transaction")
+		return false, nil, nil, errors.Wrap(err, "failed to convert transaction")
 	}
-	name := tx.FunctionCall.Name
-	if name == "" && tx.FunctionCall.Default {
+	name := invocation.Call.Name
+	if name == "" && invocation.Call.Default {
 		name = "default"
 	}
 	fn, ok := a.DApp.CallableFuncs[name]
 	if !ok {
-		return false, nil, errors.Errorf("Callable function named '%s' not found", name)
+		return false, nil, nil, errors.Errorf("Callable function named '%s' not found", name)
 	}
-	invoke, err := a.buildInvocation(scheme, tx)
+	invoke, err := a.buildInvocation(tx, invocation)
 	if err != nil {
-		return false, nil, err
+		return false, nil, nil, err
 	}
 	height, err := state.AddingBlockHeight()
 	if err != nil {
-		return false, nil, err
+		return false, nil, nil, err
 	}
 	scope := NewScope(a.Version, scheme, state)
 	scope.SetThis(this)
 	scope.SetLastBlockInfo(lastBlock)
 	scope.SetHeight(height)
 	scope.SetTransaction(txObj)
+	scope.SetInvoker(invoker)
 
-	// assign of global vars and function
-	for _, expr := range a.DApp.Declarations {
-		_, err = expr.Evaluate(scope)
+	var rs Expr
+	if a.program != nil {
+		args := make([]Expr, len(invocation.Call.Arguments))
+		for i, arg := range invocation.Call.Arguments {
+			args[i], err = protoArgToArgExpr(arg)
+			if err != nil {
+				return true, nil, nil, errors.Wrap(err, "Script.CallFunction")
+			}
+		}
+		rs, err = a.program.call(scope, name, args, invoke)
 		if err != nil {
-			return true, nil, errors.Wrap(err, "Script.CallFunction")
+			return true, nil, nil, errors.Wrap(err, "Script.CallFunction")
 		}
-	}
-
-	if len(fn.FuncDecl.Args) != len(tx.FunctionCall.Arguments) {
-		return true, nil, errors.Errorf("invalid func '%s' args count, expected %d, got %d", fn.FuncDecl.Name, len(fn.FuncDecl.Args), len(tx.FunctionCall.Arguments))
-	}
-	// pass function arguments
-	curScope := scope.Clone()
-	for i, arg := range tx.FunctionCall.Arguments {
-		argExpr, err := protoArgToArgExpr(arg)
+	} else {
+		rs, err = a.evaluateFunction(scope, fn, invocation.Call.Arguments, invoke)
 		if err != nil {
-			return true, nil, errors.Wrap(err, "Script.CallFunction")
+			return true, nil, nil, err
 		}
-		curScope.AddValue(fn.FuncDecl.Args[i], argExpr)
 	}
-	// invocation type
-	curScope.AddValue(fn.AnnotationInvokeName, invoke)
 
-	rs, err := fn.FuncDecl.Body.Evaluate(curScope)
-	if err != nil {
-		return true, nil, errors.Wrap(err, "Script.CallFunction")
+	var value Expr = NewUnit()
+	if t, ok := rs.(*TupleExpr); ok && a.Version >= 5 && len(t.Elements) == 2 {
+		rs, value = t.Elements[0], t.Elements[1]
 	}
-
 	switch t := rs.(type) {
 	case *WriteSetExpr:
 		actions, err := t.ToActions()
-		return true, actions, err
+		return true, actions, value, err
 	case *TransferSetExpr:
 		actions, err := t.ToActions()
-		return true, actions, err
+		return true, actions, value, err
 	case *ScriptResultExpr:
 		actions, err := t.ToActions()
-		return true, actions, err
+		return true, actions, value, err
 	case Exprs:
 		res := make([]proto.ScriptAction, 0, len(t))
 		for _, e := range t {
 			ae, ok := e.(Actionable)
 			if !ok {
-				return true, nil, errors.Errorf("Script.CallFunction: fail to convert result to action")
+				return true, nil, nil, errors.Errorf("Script.CallFunction: fail to convert result to action")
 			}
 			action, err := ae.ToAction(tx.ID)
 			if err != nil {
-				return true, nil, errors.Wrap(err, "Script.CallFunction: fail to convert result to action")
+				return true, nil, nil, errors.Wrap(err, "Script.CallFunction: fail to convert result to action")
 			}
 			res = append(res, action)
 		}
-		return true, res, nil
+		return true, res, value, nil
 	default:
-		return true, nil, errors.Errorf("Script.CallFunction: unexpected result type '%T'", t)
+		return true, nil, nil, errors.Errorf("Script.CallFunction: unexpected result type '%T'", t)
+	}
+}
+
+func (a *Script) evaluateFunction(scope Scope, fn *DappCallableFunc, arguments proto.Arguments, invoke Expr) (Expr, error) {
+	// assign of global vars and function
+	for _, expr := range a.DApp.Declarations {
+		_, err := expr.Evaluate(scope)
+		if err != nil {
+			return nil, errors.Wrap(err, "Script.CallFunction")
+		}
+	}
+
+	if len(fn.FuncDecl.Args) != len(arguments) {
+		return nil, errors.Errorf("invalid func '%s' args count, expected %d, got %d", fn.FuncDecl.Name, len(fn.FuncDecl.Args), len(arguments))
+	}
+	// pass function arguments
+	curScope := scope.Clone()
+	for i, arg := range arguments {
+		argExpr, err := protoArgToArgExpr(arg)
+		if err != nil {
+			return nil, errors.Wrap(err, "Script.CallFunction")
+		}
+		curScope.AddValue(fn.FuncDecl.Args[i], argExpr)
 	}
+	// invocation type
+	curScope.AddValue(fn.AnnotationInvokeName, invoke)
+
+	rs, err := fn.FuncDecl.Body.Evaluate(curScope)
+	if err != nil {
+		return nil, errors.Wrap(err, "Script.CallFunction")
+	}
+	return rs, nil
 }
 
 func (a *Script) Verify(scheme byte, state types.SmartState, object map[string]Expr, this, lastBlock Expr) (Result, error) {
@@ -158,6 +263,9 @@ func (a *Script) Verify(scheme byte, state types.SmartState, object map[string]E
 		scope.SetHeight(height)
 
 		fn := a.DApp.Verifier
+		if a.program != nil {
+			return resultOf(a.program.verify(scope, NewObject(object)))
+		}
 		// pass function arguments
 		curScope := scope //.Clone()
 		// annotated tx type
@@ -176,30 +284,37 @@ func (a *Script) Verify(scheme byte, state types.SmartState, object map[string]E
 		scope.SetThis(this)
 		scope.SetLastBlockInfo(lastBlock)
 		scope.SetHeight(height)
+		if a.program != nil {
+			return resultOf(a.program.verify(scope, nil))
+		}
 		return evalAsResult(a.Verifier, scope)
 	}
 }
 
-func (a *Script) buildInvocation(scheme proto.Scheme, tx *proto.InvokeScriptWithProofs) (*InvocationExpr, error) {
+func (a *Script) buildInvocation(tx *proto.InvokeScriptWithProofs, invocation *Invocation) (*InvocationExpr, error) {
 	fields := object{}
-	addr, err := proto.NewAddressFromPublicKey(scheme, tx.SenderPK)
-	if err != nil {
-		return nil, err
-	}
-	fields["caller"] = NewAddressFromProtoAddress(addr)
-	fields["callerPublicKey"] = NewBytes(tx.SenderPK.Bytes())
+	fields["caller"] = NewAddressFromProtoAddress(invocation.Caller)
+	fields["callerPublicKey"] = NewBytes(invocation.CallerPublicKey.Bytes())
 
 	switch a.Version {
 	case 4:
 		payments := NewExprs(nil)
-		for _, p := range tx.Payments {
+		for _, p := range invocation.Payments {
 			payments = append(NewExprs(NewAttachedPaymentExpr(makeOptionalAsset(p.Asset), NewLong(int64(p.Amount)))), payments...)
 		}
 		fields["payments"] = payments
+	case 5:
+		payments := make(Exprs, len(invocation.Payments))
+		for i, p := range invocation.Payments {
+			payments[i] = NewAttachedPaymentExpr(makeOptionalAsset(p.Asset), NewLong(int64(p.Amount)))
+		}
+		fields["payments"] = payments
+		fields["originCaller"] = NewAddressFromProtoAddress(invocation.OriginCaller)
+		fields["originCallerPublicKey"] = NewBytes(invocation.OriginCallerPublicKey.Bytes())
 	default:
 		fields["payment"] = NewUnit()
-		if len(tx.Payments) > 0 {
-			fields["payment"] = NewAttachedPaymentExpr(makeOptionalAsset(tx.Payments[0].Asset), NewLong(int64(tx.Payments[0].Amount)))
+		if len(invocation.Payments) > 0 {
+			fields["payment"] = NewAttachedPaymentExpr(makeOptionalAsset(invocation.Payments[0].Asset), NewLong(int64(invocation.Payments[0].Amount)))
 		}
 	}
 	fields["transactionId"] = NewBytes(tx.ID.Bytes())
@@ -232,7 +347,10 @@ func (r *Result) Failed() bool {
 }
 
 func evalAsResult(e Expr, s Scope) (Result, error) {
-	rs, err := e.Evaluate(s)
+	return resultOf(e.Evaluate(s))
+}
+
+func resultOf(rs Expr, err error) (Result, error) {
 	if err != nil {
 		if throw, ok := err.(Throw); ok {
 			return Result{
@@ -2294,6 +2412,172 @@ func (a *SponsorshipExpr) Get(name string) (Expr, error) {
 	}
 }
 
+type LeaseExpr struct {
+	Recipient proto.Recipient
+	Amount    int64
+	Nonce     int64
+}
+
+func NewLeaseExpr(recipient proto.Recipient, amount, nonce int64) *LeaseExpr {
+	return &LeaseExpr{
+		Recipient: recipient,
+		Amount:    amount,
+		Nonce:     nonce,
+	}
+}
+
+func (a *LeaseExpr) Write(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "LeaseExpr")
+}
+
+func (a *LeaseExpr) Evaluate(Scope) (Expr, error) {
+	return a, nil
+}
+
+func (a *LeaseExpr) Eq(other Expr) bool {
+	b, ok := other.(*LeaseExpr)
+	if !ok {
+		return false
+	}
+	return a.Recipient.Eq(b.Recipient) && a.Amount == b.Amount && a.Nonce == b.Nonce
+}
+
+func (a *LeaseExpr) InstanceOf() string {
+	return "Lease"
+}
+
+func (a *LeaseExpr) ToAction(id *crypto.Digest) (proto.ScriptAction, error) {
+	if id == nil {
+		return nil, errors.New("empty parent for LeaseExpr")
+	}
+	leaseID, err := proto.GenerateLeaseScriptActionID(a.Recipient, a.Amount, a.Nonce, *id)
+	if err != nil {
+		return nil, err
+	}
+	return &proto.LeaseScriptAction{
+		ID:        leaseID,
+		Recipient: a.Recipient,
+		Amount:    a.Amount,
+		Nonce:     a.Nonce,
+	}, nil
+}
+
+func (a *LeaseExpr) Get(name string) (Expr, error) {
+	switch name {
+	case "recipient":
+		return NewRecipientFromProtoRecipient(a.Recipient), nil
+	case "amount":
+		return NewLong(a.Amount), nil
+	case "nonce":
+		return NewLong(a.Nonce), nil
+	default:
+		return nil, errors.Errorf("unknown field '%s' of LeaseExpr", name)
+	}
+}
+
+type LeaseCancelExpr struct {
+	LeaseID crypto.Digest
+}
+
+func NewLeaseCancelExpr(leaseID []byte) (*LeaseCancelExpr, error) {
+	id, err := crypto.NewDigestFromBytes(leaseID)
+	if err != nil {
+		return nil, err
+	}
+	return &LeaseCancelExpr{
+		LeaseID: id,
+	}, nil
+}
+
+func (a *LeaseCancelExpr) Write(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "LeaseCancelExpr")
+}
+
+func (a *LeaseCancelExpr) Evaluate(Scope) (Expr, error) {
+	return a, nil
+}
+
+func (a *LeaseCancelExpr) Eq(other Expr) bool {
+	b, ok := other.(*LeaseCancelExpr)
+	if !ok {
+		return false
+	}
+	return a.LeaseID == b.LeaseID
+}
+
+func (a *LeaseCancelExpr) InstanceOf() string {
+	return "LeaseCancel"
+}
+
+func (a *LeaseCancelExpr) ToAction(*crypto.Digest) (proto.ScriptAction, error) {
+	return &proto.LeaseCancelScriptAction{
+		LeaseID: a.LeaseID,
+	}, nil
+}
+
+func (a *LeaseCancelExpr) Get(name string) (Expr, error) {
+	switch name {
+	case "leaseId":
+		return NewBytes(a.LeaseID.Bytes()), nil
+	default:
+		return nil, errors.Errorf("unknown field '%s' of LeaseCancelExpr", name)
+	}
+}
+
+const maxTupleSize = 22
+
+// TupleExpr is a tuple of values, elements are accessible with getters `_1`, `_2` and so on.
+type TupleExpr struct {
+	Elements Exprs
+}
+
+func NewTuple(elements ...Expr) *TupleExpr {
+	return &TupleExpr{
+		Elements: elements,
+	}
+}
+
+func (a *TupleExpr) Write(w io.Writer) {
+	_, _ = fmt.Fprint(w, "(")
+	for i, e := range a.Elements {
+		if i > 0 {
+			_, _ = fmt.Fprint(w, ", ")
+		}
+		e.Write(w)
+	}
+	_, _ = fmt.Fprint(w, ")")
+}
+
+func (a *TupleExpr) Evaluate(Scope) (Expr, error) {
+	return a, nil
+}
+
+func (a *TupleExpr) Eq(other Expr) bool {
+	b, ok := other.(*TupleExpr)
+	if !ok || len(a.Elements) != len(b.Elements) {
+		return false
+	}
+	for i := range a.Elements {
+		if !a.Elements[i].Eq(b.Elements[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func (a *TupleExpr) InstanceOf() string {
+	return fmt.Sprintf("Tuple%d", len(a.Elements))
+}
+
+func (a *TupleExpr) Get(name string) (Expr, error) {
+	if strings.HasPrefix(name, "_") {
+		if i, err := strconv.Atoi(name[1:]); err == nil && i >= 1 && i <= len(a.Elements) {
+			return a.Elements[i-1], nil
+		}
+	}
+	return nil, errors.Errorf("unknown field '%s' of TupleExpr", name)
+}
+
 type BalanceDetailsExpr struct {
 	fields object
 }
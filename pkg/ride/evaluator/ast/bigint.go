@@ -0,0 +1,534 @@
+package ast
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ericlagergren/decimal"
+	"github.com/ericlagergren/decimal/math"
+	"github.com/pkg/errors"
+)
+
+const (
+	maxBigIntBytes = 64
+	maxBigIntScale = 18
+)
+
+var (
+	bigOne    = big.NewInt(1)
+	maxBigInt = new(big.Int).Sub(new(big.Int).Lsh(bigOne, 511), bigOne)
+	minBigInt = new(big.Int).Neg(new(big.Int).Lsh(bigOne, 511))
+
+	// bigIntContext has enough precision to hold any BigInt value with the maximal scale.
+	bigIntContext = decimal.Context{
+		Precision:     200,
+		RoundingMode:  decimal.ToNearestEven,
+		OperatingMode: decimal.GDA,
+		Traps:         ^(decimal.Inexact | decimal.Rounded | decimal.Subnormal),
+		MaxScale:      6144,
+		MinScale:      -6143,
+	}
+)
+
+// BigIntExpr is a signed 512-bit integer introduced in RIDE V5.
+type BigIntExpr struct {
+	Value *big.Int
+}
+
+func NewBigInt(value *big.Int) *BigIntExpr {
+	return &BigIntExpr{
+		Value: value,
+	}
+}
+
+func (a *BigIntExpr) Write(w io.Writer) {
+	_, _ = fmt.Fprint(w, a.Value.String())
+}
+
+func (a *BigIntExpr) Evaluate(Scope) (Expr, error) {
+	return a, nil
+}
+
+func (a *BigIntExpr) Eq(other Expr) bool {
+	b, ok := other.(*BigIntExpr)
+	if !ok {
+		return false
+	}
+	return a.Value.Cmp(b.Value) == 0
+}
+
+func (a *BigIntExpr) InstanceOf() string {
+	return "BigInt"
+}
+
+func checkedBigInt(v *big.Int) (*BigIntExpr, error) {
+	if v.Cmp(minBigInt) < 0 || v.Cmp(maxBigInt) > 0 {
+		return nil, errors.New("BigInt value is out of range")
+	}
+	return NewBigInt(v), nil
+}
+
+// bigIntToBytes returns the minimal two's-complement big-endian representation of the value.
+func bigIntToBytes(v *big.Int) []byte {
+	t := new(big.Int).Set(v)
+	if v.Sign() < 0 {
+		t.Not(t)
+	}
+	n := t.BitLen()/8 + 1
+	t.Set(v)
+	if v.Sign() < 0 {
+		t.Add(t, new(big.Int).Lsh(bigOne, uint(8*n)))
+	}
+	return t.FillBytes(make([]byte, n))
+}
+
+func bigIntFromBytes(b []byte) (*big.Int, error) {
+	if l := len(b); l == 0 || l > maxBigIntBytes {
+		return nil, errors.Errorf("invalid size of BigInt bytes %d", l)
+	}
+	v := new(big.Int).SetBytes(b)
+	if b[0]&0x80 != 0 {
+		v.Sub(v, new(big.Int).Lsh(bigOne, uint(8*len(b))))
+	}
+	return v, nil
+}
+
+// roundedQuo divides x by y rounding the result with the given mode.
+func roundedQuo(x, y *big.Int, mode decimal.RoundingMode) *big.Int {
+	q, r := new(big.Int).QuoRem(x, y, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	negative := (x.Sign() < 0) != (y.Sign() < 0)
+	half := new(big.Int).Lsh(new(big.Int).Abs(r), 1).CmpAbs(y)
+	away := false
+	switch mode {
+	case decimal.AwayFromZero:
+		away = true
+	case decimal.ToPositiveInf:
+		away = !negative
+	case decimal.ToNegativeInf:
+		away = negative
+	case decimal.ToNearestAway:
+		away = half >= 0
+	case decimal.ToNearestTowardZero:
+		away = half > 0
+	case decimal.ToNearestEven:
+		away = half > 0 || (half == 0 && new(big.Int).Abs(q).Bit(0) == 1)
+	}
+	if away {
+		if negative {
+			q.Sub(q, bigOne)
+		} else {
+			q.Add(q, bigOne)
+		}
+	}
+	return q
+}
+
+func convertToBigResult(v *decimal.Big, scale int, mode decimal.RoundingMode) (*big.Int, error) {
+	context := bigIntContext
+	context.RoundingMode = mode
+	r := decimal.WithContext(context).Set(v)
+	s := decimal.WithContext(bigIntContext).SetMantScale(int64(scale), 0)
+	m := decimal.WithContext(bigIntContext)
+	math.Pow(m, ten, s)
+	r.Mul(r, m)
+	if !r.IsFinite() {
+		return nil, errors.New("result is not finite")
+	}
+	res := r.RoundToInt().Int(nil)
+	if res.Cmp(minBigInt) < 0 || res.Cmp(maxBigInt) > 0 {
+		return nil, errors.New("result out of BigInt range")
+	}
+	return res, nil
+}
+
+func checkBigIntScales(baseScale, exponentScale, resultScale int) bool {
+	return baseScale >= 0 && baseScale <= maxBigIntScale &&
+		exponentScale >= 0 && exponentScale <= maxBigIntScale &&
+		resultScale >= 0 && resultScale <= maxBigIntScale
+}
+
+func powBigInt(base, exponent *big.Int, baseScale, exponentScale, resultScale int, mode decimal.RoundingMode) (*big.Int, error) {
+	if !checkBigIntScales(baseScale, exponentScale, resultScale) {
+		return nil, errors.New("pow: invalid scale")
+	}
+	b := decimal.WithContext(bigIntContext).SetBigMantScale(base, baseScale)
+	e := decimal.WithContext(bigIntContext).SetBigMantScale(exponent, exponentScale)
+	if b.IsInt() && e.Cmp(zero) == 0 {
+		res, err := convertToBigResult(one, resultScale, mode)
+		if err != nil {
+			return nil, errors.Wrap(err, "pow")
+		}
+		return res, nil
+	}
+	r := decimal.WithContext(bigIntContext)
+	r = math.Pow(r, b, e)
+	if r.Context.Err() != nil {
+		return nil, errors.Errorf("pow: %s", r.Context.Conditions.Error())
+	}
+	res, err := convertToBigResult(r, resultScale, mode)
+	if err != nil {
+		return nil, errors.Wrap(err, "pow")
+	}
+	return res, nil
+}
+
+func logBigInt(base, exponent *big.Int, baseScale, exponentScale, resultScale int, mode decimal.RoundingMode) (*big.Int, error) {
+	if !checkBigIntScales(baseScale, exponentScale, resultScale) {
+		return nil, errors.New("log: invalid scale")
+	}
+	b := decimal.WithContext(bigIntContext).SetBigMantScale(base, baseScale)
+	e := decimal.WithContext(bigIntContext).SetBigMantScale(exponent, exponentScale)
+	bl := decimal.WithContext(bigIntContext)
+	math.Log(bl, b)
+	if bl.Context.Err() != nil {
+		return nil, errors.New(bl.Context.Conditions.Error())
+	}
+	el := decimal.WithContext(bigIntContext)
+	math.Log(el, e)
+	if el.Context.Err() != nil {
+		return nil, errors.New(el.Context.Conditions.Error())
+	}
+	r := decimal.WithContext(bigIntContext)
+	r.Quo(bl, el)
+	if r.Context.Err() != nil {
+		return nil, errors.New(r.Context.Conditions.Error())
+	}
+	res, err := convertToBigResult(r, resultScale, mode)
+	if err != nil {
+		return nil, errors.Wrap(err, "log")
+	}
+	return res, nil
+}
+
+func mathBigInt(funcName string, f func(*big.Int, *big.Int) (Expr, error), s Scope, e Exprs) (Expr, error) {
+	if l := len(e); l != 2 {
+		return nil, errors.Errorf("%s: invalid params, expected 2, passed %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	first, ok := rs[0].(*BigIntExpr)
+	if !ok {
+		return nil, errors.Errorf("%s first argument expected to be *BigIntExpr, got %T", funcName, rs[0])
+	}
+	second, ok := rs[1].(*BigIntExpr)
+	if !ok {
+		return nil, errors.Errorf("%s second argument expected to be *BigIntExpr, got %T", funcName, rs[1])
+	}
+	r, err := f(first.Value, second.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return r, nil
+}
+
+func bigIntArgument(funcName string, s Scope, e Exprs) (*big.Int, error) {
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	v, ok := rs[0].(*BigIntExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected argument of type *BigIntExpr, got %T", funcName, rs[0])
+	}
+	return v.Value, nil
+}
+
+// NativeToBigInt converts Int to BigInt.
+func NativeToBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeToBigInt"
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	v, ok := rs[0].(*LongExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected argument of type *LongExpr, got %T", funcName, rs[0])
+	}
+	return NewBigInt(big.NewInt(v.Value)), nil
+}
+
+// NativeSumBigInt sums two BigInts.
+func NativeSumBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeSumBigInt", func(x, y *big.Int) (Expr, error) {
+		return checkedBigInt(new(big.Int).Add(x, y))
+	}, s, e)
+}
+
+// NativeSubBigInt subtracts two BigInts.
+func NativeSubBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeSubBigInt", func(x, y *big.Int) (Expr, error) {
+		return checkedBigInt(new(big.Int).Sub(x, y))
+	}, s, e)
+}
+
+// NativeMulBigInt multiplies two BigInts.
+func NativeMulBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeMulBigInt", func(x, y *big.Int) (Expr, error) {
+		return checkedBigInt(new(big.Int).Mul(x, y))
+	}, s, e)
+}
+
+// NativeDivBigInt divides two BigInts, the result is truncated towards zero.
+func NativeDivBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeDivBigInt", func(x, y *big.Int) (Expr, error) {
+		if y.Sign() == 0 {
+			return nil, errors.New("zero division")
+		}
+		return checkedBigInt(new(big.Int).Quo(x, y))
+	}, s, e)
+}
+
+// NativeModBigInt returns the remainder of truncated division of two BigInts.
+func NativeModBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeModBigInt", func(x, y *big.Int) (Expr, error) {
+		if y.Sign() == 0 {
+			return nil, errors.New("zero division")
+		}
+		return checkedBigInt(new(big.Int).Rem(x, y))
+	}, s, e)
+}
+
+// NativeGtBigInt checks that the first BigInt is greater than the second.
+func NativeGtBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeGtBigInt", func(x, y *big.Int) (Expr, error) {
+		return NewBoolean(x.Cmp(y) > 0), nil
+	}, s, e)
+}
+
+// NativeGeBigInt checks that the first BigInt is greater than or equal to the second.
+func NativeGeBigInt(s Scope, e Exprs) (Expr, error) {
+	return mathBigInt("NativeGeBigInt", func(x, y *big.Int) (Expr, error) {
+		return NewBoolean(x.Cmp(y) >= 0), nil
+	}, s, e)
+}
+
+// NativeUnaryMinusBigInt negates BigInt.
+func NativeUnaryMinusBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeUnaryMinusBigInt"
+	v, err := bigIntArgument(funcName, s, e)
+	if err != nil {
+		return nil, err
+	}
+	r, err := checkedBigInt(new(big.Int).Neg(v))
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return r, nil
+}
+
+func fractionBigIntArguments(funcName string, rs Exprs) (*big.Int, *big.Int, *big.Int, error) {
+	value, ok := rs[0].(*BigIntExpr)
+	if !ok {
+		return nil, nil, nil, errors.Errorf("%s first argument expected to be *BigIntExpr, got %T", funcName, rs[0])
+	}
+	numerator, ok := rs[1].(*BigIntExpr)
+	if !ok {
+		return nil, nil, nil, errors.Errorf("%s second argument expected to be *BigIntExpr, got %T", funcName, rs[1])
+	}
+	denominator, ok := rs[2].(*BigIntExpr)
+	if !ok {
+		return nil, nil, nil, errors.Errorf("%s third argument expected to be *BigIntExpr, got %T", funcName, rs[2])
+	}
+	if denominator.Value.Sign() == 0 {
+		return nil, nil, nil, errors.Errorf("%s: zero division", funcName)
+	}
+	return value.Value, numerator.Value, denominator.Value, nil
+}
+
+// NativeFractionBigInt multiplies and divides BigInts, the result is truncated towards zero.
+func NativeFractionBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeFractionBigInt"
+	if l := len(e); l != 3 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 3, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	v, n, d, err := fractionBigIntArguments(funcName, rs)
+	if err != nil {
+		return nil, err
+	}
+	r, err := checkedBigInt(new(big.Int).Quo(new(big.Int).Mul(v, n), d))
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return r, nil
+}
+
+// NativeFractionBigIntRounding multiplies and divides BigInts, the result is rounded with the given rounding mode.
+func NativeFractionBigIntRounding(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeFractionBigIntRounding"
+	if l := len(e); l != 4 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 4, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	v, n, d, err := fractionBigIntArguments(funcName, rs)
+	if err != nil {
+		return nil, err
+	}
+	round, err := roundingMode(rs[3])
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	r, err := checkedBigInt(roundedQuo(new(big.Int).Mul(v, n), d, round))
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return r, nil
+}
+
+func powLogBigIntArguments(funcName string, s Scope, e Exprs) (*big.Int, *big.Int, int, int, int, decimal.RoundingMode, error) {
+	if l := len(e); l != 6 {
+		return nil, nil, 0, 0, 0, 0, errors.Errorf("%s: invalid number of parameters, expected 6, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, nil, 0, 0, 0, 0, errors.Wrap(err, funcName)
+	}
+	base, ok := rs[0].(*BigIntExpr)
+	if !ok {
+		return nil, nil, 0, 0, 0, 0, errors.Errorf("%s first argument expected to be *BigIntExpr, got %T", funcName, rs[0])
+	}
+	bp, ok := rs[1].(*LongExpr)
+	if !ok {
+		return nil, nil, 0, 0, 0, 0, errors.Errorf("%s second argument expected to be *LongExpr, got %T", funcName, rs[1])
+	}
+	exponent, ok := rs[2].(*BigIntExpr)
+	if !ok {
+		return nil, nil, 0, 0, 0, 0, errors.Errorf("%s third argument expected to be *BigIntExpr, got %T", funcName, rs[2])
+	}
+	ep, ok := rs[3].(*LongExpr)
+	if !ok {
+		return nil, nil, 0, 0, 0, 0, errors.Errorf("%s 4th argument expected to be *LongExpr, got %T", funcName, rs[3])
+	}
+	rp, ok := rs[4].(*LongExpr)
+	if !ok {
+		return nil, nil, 0, 0, 0, 0, errors.Errorf("%s 5th argument expected to be *LongExpr, got %T", funcName, rs[4])
+	}
+	round, err := roundingMode(rs[5])
+	if err != nil {
+		return nil, nil, 0, 0, 0, 0, errors.Wrap(err, funcName)
+	}
+	return base.Value, exponent.Value, int(bp.Value), int(ep.Value), int(rp.Value), round, nil
+}
+
+// NativePowBigInt calculates power of BigInt.
+func NativePowBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativePowBigInt"
+	base, exponent, bp, ep, rp, round, err := powLogBigIntArguments(funcName, s, e)
+	if err != nil {
+		return nil, err
+	}
+	r, err := powBigInt(base, exponent, bp, ep, rp, round)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return NewBigInt(r), nil
+}
+
+// NativeLogBigInt calculates logarithm of BigInt.
+func NativeLogBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeLogBigInt"
+	base, exponent, bp, ep, rp, round, err := powLogBigIntArguments(funcName, s, e)
+	if err != nil {
+		return nil, err
+	}
+	r, err := logBigInt(base, exponent, bp, ep, rp, round)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return NewBigInt(r), nil
+}
+
+// NativeBigIntToLong converts BigInt to Int, fails if the value doesn't fit.
+func NativeBigIntToLong(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeBigIntToLong"
+	v, err := bigIntArgument(funcName, s, e)
+	if err != nil {
+		return nil, err
+	}
+	if !v.IsInt64() {
+		return nil, errors.Errorf("%s: BigInt value is out of Int range", funcName)
+	}
+	return NewLong(v.Int64()), nil
+}
+
+// NativeBigIntToBytes converts BigInt to its two's-complement big-endian representation.
+func NativeBigIntToBytes(s Scope, e Exprs) (Expr, error) {
+	v, err := bigIntArgument("NativeBigIntToBytes", s, e)
+	if err != nil {
+		return nil, err
+	}
+	return NewBytes(bigIntToBytes(v)), nil
+}
+
+// NativeBytesToBigInt converts two's-complement big-endian bytes to BigInt.
+func NativeBytesToBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeBytesToBigInt"
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	b, ok := rs[0].(*BytesExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected argument of type *BytesExpr, got %T", funcName, rs[0])
+	}
+	v, err := bigIntFromBytes(b.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return NewBigInt(v), nil
+}
+
+// NativeParseBigInt parses decimal string representation of BigInt, returns unit if the string is invalid.
+func NativeParseBigInt(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeParseBigInt"
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	str, ok := rs[0].(*StringExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected argument of type *StringExpr, got %T", funcName, rs[0])
+	}
+	v, ok := new(big.Int).SetString(str.Value, 10)
+	if !ok {
+		return NewUnit(), nil
+	}
+	r, err := checkedBigInt(v)
+	if err != nil {
+		return NewUnit(), nil
+	}
+	return r, nil
+}
+
+// NativeBigIntToString returns decimal string representation of BigInt.
+func NativeBigIntToString(s Scope, e Exprs) (Expr, error) {
+	v, err := bigIntArgument("NativeBigIntToString", s, e)
+	if err != nil {
+		return nil, err
+	}
+	return NewString(v.String()), nil
+}
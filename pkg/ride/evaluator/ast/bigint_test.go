@@ -0,0 +1,136 @@
+package ast
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func bigInt(t *testing.T, s string) *BigIntExpr {
+	v, ok := new(big.Int).SetString(s, 10)
+	require.True(t, ok)
+	return NewBigInt(v)
+}
+
+func TestBigIntArithmetics(t *testing.T) {
+	max := NewBigInt(maxBigInt)
+	for _, test := range []struct {
+		fn       Callable
+		x, y     *BigIntExpr
+		expected *BigIntExpr
+	}{
+		{NativeSumBigInt, bigInt(t, "9223372036854775807"), bigInt(t, "1"), bigInt(t, "9223372036854775808")},
+		{NativeSubBigInt, bigInt(t, "-9223372036854775808"), bigInt(t, "1"), bigInt(t, "-9223372036854775809")},
+		{NativeMulBigInt, bigInt(t, "9223372036854775807"), bigInt(t, "-2"), bigInt(t, "-18446744073709551614")},
+		{NativeDivBigInt, bigInt(t, "-7"), bigInt(t, "2"), bigInt(t, "-3")},
+		{NativeModBigInt, bigInt(t, "-7"), bigInt(t, "2"), bigInt(t, "-1")},
+	} {
+		rs, err := test.fn(newEmptyScopeV5(), Params(test.x, test.y))
+		require.NoError(t, err)
+		assert.True(t, test.expected.Eq(rs), "expected %s, got %s", test.expected.Value, rs.(*BigIntExpr).Value)
+	}
+	_, err := NativeSumBigInt(newEmptyScopeV5(), Params(max, bigInt(t, "1")))
+	assert.Error(t, err)
+	_, err = NativeMulBigInt(newEmptyScopeV5(), Params(max, bigInt(t, "2")))
+	assert.Error(t, err)
+	_, err = NativeDivBigInt(newEmptyScopeV5(), Params(max, bigInt(t, "0")))
+	assert.Error(t, err)
+	_, err = NativeUnaryMinusBigInt(newEmptyScopeV5(), Params(NewBigInt(minBigInt)))
+	assert.Error(t, err)
+}
+
+func TestBigIntComparison(t *testing.T) {
+	rs, err := NativeGtBigInt(newEmptyScopeV5(), Params(bigInt(t, "2"), bigInt(t, "1")))
+	require.NoError(t, err)
+	assert.Equal(t, NewBoolean(true), rs)
+	rs, err = NativeGtBigInt(newEmptyScopeV5(), Params(bigInt(t, "1"), bigInt(t, "1")))
+	require.NoError(t, err)
+	assert.Equal(t, NewBoolean(false), rs)
+	rs, err = NativeGeBigInt(newEmptyScopeV5(), Params(bigInt(t, "1"), bigInt(t, "1")))
+	require.NoError(t, err)
+	assert.Equal(t, NewBoolean(true), rs)
+}
+
+func TestBigIntFraction(t *testing.T) {
+	max := NewBigInt(maxBigInt)
+	rs, err := NativeFractionBigInt(newEmptyScopeV5(), Params(max, bigInt(t, "3"), bigInt(t, "6")))
+	require.NoError(t, err)
+	assert.True(t, NewBigInt(new(big.Int).Quo(maxBigInt, big.NewInt(2))).Eq(rs))
+
+	rs, err = NativeFractionBigIntRounding(newEmptyScopeV5(), Params(bigInt(t, "-5"), bigInt(t, "1"), bigInt(t, "2"), &HalfEvenExpr{}))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "-2").Eq(rs))
+	rs, err = NativeFractionBigIntRounding(newEmptyScopeV5(), Params(bigInt(t, "-5"), bigInt(t, "1"), bigInt(t, "2"), &HalfUpExpr{}))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "-3").Eq(rs))
+	rs, err = NativeFractionBigIntRounding(newEmptyScopeV5(), Params(bigInt(t, "-5"), bigInt(t, "1"), bigInt(t, "2"), &CeilingExpr{}))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "-2").Eq(rs))
+
+	_, err = NativeFractionBigInt(newEmptyScopeV5(), Params(max, bigInt(t, "2"), bigInt(t, "1")))
+	assert.Error(t, err)
+	_, err = NativeFractionBigInt(newEmptyScopeV5(), Params(max, bigInt(t, "2"), bigInt(t, "0")))
+	assert.Error(t, err)
+}
+
+func TestBigIntPowLog(t *testing.T) {
+	rs, err := NativePowBigInt(newEmptyScopeV5(), Params(bigInt(t, "12"), NewLong(1), bigInt(t, "2"), NewLong(0), NewLong(18), &HalfEvenExpr{}))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "1440000000000000000").Eq(rs))
+	rs, err = NativeLogBigInt(newEmptyScopeV5(), Params(bigInt(t, "1000"), NewLong(0), bigInt(t, "10"), NewLong(0), NewLong(18), &HalfEvenExpr{}))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "3000000000000000000").Eq(rs))
+	_, err = NativePowBigInt(newEmptyScopeV5(), Params(bigInt(t, "12"), NewLong(19), bigInt(t, "2"), NewLong(0), NewLong(0), &HalfEvenExpr{}))
+	assert.Error(t, err)
+}
+
+func TestBigIntConversions(t *testing.T) {
+	for _, test := range []struct {
+		value string
+		bytes []byte
+	}{
+		{"0", []byte{0x00}},
+		{"127", []byte{0x7f}},
+		{"128", []byte{0x00, 0x80}},
+		{"-1", []byte{0xff}},
+		{"-128", []byte{0x80}},
+		{"-129", []byte{0xff, 0x7f}},
+	} {
+		v := bigInt(t, test.value)
+		rs, err := NativeBigIntToBytes(newEmptyScopeV5(), Params(v))
+		require.NoError(t, err)
+		assert.Equal(t, NewBytes(test.bytes), rs, test.value)
+		rs, err = NativeBytesToBigInt(newEmptyScopeV5(), Params(NewBytes(test.bytes)))
+		require.NoError(t, err)
+		assert.True(t, v.Eq(rs), test.value)
+	}
+	rs, err := NativeBigIntToBytes(newEmptyScopeV5(), Params(NewBigInt(minBigInt)))
+	require.NoError(t, err)
+	assert.Len(t, rs.(*BytesExpr).Value, maxBigIntBytes)
+	_, err = NativeBytesToBigInt(newEmptyScopeV5(), Params(NewBytes(make([]byte, maxBigIntBytes+1))))
+	assert.Error(t, err)
+
+	rs, err = NativeToBigInt(newEmptyScopeV5(), Params(NewLong(-12345)))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "-12345").Eq(rs))
+	rs, err = NativeBigIntToLong(newEmptyScopeV5(), Params(bigInt(t, "-12345")))
+	require.NoError(t, err)
+	assert.Equal(t, NewLong(-12345), rs)
+	_, err = NativeBigIntToLong(newEmptyScopeV5(), Params(bigInt(t, "9223372036854775808")))
+	assert.Error(t, err)
+
+	rs, err = NativeParseBigInt(newEmptyScopeV5(), Params(NewString("-9223372036854775809")))
+	require.NoError(t, err)
+	assert.True(t, bigInt(t, "-9223372036854775809").Eq(rs))
+	rs, err = NativeParseBigInt(newEmptyScopeV5(), Params(NewString("12a")))
+	require.NoError(t, err)
+	assert.Equal(t, NewUnit(), rs)
+	rs, err = NativeParseBigInt(newEmptyScopeV5(), Params(NewString(new(big.Int).Add(maxBigInt, bigOne).String())))
+	require.NoError(t, err)
+	assert.Equal(t, NewUnit(), rs)
+	rs, err = NativeBigIntToString(newEmptyScopeV5(), Params(bigInt(t, "-9223372036854775809")))
+	require.NoError(t, err)
+	assert.Equal(t, NewString("-9223372036854775809"), rs)
+}
@@ -0,0 +1,293 @@
+package ast
+
+import (
+	"sort"
+
+	"github.com/pkg/errors"
+)
+
+type opcode byte
+
+const (
+	opHalt        opcode = iota // stop execution, result is on top of the stack
+	opReturn                    // return from function or lazy value evaluation
+	opConst                     // push constant [a]
+	opRef                       // push value of name [a]
+	opDeclLazy                  // declare lazy value [a] with code chunk [b] in current scope (Block)
+	opDeclRaw                   // declare raw value [a] with code chunk [b] in current scope (BlockV2 and DApp declarations)
+	opDeclFunc                  // declare user function [b] named [a] in current scope
+	opEnter                     // create child scope and make it current
+	opLeave                     // restore the scope that was current before corresponding opEnter
+	opJumpIfFalse               // pop the condition and jump to [a] if it's false
+	opJump                      // unconditional jump to [a]
+	opWrap                      // mark the beginning of arguments of function [a] or object of getter [a] if [b] is set
+	opGetter                    // pop the object and push its field named [a]
+	opFunc                      // lookup function by name [a] expecting [b] arguments, push the function on stack
+	opCall                      // pop [a] arguments and the function, call the function
+)
+
+type instruction struct {
+	op opcode
+	a  int32
+	b  int32
+}
+
+// function describes user function declared in script.
+type function struct {
+	args  []int32
+	chunk int32
+}
+
+// entry is the description of code that can be called from outside: DApp's callable function or verifier.
+type entry struct {
+	args       []int32
+	annotation int32
+	chunk      int32
+}
+
+// Program is the compiled representation of a Script. Program is executed by stack VM and produces exactly the same
+// results as the evaluation of the script's AST.
+type Program struct {
+	dApp      bool
+	code      []instruction
+	chunks    []int32 // chunk number to address of the first instruction of the chunk
+	sources   []Expr  // chunk number to expression compiled into the chunk, used in error messages
+	constants []Expr
+	names     []string // names of variables, functions and fields
+	functions []function
+	// Chunk of DApp's global declarations
+	declarations int32
+	verifier     *entry
+	callables    map[string]*entry
+}
+
+type compiler struct {
+	p     *Program
+	names map[string]int32
+	queue []pendingChunk
+}
+
+type pendingChunk struct {
+	chunk int32
+	expr  Expr
+}
+
+// Compile translates the script to the bytecode and stores the result within the script. Evaluation of compiled
+// script is done by the VM.
+func (a *Script) Compile() error {
+	p, err := compile(a)
+	if err != nil {
+		return errors.Wrap(err, "compilation failed")
+	}
+	a.program = p
+	return nil
+}
+
+// Compiled returns true if the script was successfully compiled.
+func (a *Script) Compiled() bool {
+	return a.program != nil
+}
+
+func compile(script *Script) (*Program, error) {
+	c := &compiler{
+		p:     &Program{dApp: script.IsDapp()},
+		names: make(map[string]int32),
+	}
+	if script.IsDapp() {
+		decls := c.newChunk()
+		for _, d := range script.DApp.Declarations {
+			if err := c.declaration(d); err != nil {
+				return nil, err
+			}
+		}
+		c.emit(opHalt, 0, 0)
+		c.p.declarations = decls
+		c.p.callables = make(map[string]*entry, len(script.DApp.CallableFuncs))
+		// Callables are compiled in order of their names to produce the same program for the same script
+		names := make([]string, 0, len(script.DApp.CallableFuncs))
+		for name := range script.DApp.CallableFuncs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			e, err := c.entry(script.DApp.CallableFuncs[name])
+			if err != nil {
+				return nil, errors.Wrapf(err, "callable '%s'", name)
+			}
+			c.p.callables[name] = e
+		}
+		if script.DApp.Verifier != nil {
+			e, err := c.entry(script.DApp.Verifier)
+			if err != nil {
+				return nil, errors.Wrap(err, "verifier")
+			}
+			c.p.verifier = e
+		}
+	} else {
+		if script.Verifier == nil {
+			return nil, errors.New("no verifier")
+		}
+		chunk := c.newChunk()
+		if err := c.expression(script.Verifier); err != nil {
+			return nil, err
+		}
+		c.emit(opHalt, 0, 0)
+		c.p.verifier = &entry{chunk: chunk}
+	}
+	if err := c.flush(); err != nil {
+		return nil, err
+	}
+	return c.p, nil
+}
+
+func (c *compiler) entry(f *DappCallableFunc) (*entry, error) {
+	chunk := c.newChunk()
+	if err := c.expression(f.FuncDecl.Body); err != nil {
+		return nil, err
+	}
+	c.emit(opHalt, 0, 0)
+	args := make([]int32, len(f.FuncDecl.Args))
+	for i, a := range f.FuncDecl.Args {
+		args[i] = c.name(a)
+	}
+	return &entry{args: args, annotation: c.name(f.AnnotationInvokeName), chunk: chunk}, nil
+}
+
+// flush compiles the bodies of functions and values of declarations that were postponed during compilation.
+func (c *compiler) flush() error {
+	for len(c.queue) > 0 {
+		pc := c.queue[0]
+		c.queue = c.queue[1:]
+		c.p.chunks[pc.chunk] = int32(len(c.p.code))
+		if err := c.expression(pc.expr); err != nil {
+			return err
+		}
+		c.emit(opReturn, 0, 0)
+	}
+	return nil
+}
+
+func (c *compiler) newChunk() int32 {
+	n := int32(len(c.p.chunks))
+	c.p.chunks = append(c.p.chunks, int32(len(c.p.code)))
+	c.p.sources = append(c.p.sources, nil)
+	return n
+}
+
+func (c *compiler) postpone(expr Expr) int32 {
+	n := int32(len(c.p.chunks))
+	c.p.chunks = append(c.p.chunks, -1)
+	c.p.sources = append(c.p.sources, expr)
+	c.queue = append(c.queue, pendingChunk{chunk: n, expr: expr})
+	return n
+}
+
+func (c *compiler) emit(op opcode, a, b int32) int {
+	c.p.code = append(c.p.code, instruction{op: op, a: a, b: b})
+	return len(c.p.code) - 1
+}
+
+func (c *compiler) name(n string) int32 {
+	if id, ok := c.names[n]; ok {
+		return id
+	}
+	id := int32(len(c.p.names))
+	c.p.names = append(c.p.names, n)
+	c.names[n] = id
+	return id
+}
+
+func (c *compiler) constant(e Expr) int32 {
+	id := int32(len(c.p.constants))
+	c.p.constants = append(c.p.constants, e)
+	return id
+}
+
+func (c *compiler) declaration(d Expr) error {
+	switch decl := d.(type) {
+	case *LetExpr:
+		c.emit(opDeclRaw, c.name(decl.Name), c.postpone(decl.Value))
+	case *FuncDeclaration:
+		args := make([]int32, len(decl.Args))
+		for i, a := range decl.Args {
+			args[i] = c.name(a)
+		}
+		f := int32(len(c.p.functions))
+		c.p.functions = append(c.p.functions, function{args: args, chunk: c.postpone(decl.Body)})
+		c.emit(opDeclFunc, c.name(decl.Name), f)
+	default:
+		return errors.Errorf("unsupported declaration of type %T", d)
+	}
+	return nil
+}
+
+func (c *compiler) expression(expr Expr) error {
+	switch e := expr.(type) {
+	case *LongExpr, *BooleanExpr, *StringExpr, *BytesExpr, *ArrayExpr:
+		c.emit(opConst, c.constant(e), 0)
+
+	case *RefExpr:
+		c.emit(opRef, c.name(e.Name), 0)
+
+	case *Block:
+		c.emit(opDeclLazy, c.name(e.Let.Name), c.postpone(e.Let.Value))
+		return c.scoped(e.Body)
+
+	case *BlockV2:
+		if err := c.declaration(e.Decl); err != nil {
+			return err
+		}
+		return c.scoped(e.Body)
+
+	case *IfExpr:
+		if err := c.expression(e.Condition); err != nil {
+			return err
+		}
+		jf := c.emit(opJumpIfFalse, 0, 0)
+		if err := c.scoped(e.True); err != nil {
+			return err
+		}
+		j := c.emit(opJump, 0, 0)
+		c.p.code[jf].a = int32(len(c.p.code))
+		if err := c.scoped(e.False); err != nil {
+			return err
+		}
+		c.p.code[j].a = int32(len(c.p.code))
+
+	case *GetterExpr:
+		c.emit(opWrap, c.name(e.Key), 1)
+		if err := c.expression(e.Object); err != nil {
+			return err
+		}
+		c.emit(opGetter, c.name(e.Key), 0)
+
+	case *FuncCallExpr:
+		return c.expression(e.Func)
+
+	case *FunctionCall:
+		c.emit(opFunc, c.name(e.Name), int32(e.Argc))
+		if e.Argc > 0 {
+			c.emit(opWrap, c.name(e.Name), 0)
+		}
+		for _, arg := range e.Argv {
+			if err := c.expression(arg); err != nil {
+				return err
+			}
+		}
+		c.emit(opCall, int32(e.Argc), 0)
+
+	default:
+		return errors.Errorf("unsupported expression of type %T", expr)
+	}
+	return nil
+}
+
+// scoped compiles the expression that must be evaluated in the child scope.
+func (c *compiler) scoped(expr Expr) error {
+	c.emit(opEnter, 0, 0)
+	if err := c.expression(expr); err != nil {
+		return err
+	}
+	c.emit(opLeave, 0, 0)
+	return nil
+}
@@ -11,6 +11,7 @@ import (
 	"encoding/hex"
 	"fmt"
 	"io"
+	"math/big"
 	"sort"
 	"strconv"
 	"strings"
@@ -430,6 +431,44 @@ func NativeFractionLong(s Scope, e Exprs) (Expr, error) {
 	return NewLong(res), nil
 }
 
+// NativeFractionLongRounding multiplies and divides integers with big integer intermediate representation,
+// the result is rounded with the given rounding mode.
+func NativeFractionLongRounding(s Scope, e Exprs) (Expr, error) {
+	const funcName = "NativeFractionLongRounding"
+	if l := len(e); l != 4 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 4, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	value, ok := rs[0].(*LongExpr)
+	if !ok {
+		return nil, errors.Errorf("%s first argument expected to be *LongExpr, got %T", funcName, rs[0])
+	}
+	numerator, ok := rs[1].(*LongExpr)
+	if !ok {
+		return nil, errors.Errorf("%s second argument expected to be *LongExpr, got %T", funcName, rs[1])
+	}
+	denominator, ok := rs[2].(*LongExpr)
+	if !ok {
+		return nil, errors.Errorf("%s third argument expected to be *LongExpr, got %T", funcName, rs[2])
+	}
+	round, err := roundingMode(rs[3])
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	if denominator.Value == 0 {
+		return nil, errors.Errorf("%s: zero division", funcName)
+	}
+	v := new(big.Int).Mul(big.NewInt(value.Value), big.NewInt(numerator.Value))
+	r := roundedQuo(v, big.NewInt(denominator.Value), round)
+	if !r.IsInt64() {
+		return nil, errors.Errorf("%s: result out of int64 range", funcName)
+	}
+	return NewLong(r.Int64()), nil
+}
+
 //NativePowLong calculates power.
 func NativePowLong(s Scope, e Exprs) (Expr, error) {
 	const funcName = "NativePowLong"
@@ -3021,3 +3060,219 @@ func lastIndexOf(list Exprs, element Expr) (int64, error) {
 	}
 	return 0, errors.New("not found")
 }
+
+func Lease(s Scope, e Exprs) (Expr, error) {
+	const funcName = "Lease"
+	if l := len(e); l != 3 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 3, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	recipient, err := extractRecipient(rs[0])
+	if err != nil {
+		return nil, errors.Wrapf(err, "%s: first argument", funcName)
+	}
+	amount, ok := rs[1].(*LongExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected second argument to be '*LongExpr', got '%T'", funcName, rs[1])
+	}
+	nonce, ok := rs[2].(*LongExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected third argument to be '*LongExpr', got '%T'", funcName, rs[2])
+	}
+	return NewLeaseExpr(recipient, amount.Value, nonce.Value), nil
+}
+
+func LeaseCancel(s Scope, e Exprs) (Expr, error) {
+	const funcName = "LeaseCancel"
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	id, ok := rs[0].(*BytesExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected argument to be '*BytesExpr', got '%T'", funcName, rs[0])
+	}
+	r, err := NewLeaseCancelExpr(id.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return r, nil
+}
+
+func AttachedPayment(s Scope, e Exprs) (Expr, error) {
+	const funcName = "AttachedPayment"
+	if l := len(e); l != 2 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 2, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	switch rs[0].(type) {
+	case *BytesExpr, *Unit:
+	default:
+		return nil, errors.Errorf("%s: expected first argument to be '*BytesExpr' or '*Unit', got '%T'", funcName, rs[0])
+	}
+	if _, ok := rs[1].(*LongExpr); !ok {
+		return nil, errors.Errorf("%s: expected second argument to be '*LongExpr', got '%T'", funcName, rs[1])
+	}
+	return NewAttachedPaymentExpr(rs[0], rs[1]), nil
+}
+
+func tupleConstructor(size int) Callable {
+	return func(s Scope, e Exprs) (Expr, error) {
+		if l := len(e); l != size {
+			return nil, errors.Errorf("Tuple%d: invalid number of parameters, expected %d, received %d", size, size, l)
+		}
+		rs, err := e.EvaluateAll(s)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Tuple%d", size)
+		}
+		return NewTuple(rs...), nil
+	}
+}
+
+// CalculateLeaseID returns the ID of lease that will be created by the Lease action.
+func CalculateLeaseID(s Scope, e Exprs) (Expr, error) {
+	const funcName = "CalculateLeaseID"
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	lease, ok := rs[0].(*LeaseExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: expected argument of type '*LeaseExpr', got '%T'", funcName, rs[0])
+	}
+	txID, ok := s.Value("txId")
+	if !ok {
+		return nil, errors.Errorf("%s: no txId in scope", funcName)
+	}
+	idb, ok := txID.(*BytesExpr)
+	if !ok {
+		return nil, errors.Errorf("%s: invalid type of txId: '%T'", funcName, txID)
+	}
+	d, err := crypto.NewDigestFromBytes(idb.Value)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	id, err := proto.GenerateLeaseScriptActionID(lease.Recipient, lease.Amount, lease.Nonce, d)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return NewBytes(id.Bytes()), nil
+}
+
+// IsDataStorageUntouched checks that the account has no data entries.
+func IsDataStorageUntouched(s Scope, e Exprs) (Expr, error) {
+	const funcName = "IsDataStorageUntouched"
+	if l := len(e); l != 1 {
+		return nil, errors.Errorf("%s: invalid number of parameters, expected 1, received %d", funcName, l)
+	}
+	rs, err := e.EvaluateAll(s)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	recipient, err := extractRecipient(rs[0])
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	r, err := s.State().IsStateUntouched(recipient)
+	if err != nil {
+		return nil, errors.Wrap(err, funcName)
+	}
+	return NewBoolean(r), nil
+}
+
+// invoke calls the callable function of other DApp using the Invoker of the scope.
+// The reentrant invocation allows the invoked DApp to call back the calling one.
+func invoke(reentrant bool) Callable {
+	funcName := "Invoke"
+	if reentrant {
+		funcName = "ReentrantInvoke"
+	}
+	return func(s Scope, e Exprs) (Expr, error) {
+		if l := len(e); l != 4 {
+			return nil, errors.Errorf("%s: invalid number of parameters, expected 4, received %d", funcName, l)
+		}
+		rs, err := e.EvaluateAll(s)
+		if err != nil {
+			return nil, errors.Wrap(err, funcName)
+		}
+		dApp, err := extractRecipient(rs[0])
+		if err != nil {
+			return nil, errors.Wrapf(err, "%s: first argument", funcName)
+		}
+		call := proto.FunctionCall{}
+		switch name := rs[1].(type) {
+		case *StringExpr:
+			call.Name = name.Value
+		case *Unit:
+			call.Default = true
+		default:
+			return nil, errors.Errorf("%s: expected second argument to be '*StringExpr' or '*Unit', got '%T'", funcName, rs[1])
+		}
+		args, ok := rs[2].(Exprs)
+		if !ok {
+			return nil, errors.Errorf("%s: expected third argument to be 'Exprs', got '%T'", funcName, rs[2])
+		}
+		call.Arguments = make(proto.Arguments, len(args))
+		for i, a := range args {
+			call.Arguments[i], err = argExprToProtoArg(a)
+			if err != nil {
+				return nil, errors.Wrap(err, funcName)
+			}
+		}
+		ps, ok := rs[3].(Exprs)
+		if !ok {
+			return nil, errors.Errorf("%s: expected fourth argument to be 'Exprs', got '%T'", funcName, rs[3])
+		}
+		payments := make(proto.ScriptPayments, len(ps))
+		for i, p := range ps {
+			payments[i], err = attachedPaymentToScriptPayment(p)
+			if err != nil {
+				return nil, errors.Wrap(err, funcName)
+			}
+		}
+		invoker := s.Invoker()
+		if invoker == nil {
+			return nil, errors.Errorf("%s: invocation of DApps is not available", funcName)
+		}
+		return invoker.Invoke(dApp, call, payments, reentrant)
+	}
+}
+
+func attachedPaymentToScriptPayment(e Expr) (proto.ScriptPayment, error) {
+	p, ok := e.(*AttachedPaymentExpr)
+	if !ok {
+		return proto.ScriptPayment{}, errors.Errorf("expected payment to be '*AttachedPaymentExpr', got '%T'", e)
+	}
+	amount, ok := p.fields["amount"].(*LongExpr)
+	if !ok {
+		return proto.ScriptPayment{}, errors.Errorf("invalid type of payment amount '%T'", p.fields["amount"])
+	}
+	if amount.Value < 0 {
+		return proto.ScriptPayment{}, errors.New("negative payment amount")
+	}
+	var asset proto.OptionalAsset
+	switch id := p.fields["assetId"].(type) {
+	case *BytesExpr:
+		d, err := crypto.NewDigestFromBytes(id.Value)
+		if err != nil {
+			return proto.ScriptPayment{}, errors.Wrap(err, "invalid payment asset")
+		}
+		asset = *proto.NewOptionalAssetFromDigest(d)
+	case *Unit:
+	default:
+		return proto.ScriptPayment{}, errors.Errorf("invalid type of payment asset '%T'", id)
+	}
+	return proto.ScriptPayment{Amount: uint64(amount.Value), Asset: asset}, nil
+}
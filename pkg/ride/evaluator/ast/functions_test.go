@@ -1981,3 +1981,148 @@ func TestLastIndexOf(t *testing.T) {
 		}
 	}
 }
+
+func TestNativeFractionLongRounding(t *testing.T) {
+	rs, err := NativeFractionLongRounding(newEmptyScopeV5(), Params(NewLong(math.MaxInt64), NewLong(4), NewLong(6), &HalfUpExpr{}))
+	require.NoError(t, err)
+	assert.Equal(t, NewLong(6148914691236517205), rs)
+	rs, err = NativeFractionLongRounding(newEmptyScopeV5(), Params(NewLong(-5), NewLong(1), NewLong(2), &DownExpr{}))
+	require.NoError(t, err)
+	assert.Equal(t, NewLong(-2), rs)
+	rs, err = NativeFractionLongRounding(newEmptyScopeV5(), Params(NewLong(-5), NewLong(1), NewLong(2), &FloorExpr{}))
+	require.NoError(t, err)
+	assert.Equal(t, NewLong(-3), rs)
+	_, err = NativeFractionLongRounding(newEmptyScopeV5(), Params(NewLong(math.MaxInt64), NewLong(4), NewLong(1), &HalfUpExpr{}))
+	assert.Error(t, err)
+	_, err = NativeFractionLongRounding(newEmptyScopeV5(), Params(NewLong(1), NewLong(4), NewLong(0), &HalfUpExpr{}))
+	assert.Error(t, err)
+}
+
+func TestLeaseConstructors(t *testing.T) {
+	addr, err := proto.NewAddressFromString("3P2USE3iYK5w7jNahAUHTytNbVRccGZwQH3")
+	require.NoError(t, err)
+	txID, err := crypto.NewDigestFromBase58("2K2XASvPkwdePyWaKDKpKT1X7u2uzu6FJASJ34nuTdEi")
+	require.NoError(t, err)
+	s := newEmptyScopeV5()
+	s.AddValue("txId", NewBytes(txID.Bytes()))
+
+	rs, err := Lease(s, Params(NewAddressFromProtoAddress(addr), NewLong(100), NewLong(1)))
+	require.NoError(t, err)
+	lease, ok := rs.(*LeaseExpr)
+	require.True(t, ok)
+	amount, err := lease.Get("amount")
+	require.NoError(t, err)
+	assert.Equal(t, NewLong(100), amount)
+
+	id, err := CalculateLeaseID(s, Params(lease))
+	require.NoError(t, err)
+	action, err := lease.ToAction(&txID)
+	require.NoError(t, err)
+	la, ok := action.(*proto.LeaseScriptAction)
+	require.True(t, ok)
+	assert.Equal(t, NewBytes(la.ID.Bytes()), id)
+	assert.Equal(t, int64(100), la.Amount)
+
+	other, err := Lease(s, Params(NewAddressFromProtoAddress(addr), NewLong(100), NewLong(2)))
+	require.NoError(t, err)
+	otherID, err := CalculateLeaseID(s, Params(other))
+	require.NoError(t, err)
+	assert.False(t, id.Eq(otherID))
+
+	rs, err = LeaseCancel(s, Params(id))
+	require.NoError(t, err)
+	action, err = rs.(*LeaseCancelExpr).ToAction(&txID)
+	require.NoError(t, err)
+	assert.Equal(t, &proto.LeaseCancelScriptAction{LeaseID: la.ID}, action)
+	_, err = LeaseCancel(s, Params(NewBytes([]byte{1, 2, 3})))
+	assert.Error(t, err)
+}
+
+func TestTuples(t *testing.T) {
+	s := newEmptyScopeV5()
+	rs, err := tupleConstructor(3)(s, Params(NewLong(1), NewString("a"), NewBoolean(true)))
+	require.NoError(t, err)
+	tuple, ok := rs.(*TupleExpr)
+	require.True(t, ok)
+	assert.Equal(t, "Tuple3", tuple.InstanceOf())
+	e, err := tuple.Get("_2")
+	require.NoError(t, err)
+	assert.Equal(t, NewString("a"), e)
+	_, err = tuple.Get("_4")
+	assert.Error(t, err)
+	_, err = tuple.Get("_0")
+	assert.Error(t, err)
+	assert.True(t, tuple.Eq(NewTuple(NewLong(1), NewString("a"), NewBoolean(true))))
+	assert.False(t, tuple.Eq(NewTuple(NewLong(1), NewString("a"))))
+	_, err = tupleConstructor(3)(s, Params(NewLong(1)))
+	assert.Error(t, err)
+}
+
+type testInvoker struct {
+	dApp      proto.Recipient
+	call      proto.FunctionCall
+	payments  proto.ScriptPayments
+	reentrant bool
+}
+
+func (i *testInvoker) Invoke(dApp proto.Recipient, call proto.FunctionCall, payments proto.ScriptPayments, reentrant bool) (Expr, error) {
+	i.dApp, i.call, i.payments, i.reentrant = dApp, call, payments, reentrant
+	return NewLong(42), nil
+}
+
+func TestInvoke(t *testing.T) {
+	addr, err := proto.NewAddressFromString("3P2USE3iYK5w7jNahAUHTytNbVRccGZwQH3")
+	require.NoError(t, err)
+	asset, err := crypto.NewDigestFromBase58("2K2XASvPkwdePyWaKDKpKT1X7u2uzu6FJASJ34nuTdEi")
+	require.NoError(t, err)
+	args := Params(Params(NewLong(1), Params(NewString("a"), NewString("b"))))
+	payments := Params(
+		NewAttachedPaymentExpr(NewUnit(), NewLong(10)),
+		NewAttachedPaymentExpr(NewBytes(asset.Bytes()), NewLong(20)),
+	)
+
+	s := newEmptyScopeV5()
+	_, err = invoke(false)(s, Params(NewAddressFromProtoAddress(addr), NewString("call"), args, payments))
+	assert.Error(t, err)
+
+	invoker := &testInvoker{}
+	s.(*ScopeImpl).SetInvoker(invoker)
+	rs, err := invoke(true)(s.Clone(), Params(NewAddressFromProtoAddress(addr), NewString("call"), args, payments))
+	require.NoError(t, err)
+	assert.Equal(t, NewLong(42), rs)
+	assert.True(t, invoker.reentrant)
+	assert.Equal(t, proto.NewRecipientFromAddress(addr), invoker.dApp)
+	expectedCall := proto.FunctionCall{
+		Name: "call",
+		Arguments: proto.Arguments{proto.NewArrayArgument(proto.Arguments{
+			proto.NewIntegerArgument(1),
+			proto.NewArrayArgument(proto.Arguments{proto.NewStringArgument("a"), proto.NewStringArgument("b")}),
+		})},
+	}
+	assert.Equal(t, expectedCall, invoker.call)
+	expectedPayments := proto.ScriptPayments{
+		{Amount: 10, Asset: proto.OptionalAsset{}},
+		{Amount: 20, Asset: *proto.NewOptionalAssetFromDigest(asset)},
+	}
+	assert.Equal(t, expectedPayments, invoker.payments)
+
+	_, err = invoke(false)(s, Params(NewAddressFromProtoAddress(addr), NewUnit(), Params(), Params()))
+	require.NoError(t, err)
+	assert.True(t, invoker.call.Default)
+	assert.False(t, invoker.reentrant)
+
+	_, err = invoke(false)(s, Params(NewAddressFromProtoAddress(addr), NewUnit(), Params(), Params(NewAttachedPaymentExpr(NewUnit(), NewLong(-1)))))
+	assert.Error(t, err)
+}
+
+func TestIsDataStorageUntouched(t *testing.T) {
+	addr, err := proto.NewAddressFromString("3P2USE3iYK5w7jNahAUHTytNbVRccGZwQH3")
+	require.NoError(t, err)
+	rs, err := IsDataStorageUntouched(newEmptyScopeV5(), Params(NewAddressFromProtoAddress(addr)))
+	require.NoError(t, err)
+	assert.Equal(t, NewBoolean(true), rs)
+	s := NewScope(5, proto.MainNetScheme, mockstate.State{DataEntries: map[string]proto.DataEntry{"key": &proto.IntegerDataEntry{Key: "key", Value: 1}}})
+	rs, err = IsDataStorageUntouched(s, Params(NewAddressFromProtoAddress(addr)))
+	require.NoError(t, err)
+	assert.Equal(t, NewBoolean(false), rs)
+}
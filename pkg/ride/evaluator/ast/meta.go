@@ -0,0 +1,249 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"google.golang.org/protobuf/encoding/protowire"
+)
+
+// ArgType is a set of types allowed for an argument of DApp's callable function.
+// Types are encoded as bit flags in DApp's meta, the union of types is a combination of flags.
+type ArgType byte
+
+const (
+	ArgInt ArgType = 1 << iota
+	ArgBytes
+	ArgBoolean
+	ArgString
+	// ArgList is set for list arguments, other flags describe the types of list's elements
+	ArgList
+)
+
+var argTypeNames = []struct {
+	t    ArgType
+	name string
+}{
+	{ArgInt, "Int"},
+	{ArgBytes, "ByteVector"},
+	{ArgBoolean, "Boolean"},
+	{ArgString, "String"},
+}
+
+func (t ArgType) String() string {
+	names := make([]string, 0, len(argTypeNames))
+	for _, n := range argTypeNames {
+		if t&n.t != 0 {
+			names = append(names, n.name)
+		}
+	}
+	s := strings.Join(names, "|")
+	if t&ArgList != 0 {
+		return "List[" + s + "]"
+	}
+	return s
+}
+
+// accepts checks that the invocation argument has one of the types.
+func (t ArgType) accepts(arg proto.Argument) bool {
+	switch a := arg.(type) {
+	case *proto.IntegerArgument:
+		return t&ArgList == 0 && t&ArgInt != 0
+	case *proto.BinaryArgument:
+		return t&ArgList == 0 && t&ArgBytes != 0
+	case *proto.BooleanArgument:
+		return t&ArgList == 0 && t&ArgBoolean != 0
+	case *proto.StringArgument:
+		return t&ArgList == 0 && t&ArgString != 0
+	case *proto.ArrayArgument:
+		if t&ArgList == 0 {
+			return false
+		}
+		for _, item := range a.Items {
+			if !(t &^ ArgList).accepts(item) {
+				return false
+			}
+		}
+		return true
+	default:
+		return false
+	}
+}
+
+func argumentTypeName(arg proto.Argument) string {
+	switch a := arg.(type) {
+	case *proto.IntegerArgument:
+		return ArgInt.String()
+	case *proto.BinaryArgument:
+		return ArgBytes.String()
+	case *proto.BooleanArgument:
+		return ArgBoolean.String()
+	case *proto.StringArgument:
+		return ArgString.String()
+	case *proto.ArrayArgument:
+		names := make([]string, len(a.Items))
+		for i, item := range a.Items {
+			names[i] = argumentTypeName(item)
+		}
+		return "List[" + strings.Join(names, ", ") + "]"
+	default:
+		return arg.GetValueType().String()
+	}
+}
+
+type CallableArgument struct {
+	Name string
+	Type ArgType
+}
+
+// CallableSignature describes the name and arguments of DApp's callable function.
+type CallableSignature struct {
+	Name      string
+	Arguments []CallableArgument
+}
+
+// Meta is the decoded DApp's meta information.
+type Meta struct {
+	Version   int32
+	Functions []CallableSignature
+}
+
+// Meta decodes the protobuf encoded meta of DApp and combines it with the names of callable functions and their
+// arguments. Empty meta is returned for scripts without meta.
+func (a *Script) Meta() (Meta, error) {
+	if !a.IsDapp() {
+		return Meta{}, errors.New("not a DApp")
+	}
+	if len(a.DApp.Meta.Bytes) == 0 {
+		return Meta{}, nil
+	}
+	version, types, err := decodeMeta(a.DApp.Meta.Bytes)
+	if err != nil {
+		return Meta{}, errors.Wrap(err, "failed to decode DApp meta")
+	}
+	if len(types) != len(a.DApp.callablesOrder) {
+		return Meta{}, errors.Errorf("invalid DApp meta: %d signatures for %d callable functions", len(types), len(a.DApp.callablesOrder))
+	}
+	m := Meta{Version: version, Functions: make([]CallableSignature, len(types))}
+	for i, name := range a.DApp.callablesOrder {
+		args := a.DApp.CallableFuncs[name].FuncDecl.Args
+		if len(types[i]) != len(args) {
+			return Meta{}, errors.Errorf("invalid DApp meta: %d argument types for %d arguments of function '%s'", len(types[i]), len(args), name)
+		}
+		sig := CallableSignature{Name: name, Arguments: make([]CallableArgument, len(args))}
+		for j, arg := range args {
+			sig.Arguments[j] = CallableArgument{Name: arg, Type: ArgType(types[i][j])}
+		}
+		m.Functions[i] = sig
+	}
+	return m, nil
+}
+
+// decodeMeta parses DAppMeta protobuf message, returns meta version and the types of arguments of callable functions.
+//
+//	message DAppMeta {
+//	  int32 version = 1;
+//	  repeated CallableFuncSignature funcs = 2;
+//	  message CallableFuncSignature {
+//	    bytes types = 1;
+//	  }
+//	}
+func decodeMeta(b []byte) (int32, [][]byte, error) {
+	var version int32
+	var types [][]byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return 0, nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		switch {
+		case num == 1 && typ == protowire.VarintType:
+			v, n := protowire.ConsumeVarint(b)
+			if n < 0 {
+				return 0, nil, protowire.ParseError(n)
+			}
+			version = int32(v)
+			b = b[n:]
+		case num == 2 && typ == protowire.BytesType:
+			f, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return 0, nil, protowire.ParseError(n)
+			}
+			t, err := decodeSignature(f)
+			if err != nil {
+				return 0, nil, err
+			}
+			types = append(types, t)
+			b = b[n:]
+		default:
+			n := protowire.ConsumeFieldValue(num, typ, b)
+			if n < 0 {
+				return 0, nil, protowire.ParseError(n)
+			}
+			b = b[n:]
+		}
+	}
+	return version, types, nil
+}
+
+func decodeSignature(b []byte) ([]byte, error) {
+	var types []byte
+	for len(b) > 0 {
+		num, typ, n := protowire.ConsumeTag(b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+		if num == 1 && typ == protowire.BytesType {
+			v, n := protowire.ConsumeBytes(b)
+			if n < 0 {
+				return nil, protowire.ParseError(n)
+			}
+			types = append(types, v...)
+			b = b[n:]
+			continue
+		}
+		n = protowire.ConsumeFieldValue(num, typ, b)
+		if n < 0 {
+			return nil, protowire.ParseError(n)
+		}
+		b = b[n:]
+	}
+	return types, nil
+}
+
+// CheckInvocation checks that the invoked function exists and the invocation's arguments match the function's
+// signature. If the DApp has no meta only the number of arguments is checked.
+func (a *Script) CheckInvocation(call proto.FunctionCall) error {
+	if !a.IsDapp() {
+		return errors.New("invoked script is not a DApp")
+	}
+	name := call.Name
+	if name == "" && call.Default {
+		name = "default"
+	}
+	fn, ok := a.DApp.CallableFuncs[name]
+	if !ok {
+		return errors.Errorf("callable function '%s' not found", name)
+	}
+	if len(fn.FuncDecl.Args) != len(call.Arguments) {
+		return errors.Errorf("invalid number of arguments of function '%s', expected %d, got %d", name, len(fn.FuncDecl.Args), len(call.Arguments))
+	}
+	meta, err := a.Meta()
+	if err != nil {
+		return err
+	}
+	for _, sig := range meta.Functions {
+		if sig.Name != name {
+			continue
+		}
+		for i, arg := range sig.Arguments {
+			if !arg.Type.accepts(call.Arguments[i]) {
+				return errors.Errorf("invalid type of argument '%s' of function '%s', expected %s, got %s", arg.Name, name, arg.Type, argumentTypeName(call.Arguments[i]))
+			}
+		}
+	}
+	return nil
+}
@@ -34,7 +34,7 @@ func BuildScript(r *BytesReader) (*Script, error) {
 		}, nil
 	}
 
-	if version < 1 || version > 4 {
+	if version < 1 || version > 5 {
 		return nil, errors.Errorf("parser: unsupported script version %d", version)
 	}
 	exp, err := f.walk(r)
@@ -57,6 +57,8 @@ type DApp struct {
 	Declarations  Exprs
 	CallableFuncs map[string]*DappCallableFunc
 	Verifier      *DappCallableFunc
+	// Names of callable functions in order of declaration, the order is used to match functions' signatures from meta
+	callablesOrder []string
 }
 
 type DappMeta struct {
@@ -68,6 +70,9 @@ func (f *flags) parseDApp(r *BytesReader) (DApp, error) {
 	dApp := DApp{}
 	dApp.DAppVersion = r.Next()
 	dApp.LibVersion = r.Next()
+	if dApp.LibVersion > 5 {
+		return dApp, errors.Errorf("parser: unsupported DApp library version %d", dApp.LibVersion)
+	}
 	// meta
 	meta := DappMeta{
 		Version: r.ReadInt(),
@@ -105,6 +110,7 @@ func (f *flags) parseDApp(r *BytesReader) (DApp, error) {
 			AnnotationInvokeName: annotationInvokeName,
 			FuncDecl:             f,
 		}
+		dApp.callablesOrder = append(dApp.callablesOrder, f.Name)
 	}
 	dApp.CallableFuncs = callableFuncs
 
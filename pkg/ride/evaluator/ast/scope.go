@@ -24,11 +24,20 @@ type Scope interface {
 	SetTransaction(transaction map[string]Expr)
 	SetHeight(height uint64)
 	SetThis(this Expr)
+	Invoker() Invoker
+	SetInvoker(invoker Invoker)
 	evaluation(string) (evaluation, bool)
 	setEvaluation(string, evaluation)
 	validMessageLength(len int) bool
 }
 
+// Invoker performs synchronous invocations of other DApps from the script, starting with RIDE V5.
+// The implementation is provided by the caller of the script, it applies the actions of the invoked function
+// immediately so the rest of the calling script sees the updated state.
+type Invoker interface {
+	Invoke(dApp proto.Recipient, call proto.FunctionCall, payments proto.ScriptPayments, reentrant bool) (Expr, error)
+}
+
 type Functions map[string]Expr
 
 type ScopeImpl struct {
@@ -38,6 +47,7 @@ type ScopeImpl struct {
 	scheme           byte
 	evaluations      map[string]evaluation
 	msgLenValidation func(int) bool
+	invoker          Invoker
 }
 
 func newScopeImpl(scheme byte, state types.SmartState, v func(int) bool) *ScopeImpl {
@@ -82,6 +92,9 @@ func NewScope(version int, scheme byte, state types.SmartState) *ScopeImpl {
 	case 3:
 		e = expressionsV3()
 		return out.withExprs(e)
+	case 5:
+		e = expressionsV5()
+		return out.withExprs(e)
 	default:
 		e = expressionsV4()
 		return out.withExprs(e)
@@ -94,6 +107,7 @@ func (a *ScopeImpl) Clone() Scope {
 		state:            a.state,
 		scheme:           a.scheme,
 		msgLenValidation: a.msgLenValidation,
+		invoker:          a.invoker,
 	}
 }
 
@@ -148,6 +162,14 @@ func (a *ScopeImpl) SetThis(this Expr) {
 	a.expressions["this"] = this
 }
 
+func (a *ScopeImpl) Invoker() Invoker {
+	return a.invoker
+}
+
+func (a *ScopeImpl) SetInvoker(invoker Invoker) {
+	a.invoker = invoker
+}
+
 func (a *ScopeImpl) SetLastBlockInfo(lastBlock Expr) {
 	a.expressions["lastBlock"] = lastBlock
 }
@@ -393,6 +415,46 @@ func functionsV4() map[string]Expr {
 	return s
 }
 
+func functionsV5() map[string]Expr {
+	s := functionsV4()
+	// New constructors
+	s["Lease"] = FunctionFromPredefined(Lease, 3)
+	s["LeaseCancel"] = FunctionFromPredefined(LeaseCancel, 1)
+	s["AttachedPayment"] = FunctionFromPredefined(AttachedPayment, 2)
+	for i := 2; i <= maxTupleSize; i++ {
+		s["Tuple"+strconv.Itoa(i)] = FunctionFromPredefined(tupleConstructor(i), uint32(i))
+	}
+
+	// New functions
+	s["1020"] = FunctionFromPredefined(invoke(false), 4)
+	s["1021"] = FunctionFromPredefined(invoke(true), 4)
+	s["1081"] = FunctionFromPredefined(CalculateLeaseID, 1)
+	s["isDataStorageUntouched"] = FunctionFromPredefined(IsDataStorageUntouched, 1)
+	s["110"] = FunctionFromPredefined(NativeFractionLongRounding, 4)
+
+	// BigInt functions
+	s["310"] = FunctionFromPredefined(NativeToBigInt, 1)
+	s["311"] = FunctionFromPredefined(NativeSumBigInt, 2)
+	s["312"] = FunctionFromPredefined(NativeSubBigInt, 2)
+	s["313"] = FunctionFromPredefined(NativeMulBigInt, 2)
+	s["314"] = FunctionFromPredefined(NativeDivBigInt, 2)
+	s["315"] = FunctionFromPredefined(NativeModBigInt, 2)
+	s["316"] = FunctionFromPredefined(NativeFractionBigInt, 3)
+	s["317"] = FunctionFromPredefined(NativeFractionBigIntRounding, 4)
+	s["318"] = FunctionFromPredefined(NativeUnaryMinusBigInt, 1)
+	s["319"] = FunctionFromPredefined(NativeGtBigInt, 2)
+	s["320"] = FunctionFromPredefined(NativeGeBigInt, 2)
+	s["118"] = FunctionFromPredefined(NativePowBigInt, 6)
+	s["119"] = FunctionFromPredefined(NativeLogBigInt, 6)
+	s["410"] = FunctionFromPredefined(NativeBigIntToLong, 1)
+	s["411"] = FunctionFromPredefined(NativeBigIntToBytes, 1)
+	s["412"] = FunctionFromPredefined(NativeBytesToBigInt, 1)
+	s["420"] = FunctionFromPredefined(NativeParseBigInt, 1)
+	s["421"] = FunctionFromPredefined(wrapWithExtract(NativeParseBigInt, "ParseBigIntValue"), 1)
+	s["422"] = FunctionFromPredefined(NativeBigIntToString, 1)
+	return s
+}
+
 func VariablesV1() map[string]Expr {
 	return map[string]Expr{"tx": NewUnit(), "unit": NewUnit()}
 }
@@ -437,6 +499,10 @@ func VariablesV4() map[string]Expr {
 	return VariablesV3()
 }
 
+func VariablesV5() map[string]Expr {
+	return VariablesV4()
+}
+
 func merge(x map[string]Expr, y map[string]Expr) map[string]Expr {
 	out := make(map[string]Expr)
 	for k, v := range x {
@@ -463,3 +529,7 @@ func expressionsV3() map[string]Expr {
 func expressionsV4() map[string]Expr {
 	return merge(VariablesV4(), functionsV4())
 }
+
+func expressionsV5() map[string]Expr {
+	return merge(VariablesV5(), functionsV5())
+}
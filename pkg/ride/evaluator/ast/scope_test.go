@@ -21,6 +21,10 @@ func newEmptyScopeV4() Scope {
 	return NewScope(4, proto.MainNetScheme, mockstate.State{})
 }
 
+func newEmptyScopeV5() Scope {
+	return NewScope(5, proto.MainNetScheme, mockstate.State{})
+}
+
 func newScopeWithState(s types.SmartState) Scope {
 	return NewScope(3, proto.MainNetScheme, s)
 }
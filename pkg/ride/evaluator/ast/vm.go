@@ -0,0 +1,380 @@
+package ast
+
+import (
+	"github.com/pkg/errors"
+)
+
+type bindingKind byte
+
+const (
+	bindValue bindingKind = iota // evaluated value
+	bindLazy                     // value that is evaluated in the scope of declaration
+	bindRaw                      // value that is evaluated in the scope of reference
+	bindFunc                     // user function
+)
+
+type binding struct {
+	name  int32
+	kind  bindingKind
+	value Expr
+	chunk int32
+	scope *vmScope // scope of declaration for lazy values and closure scope for functions
+	fn    *function
+}
+
+type memo struct {
+	name  int32
+	value Expr
+}
+
+// vmScope reproduces the behaviour of ScopeImpl, but names are resolved by identifiers instead of strings.
+type vmScope struct {
+	parent   *vmScope
+	bindings []binding
+	memos    []memo
+}
+
+func (s *vmScope) add(b binding) {
+	for i := range s.bindings {
+		if s.bindings[i].name == b.name {
+			s.bindings[i] = b
+			return
+		}
+	}
+	s.bindings = append(s.bindings, b)
+}
+
+func (s *vmScope) lookup(name int32) *binding {
+	for c := s; c != nil; c = c.parent {
+		for i := range c.bindings {
+			if c.bindings[i].name == name {
+				return &c.bindings[i]
+			}
+		}
+	}
+	return nil
+}
+
+func (s *vmScope) memo(name int32) (Expr, bool) {
+	for i := range s.memos {
+		if s.memos[i].name == name {
+			return s.memos[i].value, true
+		}
+	}
+	return nil, false
+}
+
+func (s *vmScope) setMemo(name int32, value Expr) {
+	for i := range s.memos {
+		if s.memos[i].name == name {
+			s.memos[i].value = value
+			return
+		}
+	}
+	s.memos = append(s.memos, memo{name: name, value: value})
+}
+
+// callee is a function prepared for the call.
+type callee struct {
+	fn     binding
+	native Callable
+}
+
+// context of arguments or getter's object evaluation, errors that occur within the context are wrapped like in AST.
+type context struct {
+	name   int32
+	getter bool
+}
+
+type frame struct {
+	pc     int
+	scope  *vmScope
+	memo   *vmScope // scope to memorize the result of value evaluation in, nil for function calls
+	name   int32
+	scopes int
+}
+
+type vm struct {
+	p       *Program
+	base    Scope    // scope with predefined functions and variables
+	globals []Expr   // cache of variables resolved in base scope
+	stack   []Expr   // values stack
+	callees []callee // functions prepared for call
+	frames  []frame  // call stack
+	scopes  []*vmScope
+	wraps   []context
+}
+
+func newVM(p *Program, base Scope) *vm {
+	return &vm{
+		p:       p,
+		base:    base,
+		globals: make([]Expr, len(p.names)),
+		stack:   make([]Expr, 0, 16),
+	}
+}
+
+// fail wraps the error with all active contexts, so the resulting error is the same as produced by AST evaluation.
+func (m *vm) fail(err error) error {
+	for i := len(m.wraps) - 1; i >= 0; i-- {
+		c := m.wraps[i]
+		if c.getter {
+			err = errors.Wrapf(err, "GetterExpr Evaluate by key %s", m.p.names[c.name])
+		} else {
+			err = errors.Wrapf(err, "evaluate user function: %s", m.p.names[c.name])
+		}
+	}
+	return err
+}
+
+func (m *vm) push(e Expr) {
+	m.stack = append(m.stack, e)
+}
+
+func (m *vm) pop() Expr {
+	e := m.stack[len(m.stack)-1]
+	m.stack = m.stack[:len(m.stack)-1]
+	return e
+}
+
+func (m *vm) global(name int32) (Expr, error) {
+	if g := m.globals[name]; g != nil {
+		return g, nil
+	}
+	e, ok := m.base.Value(m.p.names[name])
+	if !ok {
+		return nil, errors.Errorf("RefExpr evaluate: not found expr by name '%s'", m.p.names[name])
+	}
+	rs, err := e.Evaluate(m.base)
+	if err != nil {
+		return nil, err
+	}
+	m.globals[name] = rs
+	return rs, nil
+}
+
+func (m *vm) callee(name int32, argc int) (callee, error) {
+	b := m.scopes[len(m.scopes)-1].lookup(name)
+	if b == nil {
+		e, ok := m.base.Value(m.p.names[name])
+		if !ok {
+			return callee{}, errors.Errorf("evaluate user function: function named '%s' not found in scope", m.p.names[name])
+		}
+		fn, ok := e.(*Function)
+		if !ok {
+			return callee{}, errors.Errorf("evaluate user function: expected value 'fn' to be *Function, found %T", e)
+		}
+		if fn.Argc != argc {
+			return callee{}, errors.Errorf("evaluate user function: function %s expects %d arguments, passed %d", m.p.names[name], fn.Argc, argc)
+		}
+		pf, ok := fn.Body.(*PredefFunction)
+		if !ok {
+			return callee{}, errors.Errorf("evaluate user function: unexpected body of function '%s'", m.p.names[name])
+		}
+		return callee{native: pf.fn}, nil
+	}
+	switch b.kind {
+	case bindValue:
+		return callee{}, errors.Errorf("evaluate user function: expected value 'fn' to be *Function, found %T", b.value)
+	case bindLazy:
+		return callee{}, errors.Errorf("evaluate user function: expected value 'fn' to be *Function, found %T", &LazyValueExpr{})
+	case bindRaw:
+		return callee{}, errors.Errorf("evaluate user function: expected value 'fn' to be *Function, found %T", m.p.sources[b.chunk])
+	}
+	if len(b.fn.args) != argc {
+		return callee{}, errors.Errorf("evaluate user function: function %s expects %d arguments, passed %d", m.p.names[name], len(b.fn.args), argc)
+	}
+	return callee{fn: *b}, nil
+}
+
+// run executes the code chunk in the given scope until the halt instruction.
+func (m *vm) run(chunk int32, scope *vmScope) (Expr, error) {
+	m.scopes = append(m.scopes[:0], scope)
+	m.stack = m.stack[:0]
+	pc := int(m.p.chunks[chunk])
+	for {
+		in := m.p.code[pc]
+		pc++
+		switch in.op {
+		case opHalt:
+			if len(m.stack) == 0 {
+				return nil, nil // chunk of declarations produces no value
+			}
+			return m.pop(), nil
+
+		case opReturn:
+			f := m.frames[len(m.frames)-1]
+			m.frames = m.frames[:len(m.frames)-1]
+			m.scopes = m.scopes[:f.scopes]
+			m.scopes[len(m.scopes)-1] = f.scope
+			if f.memo != nil {
+				f.memo.setMemo(f.name, m.stack[len(m.stack)-1])
+			}
+			pc = f.pc
+
+		case opConst:
+			m.push(m.p.constants[in.a])
+
+		case opRef:
+			s := m.scopes[len(m.scopes)-1]
+			if v, ok := s.memo(in.a); ok {
+				m.push(v)
+				continue
+			}
+			b := s.lookup(in.a)
+			if b == nil {
+				g, err := m.global(in.a)
+				if err != nil {
+					return nil, m.fail(err)
+				}
+				s.setMemo(in.a, g)
+				m.push(g)
+				continue
+			}
+			switch b.kind {
+			case bindValue:
+				s.setMemo(in.a, b.value)
+				m.push(b.value)
+				continue
+			case bindLazy:
+				m.frames = append(m.frames, frame{pc: pc, scope: s, memo: s, name: in.a, scopes: len(m.scopes)})
+				m.scopes[len(m.scopes)-1] = b.scope
+			case bindRaw:
+				m.frames = append(m.frames, frame{pc: pc, scope: s, memo: s, name: in.a, scopes: len(m.scopes)})
+			case bindFunc:
+				// Reference to a function evaluates function's body in current scope, exactly like AST does
+				m.frames = append(m.frames, frame{pc: pc, scope: s, memo: s, name: in.a, scopes: len(m.scopes)})
+			}
+			pc = int(m.p.chunks[b.chunk])
+
+		case opDeclLazy:
+			s := m.scopes[len(m.scopes)-1]
+			s.add(binding{name: in.a, kind: bindLazy, chunk: in.b, scope: s})
+
+		case opDeclRaw:
+			s := m.scopes[len(m.scopes)-1]
+			s.add(binding{name: in.a, kind: bindRaw, chunk: in.b})
+
+		case opDeclFunc:
+			s := m.scopes[len(m.scopes)-1]
+			fn := &m.p.functions[in.b]
+			s.add(binding{name: in.a, kind: bindFunc, chunk: fn.chunk, scope: s, fn: fn})
+
+		case opEnter:
+			m.scopes = append(m.scopes, &vmScope{parent: m.scopes[len(m.scopes)-1]})
+
+		case opLeave:
+			m.scopes = m.scopes[:len(m.scopes)-1]
+
+		case opJumpIfFalse:
+			c := m.pop()
+			b, ok := c.(*BooleanExpr)
+			if !ok {
+				return nil, m.fail(errors.Errorf("IfExpr evaluate: expected bool in condition found %T", c))
+			}
+			if !b.Value {
+				pc = int(in.a)
+			}
+
+		case opJump:
+			pc = int(in.a)
+
+		case opWrap:
+			m.wraps = append(m.wraps, context{name: in.a, getter: in.b != 0})
+
+		case opGetter:
+			m.wraps = m.wraps[:len(m.wraps)-1]
+			o := m.pop()
+			switch obj := o.(type) {
+			case Getable:
+				e, err := obj.Get(m.p.names[in.a])
+				if err != nil {
+					return nil, m.fail(err)
+				}
+				m.push(e)
+			case *Unit:
+				m.push(NewUnit())
+			default:
+				return nil, m.fail(errors.Errorf("GetterExpr Evaluate: expected value be Getable, got %T", o))
+			}
+
+		case opFunc:
+			c, err := m.callee(in.a, int(in.b))
+			if err != nil {
+				return nil, m.fail(err)
+			}
+			m.callees = append(m.callees, c)
+
+		case opCall:
+			argc := int(in.a)
+			if argc > 0 {
+				m.wraps = m.wraps[:len(m.wraps)-1]
+			}
+			c := m.callees[len(m.callees)-1]
+			m.callees = m.callees[:len(m.callees)-1]
+			args := m.stack[len(m.stack)-argc:]
+			if c.native != nil {
+				params := make(Exprs, argc)
+				copy(params, args)
+				m.stack = m.stack[:len(m.stack)-argc]
+				rs, err := c.native(m.base, params)
+				if err != nil {
+					return nil, m.fail(err)
+				}
+				m.push(rs)
+				continue
+			}
+			fs := &vmScope{parent: c.fn.scope, bindings: make([]binding, 0, argc), memos: make([]memo, 0, argc)}
+			for i, a := range c.fn.fn.args {
+				fs.add(binding{name: a, kind: bindValue, value: args[i]})
+				fs.setMemo(a, args[i])
+			}
+			m.stack = m.stack[:len(m.stack)-argc]
+			m.frames = append(m.frames, frame{pc: pc, scope: m.scopes[len(m.scopes)-1], scopes: len(m.scopes)})
+			m.scopes[len(m.scopes)-1] = fs
+			pc = int(m.p.chunks[c.fn.chunk])
+
+		default:
+			return nil, errors.Errorf("invalid instruction %d", in.op)
+		}
+	}
+}
+
+// verify evaluates the verifier of the compiled script. For DApp the annotation value is bound to the name of the
+// verifier's annotation and global declarations are evaluated in the same scope as the verifier, like AST does.
+func (p *Program) verify(base Scope, annotation Expr) (Expr, error) {
+	if p.verifier == nil {
+		return nil, errors.New("verify function not defined")
+	}
+	m := newVM(p, base)
+	root := &vmScope{}
+	if p.dApp {
+		root.add(binding{name: p.verifier.annotation, kind: bindValue, value: annotation})
+		if _, err := m.run(p.declarations, root); err != nil {
+			return nil, err
+		}
+	}
+	return m.run(p.verifier.chunk, root)
+}
+
+// call evaluates the callable function of the compiled DApp with the given arguments and invocation.
+func (p *Program) call(base Scope, name string, args []Expr, invocation Expr) (Expr, error) {
+	e, ok := p.callables[name]
+	if !ok {
+		return nil, errors.Errorf("Callable function named '%s' not found", name)
+	}
+	if len(e.args) != len(args) {
+		return nil, errors.Errorf("invalid func '%s' args count, expected %d, got %d", name, len(e.args), len(args))
+	}
+	m := newVM(p, base)
+	root := &vmScope{}
+	if _, err := m.run(p.declarations, root); err != nil {
+		return nil, err
+	}
+	s := &vmScope{parent: root}
+	for i, a := range e.args {
+		s.add(binding{name: a, kind: bindValue, value: args[i]})
+	}
+	s.add(binding{name: e.annotation, kind: bindValue, value: invocation})
+	return m.run(e.chunk, s)
+}
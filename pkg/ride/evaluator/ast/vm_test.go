@@ -0,0 +1,281 @@
+package ast
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/ride/mockstate"
+	"github.com/wavesplatform/gowaves/pkg/util/byte_helpers"
+)
+
+// exprGenerator produces random integer expressions with heavy use of names shadowing, lazy and raw declarations, user
+// functions and throws. To guarantee termination, declaration of a name can refer only to the names of lower rank.
+type exprGenerator struct {
+	r     *rand.Rand
+	names []string
+}
+
+// env maps the names visible at the point of generation to the number of function's arguments, or -1 for values.
+type env map[string]int
+
+func (e env) with(name string, argc int) env {
+	r := make(env, len(e)+1)
+	for k, v := range e {
+		r[k] = v
+	}
+	r[name] = argc
+	return r
+}
+
+func newExprGenerator(seed int64) *exprGenerator {
+	return &exprGenerator{r: rand.New(rand.NewSource(seed)), names: []string{"a", "b", "c", "d", "e"}}
+}
+
+// below returns the part of environment with names of rank lower than the rank of given name.
+func (g *exprGenerator) below(e env, rank int) env {
+	r := make(env)
+	for i := 0; i < rank; i++ {
+		if v, ok := e[g.names[i]]; ok {
+			r[g.names[i]] = v
+		}
+	}
+	return r
+}
+
+func (g *exprGenerator) pick(e env, function bool) (string, int, bool) {
+	candidates := make([]string, 0, len(e))
+	for _, n := range g.names {
+		if argc, ok := e[n]; ok && (argc >= 0) == function {
+			candidates = append(candidates, n)
+		}
+	}
+	if len(candidates) == 0 {
+		return "", 0, false
+	}
+	n := candidates[g.r.Intn(len(candidates))]
+	return n, e[n], true
+}
+
+// declaration returns the declaration and the environment of the block's body.
+func (g *exprGenerator) declaration(depth int, e env) (Expr, env) {
+	i := g.r.Intn(len(g.names))
+	name := g.names[i]
+	if g.r.Intn(2) == 0 {
+		return NewLet(name, g.long(depth-1, g.below(e, i))), e.with(name, -1)
+	}
+	args := make([]string, 0, 2)
+	fe := g.below(e, i)
+	for j := g.r.Intn(3); i > 0 && j > 0; j-- {
+		a := g.names[g.r.Intn(i)]
+		args = append(args, a)
+		fe = fe.with(a, -1)
+	}
+	return &FuncDeclaration{Name: name, Args: args, Body: g.long(depth-1, fe)}, e.with(name, len(args))
+}
+
+func (g *exprGenerator) call(name string, depth int, e env, argc int) Expr {
+	args := make(Exprs, argc)
+	for i := range args {
+		args[i] = g.long(depth-1, e)
+	}
+	return NewFunctionCall(name, args)
+}
+
+func (g *exprGenerator) boolean(depth int, e env) Expr {
+	switch g.r.Intn(6) {
+	case 0:
+		if depth > 0 {
+			return NewIf(g.boolean(depth-1, e), g.boolean(depth-1, e), g.boolean(depth-1, e))
+		}
+	case 1:
+		if depth > 0 {
+			d, be := g.declaration(depth, e)
+			return &BlockV2{Decl: d, Body: g.boolean(depth-1, be)}
+		}
+	case 2:
+		return NewFunctionCall("2", Exprs{NewString("boolean")})
+	case 3:
+		return NewFunctionCall("102", Exprs{g.long(depth-1, e), g.long(depth-1, e)})
+	}
+	return NewFunctionCall("0", Exprs{g.long(depth-1, e), g.long(depth-1, e)})
+}
+
+func (g *exprGenerator) long(depth int, e env) Expr {
+	if depth <= 0 {
+		if n, _, ok := g.pick(e, false); ok && g.r.Intn(3) > 0 {
+			return &RefExpr{Name: n}
+		}
+		return NewLong(int64(g.r.Intn(5)))
+	}
+	switch g.r.Intn(10) {
+	case 0:
+		i := g.r.Intn(len(g.names))
+		n := g.names[i]
+		return &Block{Let: NewLet(n, g.long(depth-1, g.below(e, i))), Body: g.long(depth-1, e.with(n, -1))}
+	case 1, 2:
+		d, be := g.declaration(depth, e)
+		return &BlockV2{Decl: d, Body: g.long(depth-1, be)}
+	case 3:
+		return NewIf(g.boolean(depth-1, e), g.long(depth-1, e), g.long(depth-1, e))
+	case 4:
+		return g.call("100", depth, e, 2)
+	case 5:
+		switch g.r.Intn(4) {
+		case 0:
+			return g.call("throw", depth, e, 0)
+		case 1:
+			return NewFunctionCall("2", Exprs{NewString(fmt.Sprintf("error %d", depth))})
+		default:
+			return NewIf(g.boolean(depth-1, e), NewFunctionCall("2", Exprs{NewString("if")}), g.long(depth-1, e))
+		}
+	case 6:
+		return NewGetterExpr(&RefExpr{Name: "tx"}, "fee")
+	default:
+		if n, argc, ok := g.pick(e, true); ok {
+			return g.call(n, depth, e, argc)
+		}
+		if n, _, ok := g.pick(e, false); ok {
+			return &RefExpr{Name: n}
+		}
+		return g.call("100", depth, e, 2)
+	}
+}
+
+func verifyBoth(t *testing.T, script *Script, obj map[string]Expr) (Result, error) {
+	compiled := *script
+	require.NoError(t, compiled.Compile())
+	require.True(t, compiled.Compiled())
+	expected, expectedErr := script.Verify(proto.MainNetScheme, mockstate.State{}, obj, nil, nil)
+	actual, actualErr := compiled.Verify(proto.MainNetScheme, mockstate.State{}, obj, nil, nil)
+	if expectedErr != nil {
+		assert.EqualError(t, actualErr, expectedErr.Error())
+	} else {
+		assert.NoError(t, actualErr)
+	}
+	assert.Equal(t, expected, actual)
+	return actual, actualErr
+}
+
+func TestVMDifferential(t *testing.T) {
+	tx := byte_helpers.TransferWithProofs.Transaction.Clone()
+	obj, err := NewVariablesFromTransaction(proto.MainNetScheme, tx)
+	require.NoError(t, err)
+	g := newExprGenerator(42)
+	var values, throws, errs int
+	for i := 0; i < 5000; i++ {
+		var script *Script
+		if i%2 == 0 {
+			script = &Script{Version: 3, Verifier: g.boolean(5, env{})}
+		} else {
+			declarations := make(Exprs, g.r.Intn(4))
+			e := env{}
+			for j := range declarations {
+				declarations[j], e = g.declaration(4, e)
+			}
+			script = &Script{Version: 3, dApp: true, DApp: DApp{
+				Declarations: declarations,
+				Verifier: &DappCallableFunc{
+					AnnotationInvokeName: "tx",
+					FuncDecl:             &FuncDeclaration{Name: "verify", Body: g.boolean(5, e)},
+				},
+			}}
+		}
+		rs, err := verifyBoth(t, script, obj)
+		switch {
+		case err != nil:
+			errs++
+		case rs.Throw:
+			throws++
+		default:
+			values++
+		}
+	}
+	// Make sure that generated scripts cover all kinds of results
+	assert.NotZero(t, values)
+	assert.NotZero(t, throws)
+	assert.NotZero(t, errs)
+}
+
+func TestVMScopes(t *testing.T) {
+	tx := byte_helpers.TransferWithProofs.Transaction.Clone()
+	obj, err := NewVariablesFromTransaction(proto.MainNetScheme, tx)
+	require.NoError(t, err)
+	for _, test := range []struct {
+		name   string
+		expr   Expr
+		result Result
+	}{
+		{
+			`let ref = 999; func g(a) = ref; func f(ref) = g(ref); f(1) == 999`,
+			&BlockV2{Decl: NewLet("ref", NewLong(999)),
+				Body: &BlockV2{Decl: &FuncDeclaration{Name: "g", Args: []string{"a"}, Body: &RefExpr{Name: "ref"}},
+					Body: &BlockV2{Decl: &FuncDeclaration{Name: "f", Args: []string{"ref"}, Body: NewFunctionCall("g", Exprs{&RefExpr{Name: "ref"}})},
+						Body: NewFunctionCall("0", Exprs{NewFunctionCall("f", Exprs{NewLong(1)}), NewLong(999)})}}},
+			Result{Value: true},
+		},
+		{
+			`let x = throw("x"); true`,
+			&BlockV2{Decl: NewLet("x", NewFunctionCall("2", Exprs{NewString("x")})), Body: NewBoolean(true)},
+			Result{Value: true},
+		},
+		{
+			`throw("x")`,
+			NewFunctionCall("2", Exprs{NewString("x")}),
+			Result{Throw: true, Message: "x"},
+		},
+		{
+			`func f() = throw("x"); f()`,
+			&BlockV2{Decl: &FuncDeclaration{Name: "f", Body: NewFunctionCall("2", Exprs{NewString("x")})},
+				Body: NewFunctionCall("f", nil)},
+			Result{Throw: true, Message: "x"},
+		},
+		{
+			`let x = 1; let y = x; let x = 2; y == 1`,
+			&Block{Let: NewLet("x", NewLong(1)),
+				Body: &Block{Let: NewLet("y", &RefExpr{Name: "x"}),
+					Body: &Block{Let: NewLet("x", NewLong(2)),
+						Body: NewFunctionCall("0", Exprs{&RefExpr{Name: "y"}, NewLong(1)})}}},
+			Result{Value: true},
+		},
+		{
+			`let x = 1; let y = x; let x = 2; y == 2 (BlockV2)`,
+			&BlockV2{Decl: NewLet("x", NewLong(1)),
+				Body: &BlockV2{Decl: NewLet("y", &RefExpr{Name: "x"}),
+					Body: &BlockV2{Decl: NewLet("x", NewLong(2)),
+						Body: NewFunctionCall("0", Exprs{&RefExpr{Name: "y"}, NewLong(2)})}}},
+			Result{Value: true},
+		},
+		{
+			`if (tx.fee > 0) then true else false`,
+			NewIf(NewFunctionCall("102", Exprs{NewGetterExpr(&RefExpr{Name: "tx"}, "fee"), NewLong(0)}), NewBoolean(true), NewBoolean(false)),
+			Result{Value: true},
+		},
+	} {
+		rs, err := verifyBoth(t, &Script{Version: 3, Verifier: test.expr}, obj)
+		require.NoError(t, err, test.name)
+		assert.Equal(t, test.result, rs, test.name)
+	}
+}
+
+func TestVMThrowInArgument(t *testing.T) {
+	tx := byte_helpers.TransferWithProofs.Transaction.Clone()
+	obj, err := NewVariablesFromTransaction(proto.MainNetScheme, tx)
+	require.NoError(t, err)
+	// Throw in function's argument is reported as an error, not as a throw
+	expr := NewFunctionCall("0", Exprs{NewFunctionCall("2", Exprs{NewString("x")}), NewLong(1)})
+	_, err = verifyBoth(t, &Script{Version: 3, Verifier: expr}, obj)
+	assert.Error(t, err)
+}
+
+func TestCompileErrors(t *testing.T) {
+	s := &Script{Version: 3}
+	assert.Error(t, s.Compile())
+	assert.False(t, s.Compiled())
+	s = &Script{Version: 3, Verifier: NewUnit()}
+	assert.Error(t, s.Compile())
+	assert.False(t, s.Compiled())
+}
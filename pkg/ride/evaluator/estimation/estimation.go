@@ -1,6 +1,8 @@
 package estimation
 
 import (
+	"strconv"
+
 	"github.com/pkg/errors"
 	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
 )
@@ -183,6 +185,33 @@ func NewEstimator(version int, catalogue *Catalogue, variables map[string]ast.Ex
 	}
 }
 
+// NewEstimatorForScript creates the estimator of given version with the functions catalogue and variables
+// of the script's RIDE version.
+func NewEstimatorForScript(version int, script *ast.Script) *Estimator {
+	switch script.Version {
+	case 1, 2:
+		return NewEstimator(version, NewCatalogueV2(), ast.VariablesV2())
+	case 3:
+		return NewEstimator(version, NewCatalogueV3(), ast.VariablesV3())
+	case 4:
+		return NewEstimator(version, NewCatalogueV4(), ast.VariablesV4())
+	default:
+		return NewEstimator(version, NewCatalogueV5(), ast.VariablesV5())
+	}
+}
+
+// MaxComplexity returns the max allowed complexity of verifier or DApp script of given RIDE version.
+func MaxComplexity(scriptVersion int) uint64 {
+	switch scriptVersion {
+	case 1, 2:
+		return 2000
+	case 3, 4:
+		return 4000
+	default:
+		return 10000
+	}
+}
+
 func (e *Estimator) Estimate(script *ast.Script) (Costs, error) {
 	if script.IsDapp() {
 		return e.EstimateDApp(script)
@@ -194,6 +223,13 @@ func (e *Estimator) EstimateDApp(script *ast.Script) (Costs, error) {
 	if !script.IsDapp() {
 		return Costs{}, errors.New("estimation: not a DApp")
 	}
+	if e.Version >= 3 {
+		r, err := newEstimatorV3(e.catalogue).estimateDApp(script)
+		if err != nil {
+			return Costs{}, errors.Wrap(err, "estimation")
+		}
+		return r, nil
+	}
 	e.contexts.deleteRootExpression("tx")
 	e.contexts.setRootExpression("height", expression{expr: ast.NewLong(0), evaluated: true})
 	e.contexts.setRootExpression("this", expression{expr: ast.NewUnit(), evaluated: false})
@@ -259,6 +295,13 @@ func (e *Estimator) EstimateVerifier(script *ast.Script) (Costs, error) {
 	if script.IsDapp() {
 		return Costs{}, errors.New("estimation: not a simple script")
 	}
+	if e.Version >= 3 {
+		r, err := newEstimatorV3(e.catalogue).estimateVerifier(script)
+		if err != nil {
+			return Costs{}, errors.Wrap(err, "estimation")
+		}
+		return r, nil
+	}
 	verifierCost, err := e.estimate(script.Verifier)
 	if err != nil {
 		return Costs{}, errors.Wrap(err, "estimation")
@@ -740,3 +783,38 @@ func NewCatalogueV4() *Catalogue {
 	c.user["2903"] = 100
 	return c
 }
+
+func NewCatalogueV5() *Catalogue {
+	c := NewCatalogueV4()
+	c.user["Lease"] = 3
+	c.user["LeaseCancel"] = 1
+	c.user["AttachedPayment"] = 2
+	for i := 2; i <= 22; i++ {
+		c.user["Tuple"+strconv.Itoa(i)] = 1
+	}
+	c.user["isDataStorageUntouched"] = 10
+	c.user["110"] = 17
+	c.user["118"] = 200
+	c.user["119"] = 200
+	c.user["310"] = 1
+	c.user["311"] = 8
+	c.user["312"] = 8
+	c.user["313"] = 64
+	c.user["314"] = 64
+	c.user["315"] = 64
+	c.user["316"] = 128
+	c.user["317"] = 128
+	c.user["318"] = 8
+	c.user["319"] = 8
+	c.user["320"] = 8
+	c.user["410"] = 1
+	c.user["411"] = 65
+	c.user["412"] = 65
+	c.user["420"] = 65
+	c.user["421"] = 65
+	c.user["422"] = 65
+	c.user["1020"] = 75
+	c.user["1021"] = 75
+	c.user["1081"] = 1
+	return c
+}
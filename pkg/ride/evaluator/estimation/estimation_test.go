@@ -2,6 +2,8 @@ package estimation
 
 import (
 	"fmt"
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
@@ -207,3 +209,58 @@ func TestDAppEstimation(t *testing.T) {
 		assert.Equal(t, test.count, len(estimation.Functions), fmt.Sprintf("Failure: V%d: %s: unexpected number of functions %d", test.version, test.code, len(estimation.Functions)))
 	}
 }
+
+func TestEstimatorV3(t *testing.T) {
+	for _, test := range []struct {
+		code      string
+		script    string
+		catalogue *Catalogue
+		cost      uint64
+	}{
+		{`false`, "AweHXCN1", NewCatalogueV3(), 1},
+		{`let x = 2 * 2; x == 4`, "AwQAAAABeAkAAGgAAAACAAAAAAAAAAACAAAAAAAAAAACCQAAAAAAAAIFAAAAAXgAAAAAAAAAAARdrwMC", NewCatalogueV3(), 6},
+		{`let x = parseIntValue("12345"); 0 == 0`, "AwQAAAABeAkBAAAADXBhcnNlSW50VmFsdWUAAAABAgAAAAUxMjM0NQkAAAAAAAACAAAAAAAAAAAAAAAAAAAAAAAAk6EsIQ==", NewCatalogueV3(), 3},
+		{`func inc(y: Int) = y + 1; let xxx = 5; inc(xxx) == 1`, "AwoBAAAAA2luYwAAAAEAAAABeQkAAGQAAAACBQAAAAF5AAAAAAAAAAABBAAAAAN4eHgAAAAAAAAAAAUJAAAAAAAAAgkBAAAAA2luYwAAAAEFAAAAA3h4eAAAAAAAAAAAAbumbXA=", NewCatalogueV3(), 12},
+		{`let x = 0; let y = if true then x else x + 1; y == 0`, "AgQAAAABeAAAAAAAAAAAAAQAAAABeQMGBQAAAAF4CQAAZAAAAAIFAAAAAXgAAAAAAAAAAAEJAAAAAAAAAgUAAAABeQAAAAAAAAAAALitwEo=", NewCatalogueV2(), 9},
+	} {
+		r, err := reader.NewReaderFromBase64(test.script)
+		require.NoError(t, err, test.code)
+		script, err := ast.BuildScript(r)
+		require.NoError(t, err, test.code)
+		e := NewEstimator(3, test.catalogue, ast.VariablesV3())
+		cost, err := e.EstimateVerifier(script)
+		require.NoError(t, err, test.code)
+		assert.Equal(t, int(test.cost), int(cost.Verifier), test.code)
+	}
+}
+
+func TestEstimatorV3Scripts(t *testing.T) {
+	for _, test := range []struct {
+		file      string
+		catalogue *Catalogue
+		dApp      uint64
+		verifier  uint64
+		functions map[string]uint64
+	}{
+		{"dapp.base64", NewCatalogueV3(), 160, 110, map[string]uint64{"deposit": 153, "withdraw": 160}},
+		{"exceeds_complexity.base64", NewCatalogueV2(), 0, 1556, map[string]uint64{}},
+		{"ride4_asset.base64", NewCatalogueV4(), 110, 110, map[string]uint64{"burn": 13, "issue": 46, "reissue": 15}},
+		{"version3.base64", NewCatalogueV3(), 0, 1556, map[string]uint64{}},
+	} {
+		b, err := ioutil.ReadFile(filepath.Join("..", "..", "..", "state", "testdata", "scripts", test.file))
+		require.NoError(t, err, test.file)
+		sb, err := reader.ScriptBytesFromBase64(b)
+		require.NoError(t, err, test.file)
+		script, err := ast.BuildScript(reader.NewBytesReader(sb))
+		require.NoError(t, err, test.file)
+		e := NewEstimator(3, test.catalogue, ast.VariablesV3())
+		cost, err := e.Estimate(script)
+		require.NoError(t, err, test.file)
+		assert.Equal(t, int(test.dApp), int(cost.DApp), test.file)
+		assert.Equal(t, int(test.verifier), int(cost.Verifier), test.file)
+		assert.Equal(t, len(test.functions), len(cost.Functions), test.file)
+		for n, c := range test.functions {
+			assert.Equal(t, int(c), int(cost.Functions[n]), fmt.Sprintf("%s: function '%s'", test.file, n))
+		}
+	}
+}
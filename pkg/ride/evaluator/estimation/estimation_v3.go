@@ -0,0 +1,218 @@
+package estimation
+
+import (
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+)
+
+// functionInfo holds the cost of user function's body calculated at the point of declaration and
+// the set of references to outer declarations used in the body.
+type functionInfo struct {
+	cost uint64
+	refs map[string]struct{}
+}
+
+// estimatorV3 implements third version of script complexity estimation algorithm.
+// Major differences from previous versions:
+//   - the cost of `let` declaration is accounted only once and only if the declaration is actually referenced;
+//   - the cost of user function is calculated once at the place of declaration, references from the function's body
+//     to outer declarations are propagated to the place of function call;
+//   - the overlapped (shadowed) declarations do not affect the cost of the outer ones;
+//   - the cost of `if` expression is the cost of condition plus the maximum of branches costs.
+type estimatorV3 struct {
+	catalogue *Catalogue
+	functions map[string]functionInfo
+	refs      map[string]struct{}
+}
+
+func newEstimatorV3(catalogue *Catalogue) *estimatorV3 {
+	return &estimatorV3{
+		catalogue: catalogue,
+		functions: make(map[string]functionInfo),
+		refs:      make(map[string]struct{}),
+	}
+}
+
+func (e *estimatorV3) estimateDApp(script *ast.Script) (Costs, error) {
+	r := Costs{Functions: make(map[string]uint64, len(script.DApp.CallableFuncs))}
+	var max uint64 = 0
+	for _, cf := range script.DApp.CallableFuncs {
+		c, err := e.estimateCallable(script.DApp.Declarations, cf)
+		if err != nil {
+			return Costs{}, errors.Wrapf(err, "estimation of function '%s'", cf.FuncDecl.Name)
+		}
+		r.Functions[cf.FuncDecl.Name] = c
+		if c > max {
+			max = c
+		}
+	}
+	if script.DApp.Verifier != nil {
+		c, err := e.estimateCallable(script.DApp.Declarations, script.DApp.Verifier)
+		if err != nil {
+			return Costs{}, errors.Wrap(err, "estimation of verifier")
+		}
+		r.Verifier = c
+		if c > max {
+			max = c
+		}
+	}
+	r.DApp = max
+	return r, nil
+}
+
+func (e *estimatorV3) estimateVerifier(script *ast.Script) (Costs, error) {
+	c, err := e.estimate(script.Verifier)
+	if err != nil {
+		return Costs{}, err
+	}
+	return Costs{Verifier: c}, nil
+}
+
+// estimateCallable estimates the cost of the expression constructed from DApp's global declarations, invocation
+// annotation and the call of the function with constant arguments.
+func (e *estimatorV3) estimateCallable(declarations ast.Exprs, callable *ast.DappCallableFunc) (uint64, error) {
+	args := make(ast.Exprs, len(callable.FuncDecl.Args))
+	for i := range args {
+		args[i] = ast.NewBoolean(true)
+	}
+	var expr ast.Expr = &ast.BlockV2{
+		Decl: ast.NewLet(callable.AnnotationInvokeName, ast.NewBoolean(true)),
+		Body: &ast.BlockV2{
+			Decl: callable.FuncDecl,
+			Body: ast.NewFunctionCall(callable.FuncDecl.Name, args),
+		},
+	}
+	for i := len(declarations) - 1; i >= 0; i-- {
+		expr = &ast.BlockV2{Decl: declarations[i], Body: expr}
+	}
+	e.functions = make(map[string]functionInfo)
+	e.refs = make(map[string]struct{})
+	return e.estimate(expr)
+}
+
+func (e *estimatorV3) estimate(expr ast.Expr) (uint64, error) {
+	switch ce := expr.(type) {
+	case *ast.StringExpr, *ast.LongExpr, *ast.BooleanExpr, *ast.BytesExpr, *ast.ArrayExpr:
+		return 1, nil
+
+	case *ast.Block:
+		return e.estimateLet(ce.Let, ce.Body)
+
+	case *ast.BlockV2:
+		switch decl := ce.Decl.(type) {
+		case *ast.LetExpr:
+			return e.estimateLet(decl, ce.Body)
+		case *ast.FuncDeclaration:
+			return e.estimateFunction(decl, ce.Body)
+		default:
+			return 0, errors.Errorf("unsupported declaration of type %T", ce.Decl)
+		}
+
+	case *ast.RefExpr:
+		e.refs[ce.Name] = struct{}{}
+		return 1, nil
+
+	case *ast.IfExpr:
+		cc, err := e.estimate(ce.Condition)
+		if err != nil {
+			return 0, err
+		}
+		tc, err := e.estimate(ce.True)
+		if err != nil {
+			return 0, err
+		}
+		fc, err := e.estimate(ce.False)
+		if err != nil {
+			return 0, err
+		}
+		if tc > fc {
+			return cc + tc + 1, nil
+		}
+		return cc + fc + 1, nil
+
+	case *ast.GetterExpr:
+		c, err := e.estimate(ce.Object)
+		if err != nil {
+			return 0, err
+		}
+		return c + 1, nil
+
+	case *ast.FuncCallExpr:
+		return e.estimate(ce.Func)
+
+	case *ast.FunctionCall:
+		var fc uint64
+		if fi, ok := e.functions[ce.Name]; ok {
+			fc = fi.cost
+			for r := range fi.refs {
+				e.refs[r] = struct{}{}
+			}
+		} else {
+			fc, ok = e.catalogue.FunctionCost(ce.Name)
+			if !ok {
+				return 0, errors.Errorf("no function '%s' in scope", ce.Name)
+			}
+		}
+		var ac uint64 = 0
+		for _, a := range ce.Argv {
+			c, err := e.estimate(a)
+			if err != nil {
+				return 0, err
+			}
+			ac += c
+		}
+		return fc + ac, nil
+
+	default:
+		return 0, errors.Errorf("unsupported expression of type %T", expr)
+	}
+}
+
+// estimateLet estimates the body of the block and adds the cost of `let` value only if it was referenced.
+func (e *estimatorV3) estimateLet(let *ast.LetExpr, body ast.Expr) (uint64, error) {
+	functions := e.functions
+	defer func() { e.functions = functions }()
+	_, overlapped := e.refs[let.Name]
+	delete(e.refs, let.Name)
+	bc, err := e.estimate(body)
+	if err != nil {
+		return 0, err
+	}
+	var lc uint64 = 0
+	if _, ok := e.refs[let.Name]; ok {
+		// Value of `let` is evaluated with functions that were in scope at the place of declaration
+		e.functions = functions
+		lc, err = e.estimate(let.Value)
+		if err != nil {
+			return 0, err
+		}
+	}
+	if overlapped {
+		e.refs[let.Name] = struct{}{}
+	} else {
+		delete(e.refs, let.Name)
+	}
+	return bc + lc, nil
+}
+
+// estimateFunction calculates the cost of function's body and puts the function in scope to estimate the block body.
+func (e *estimatorV3) estimateFunction(decl *ast.FuncDeclaration, body ast.Expr) (uint64, error) {
+	functions := e.functions
+	refs := e.refs
+	defer func() { e.functions = functions }()
+	e.refs = make(map[string]struct{})
+	fc, err := e.estimate(decl.Body)
+	if err != nil {
+		return 0, err
+	}
+	for _, a := range decl.Args {
+		delete(e.refs, a)
+	}
+	e.functions = make(map[string]functionInfo, len(functions)+1)
+	for k, v := range functions {
+		e.functions[k] = v
+	}
+	e.functions[decl.Name] = functionInfo{cost: fc + uint64(len(decl.Args)*5), refs: e.refs}
+	e.refs = refs
+	return e.estimate(body)
+}
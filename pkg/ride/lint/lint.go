@@ -0,0 +1,165 @@
+package lint
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/estimation"
+)
+
+// DefaultComplexityThreshold is the part of complexity limit after which the complexity of function is reported.
+const DefaultComplexityThreshold = 0.9
+
+// Rule identifies the kind of problem found in a script.
+type Rule string
+
+const (
+	UnusedDeclaration   Rule = "unused-declaration"
+	UnreachableCase     Rule = "unreachable-case"
+	UncheckedDataKey    Rule = "unchecked-data-key"
+	MissingCallerCheck  Rule = "missing-caller-check"
+	PermissiveVerifier  Rule = "permissive-verifier"
+	ComplexityNearLimit Rule = "complexity-near-limit"
+)
+
+// Warning describes one problem found in a script.
+// Function is the name of callable function, user function or verifier the problem was found in,
+// it is empty for problems with global declarations and expression scripts.
+type Warning struct {
+	Rule     Rule   `json:"rule"`
+	Function string `json:"function,omitempty"`
+	Message  string `json:"message"`
+}
+
+// Complexity holds the estimated complexities of a script along with the limit for its version.
+type Complexity struct {
+	Limit     uint64            `json:"limit"`
+	Script    uint64            `json:"script"`
+	Verifier  uint64            `json:"verifier"`
+	Functions map[string]uint64 `json:"functions,omitempty"`
+}
+
+// Report is the machine-readable result of script linting.
+type Report struct {
+	Version    int        `json:"version"`
+	DApp       bool       `json:"dApp"`
+	Complexity Complexity `json:"complexity"`
+	Warnings   []Warning  `json:"warnings"`
+}
+
+type Linter struct {
+	estimator *estimation.Estimator
+	threshold float64
+	warnings  []Warning
+}
+
+// NewLinter creates linter that uses given estimator to check the complexity of functions.
+// Threshold is the part of complexity limit, the functions with greater or equal complexity are reported.
+func NewLinter(estimator *estimation.Estimator, threshold float64) (*Linter, error) {
+	if estimator == nil {
+		return nil, errors.New("lint: empty estimator")
+	}
+	if threshold <= 0 || threshold > 1 {
+		return nil, errors.Errorf("lint: invalid complexity threshold %f, expected value in (0, 1]", threshold)
+	}
+	return &Linter{estimator: estimator, threshold: threshold}, nil
+}
+
+func (l *Linter) warn(rule Rule, function string, format string, args ...interface{}) {
+	l.warnings = append(l.warnings, Warning{Rule: rule, Function: function, Message: fmt.Sprintf(format, args...)})
+}
+
+// Lint checks the script and returns the report with all found problems.
+func (l *Linter) Lint(script *ast.Script) (*Report, error) {
+	costs, err := l.estimator.Estimate(script)
+	if err != nil {
+		return nil, errors.Wrap(err, "lint")
+	}
+	l.warnings = make([]Warning, 0)
+	complexity := Complexity{Limit: estimation.MaxComplexity(script.Version), Script: costs.Verifier, Verifier: costs.Verifier}
+	if script.IsDapp() {
+		complexity.Script = costs.DApp
+		complexity.Functions = costs.Functions
+		l.lintDApp(&script.DApp)
+	} else {
+		l.lintExpression(script.Verifier)
+	}
+	l.checkComplexity(script, complexity)
+	return &Report{
+		Version:    script.Version,
+		DApp:       script.IsDapp(),
+		Complexity: complexity,
+		Warnings:   l.warnings,
+	}, nil
+}
+
+func (l *Linter) lintExpression(verifier ast.Expr) {
+	l.checkUnusedLocals("", verifier)
+	l.checkMatches("", verifier)
+	if alwaysTrue(verifier, nil) {
+		l.warn(PermissiveVerifier, "", "script always returns true, any transaction is allowed")
+	}
+}
+
+func (l *Linter) lintDApp(dApp *ast.DApp) {
+	l.checkUnusedGlobals(dApp)
+	globals := make(map[string]bool)
+	for _, d := range dApp.Declarations {
+		switch decl := d.(type) {
+		case *ast.LetExpr:
+			l.checkUnusedLocals("", decl.Value)
+			l.checkMatches("", decl.Value)
+			globals[decl.Name] = alwaysTrue(decl.Value, globals)
+		case *ast.FuncDeclaration:
+			l.checkUnusedLocals(decl.Name, decl.Body)
+			l.checkMatches(decl.Name, decl.Body)
+		}
+	}
+	for _, name := range callableNames(dApp) {
+		fn := dApp.CallableFuncs[name].FuncDecl
+		l.checkUnusedLocals(name, fn.Body)
+		l.checkMatches(name, fn.Body)
+		l.checkCallable(dApp, dApp.CallableFuncs[name])
+	}
+	if dApp.Verifier != nil {
+		fn := dApp.Verifier.FuncDecl
+		l.checkUnusedLocals(fn.Name, fn.Body)
+		l.checkMatches(fn.Name, fn.Body)
+		for _, a := range append([]string{dApp.Verifier.AnnotationInvokeName}, fn.Args...) {
+			delete(globals, a)
+		}
+		if alwaysTrue(fn.Body, globals) {
+			l.warn(PermissiveVerifier, fn.Name, "verifier always returns true, any transaction from the account is allowed")
+		}
+	}
+}
+
+func (l *Linter) checkComplexity(script *ast.Script, complexity Complexity) {
+	threshold := uint64(l.threshold * float64(complexity.Limit))
+	check := func(function string, cost uint64) {
+		if cost >= threshold {
+			l.warn(ComplexityNearLimit, function, "complexity %d is %d%% of the limit %d", cost, cost*100/complexity.Limit, complexity.Limit)
+		}
+	}
+	if !script.IsDapp() {
+		check("", complexity.Verifier)
+		return
+	}
+	for _, name := range callableNames(&script.DApp) {
+		check(name, complexity.Functions[name])
+	}
+	if script.DApp.Verifier != nil {
+		check(script.DApp.Verifier.FuncDecl.Name, complexity.Verifier)
+	}
+}
+
+func callableNames(dApp *ast.DApp) []string {
+	names := make([]string, 0, len(dApp.CallableFuncs))
+	for name := range dApp.CallableFuncs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
@@ -0,0 +1,215 @@
+package lint
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/estimation"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
+)
+
+func ref(name string) ast.Expr {
+	return &ast.RefExpr{Name: name}
+}
+
+func call(name string, args ...ast.Expr) ast.Expr {
+	return ast.NewFuncCall(ast.NewFunctionCall(name, args))
+}
+
+func let(name string, value, body ast.Expr) ast.Expr {
+	return &ast.Block{Let: ast.NewLet(name, value), Body: body}
+}
+
+func rules(warnings []Warning) []Rule {
+	r := make([]Rule, len(warnings))
+	for i, w := range warnings {
+		r[i] = w.Rule
+	}
+	return r
+}
+
+func newTestLinter(t *testing.T) *Linter {
+	l, err := NewLinter(estimation.NewEstimator(3, estimation.NewCatalogueV3(), ast.VariablesV3()), DefaultComplexityThreshold)
+	require.NoError(t, err)
+	l.warnings = make([]Warning, 0)
+	return l
+}
+
+func TestNewLinter(t *testing.T) {
+	e := estimation.NewEstimator(3, estimation.NewCatalogueV3(), ast.VariablesV3())
+	_, err := NewLinter(nil, DefaultComplexityThreshold)
+	assert.Error(t, err)
+	_, err = NewLinter(e, 0)
+	assert.Error(t, err)
+	_, err = NewLinter(e, 1.5)
+	assert.Error(t, err)
+	_, err = NewLinter(e, 1)
+	assert.NoError(t, err)
+}
+
+func TestLintScripts(t *testing.T) {
+	for _, test := range []struct {
+		file      string
+		threshold float64
+		warnings  []Warning
+	}{
+		{"dapp.base64", DefaultComplexityThreshold, []Warning{}},
+		{"dapp.base64", 0.04, []Warning{
+			{Rule: ComplexityNearLimit, Function: "withdraw", Message: "complexity 160 is 4% of the limit 4000"},
+		}},
+		{"version3.base64", DefaultComplexityThreshold, []Warning{
+			{Rule: UnusedDeclaration, Message: "let 'i' is never used"},
+		}},
+		{"version3.base64", 0.3, []Warning{
+			{Rule: UnusedDeclaration, Message: "let 'i' is never used"},
+			{Rule: ComplexityNearLimit, Message: "complexity 1556 is 38% of the limit 4000"},
+		}},
+	} {
+		b, err := ioutil.ReadFile(filepath.Join("..", "..", "state", "testdata", "scripts", test.file))
+		require.NoError(t, err, test.file)
+		sb, err := reader.ScriptBytesFromBase64(b)
+		require.NoError(t, err, test.file)
+		script, err := ast.BuildScript(reader.NewBytesReader(sb))
+		require.NoError(t, err, test.file)
+		l, err := NewLinter(estimation.NewEstimator(3, estimation.NewCatalogueV3(), ast.VariablesV3()), test.threshold)
+		require.NoError(t, err, test.file)
+		r, err := l.Lint(script)
+		require.NoError(t, err, test.file)
+		assert.Equal(t, 3, r.Version, test.file)
+		assert.Equal(t, script.IsDapp(), r.DApp, test.file)
+		assert.Equal(t, uint64(4000), r.Complexity.Limit, test.file)
+		assert.Equal(t, test.warnings, r.Warnings, test.file)
+	}
+}
+
+func TestUnusedDeclarations(t *testing.T) {
+	l := newTestLinter(t)
+	// let a = 1; let b = 3; func f(b) = b; func g() = true; let a = 2; a == 2
+	expr := let("a", ast.NewLong(1),
+		let("b", ast.NewLong(3),
+			&ast.BlockV2{
+				Decl: &ast.FuncDeclaration{Name: "f", Args: []string{"b"}, Body: ref("b")},
+				Body: &ast.BlockV2{
+					Decl: &ast.FuncDeclaration{Name: "g", Body: ast.NewBoolean(true)},
+					Body: let("a", ast.NewLong(2), call("0", ref("a"), ast.NewLong(2))),
+				},
+			}))
+	l.lintExpression(expr)
+	assert.Equal(t, []Warning{
+		{Rule: UnusedDeclaration, Message: "let 'a' is never used"},
+		{Rule: UnusedDeclaration, Message: "let 'b' is never used"},
+		{Rule: UnusedDeclaration, Message: "function 'f' is never used"},
+		{Rule: UnusedDeclaration, Message: "function 'g' is never used"},
+	}, l.warnings)
+
+	l = newTestLinter(t)
+	dApp := &ast.DApp{
+		Declarations: ast.Exprs{
+			ast.NewLet("used", ast.NewLong(1)),
+			ast.NewLet("unused", ast.NewLong(2)),
+			&ast.FuncDeclaration{Name: "helper", Body: ref("used")},
+			&ast.FuncDeclaration{Name: "orphan", Body: ast.NewBoolean(true)},
+		},
+		CallableFuncs: map[string]*ast.DappCallableFunc{
+			"call": {AnnotationInvokeName: "i", FuncDecl: &ast.FuncDeclaration{Name: "call", Body: call("helper")}},
+		},
+	}
+	l.lintDApp(dApp)
+	assert.Equal(t, []Warning{
+		{Rule: UnusedDeclaration, Message: "let 'unused' is never used"},
+		{Rule: UnusedDeclaration, Message: "function 'orphan' is never used"},
+	}, l.warnings)
+}
+
+func TestUnreachableCase(t *testing.T) {
+	isInstance := func(t string) ast.Expr {
+		return call("1", ref("$match0"), ast.NewString(t))
+	}
+	// match tx { case _: TransferTransaction|DataTransaction => true case _: DataTransaction => false case _ => false }
+	expr := let("$match0", ref("tx"),
+		ast.NewIf(ast.NewIf(isInstance("TransferTransaction"), ast.NewBoolean(true), isInstance("DataTransaction")), ast.NewBoolean(true),
+			ast.NewIf(isInstance("DataTransaction"), ast.NewBoolean(false), ast.NewBoolean(false))))
+	l := newTestLinter(t)
+	l.lintExpression(expr)
+	assert.Equal(t, []Warning{
+		{Rule: UnreachableCase, Message: "match case 'DataTransaction' is unreachable, the type is matched by previous cases"},
+	}, l.warnings)
+}
+
+func TestPermissiveVerifier(t *testing.T) {
+	for _, test := range []struct {
+		expr ast.Expr
+		rs   []Rule
+	}{
+		{ast.NewBoolean(true), []Rule{PermissiveVerifier}},
+		{ast.NewBoolean(false), []Rule{}},
+		{let("x", ast.NewBoolean(true), ref("x")), []Rule{PermissiveVerifier}},
+		{ast.NewIf(call("sigVerify"), ast.NewBoolean(true), ast.NewBoolean(true)), []Rule{PermissiveVerifier}},
+		{ast.NewIf(call("sigVerify"), ast.NewBoolean(true), ast.NewBoolean(false)), []Rule{}},
+		{ast.NewIf(ast.NewBoolean(false), ast.NewBoolean(false), ast.NewBoolean(true)), []Rule{PermissiveVerifier}},
+	} {
+		l := newTestLinter(t)
+		l.lintExpression(test.expr)
+		assert.Equal(t, test.rs, rules(l.warnings))
+	}
+	l := newTestLinter(t)
+	dApp := &ast.DApp{
+		Declarations:  ast.Exprs{ast.NewLet("allowed", ast.NewBoolean(true))},
+		CallableFuncs: map[string]*ast.DappCallableFunc{},
+		Verifier:      &ast.DappCallableFunc{AnnotationInvokeName: "tx", FuncDecl: &ast.FuncDeclaration{Name: "verify", Body: ref("allowed")}},
+	}
+	l.lintDApp(dApp)
+	assert.Equal(t, []Warning{
+		{Rule: PermissiveVerifier, Function: "verify", Message: "verifier always returns true, any transaction from the account is allowed"},
+	}, l.warnings)
+}
+
+func TestCallableChecks(t *testing.T) {
+	callable := func(name string, args []string, body ast.Expr) *ast.DappCallableFunc {
+		return &ast.DappCallableFunc{AnnotationInvokeName: "i", FuncDecl: &ast.FuncDeclaration{Name: name, Args: args, Body: body}}
+	}
+	caller := ast.NewGetterExpr(ref("i"), "caller")
+	throw := call("2", ast.NewString("denied"))
+	dApp := &ast.DApp{
+		Declarations: ast.Exprs{
+			&ast.FuncDeclaration{Name: "onlyOwner", Args: []string{"inv"},
+				Body: ast.NewIf(call("0", ast.NewGetterExpr(ref("inv"), "caller"), ref("this")), ast.NewBoolean(true), throw)},
+			// let key = "config"
+			ast.NewLet("key", ast.NewString("config")),
+			// func config(value: String) = DataEntry(key, value)
+			&ast.FuncDeclaration{Name: "config", Args: []string{"value"}, Body: call("DataEntry", ref("key"), ref("value"))},
+			// func entry(k: String, v: String) = DataEntry(k, v)
+			&ast.FuncDeclaration{Name: "entry", Args: []string{"k", "v"}, Body: call("DataEntry", ref("k"), ref("v"))},
+		},
+		CallableFuncs: map[string]*ast.DappCallableFunc{
+			// @Callable(i) func put(key: String, value: String) = WriteSet([DataEntry(key, value)])
+			"put": callable("put", []string{"key", "value"}, call("WriteSet", call("1100", call("DataEntry", ref("key"), ref("value")), ref("nil")))),
+			// @Callable(i) func putChecked(key: String) = if (size(key) > 10) then throw() else WriteSet([DataEntry("p_" + key, 1)])
+			"putChecked": callable("putChecked", []string{"key"}, ast.NewIf(call("102", call("155", ref("key")), ast.NewLong(10)), throw,
+				call("WriteSet", call("1100", call("DataEntry", call("300", ast.NewString("p_"), ref("key")), ast.NewLong(1)), ref("nil"))))),
+			// @Callable(i) func withdraw(amount: Int) = TransferSet([ScriptTransfer(i.caller, amount, unit)])
+			"withdraw": callable("withdraw", []string{"amount"}, call("TransferSet", call("1100", call("ScriptTransfer", caller, ref("amount"), ref("unit")), ref("nil")))),
+			// @Callable(i) func drain(amount: Int) = if (onlyOwner(i)) then TransferSet([ScriptTransfer(i.caller, amount, unit)]) else throw()
+			"drain": callable("drain", []string{"amount"}, ast.NewIf(call("onlyOwner", ref("i")),
+				call("TransferSet", call("1100", call("ScriptTransfer", caller, ref("amount"), ref("unit")), ref("nil"))), throw)),
+			// @Callable(i) func send(recipient: String) = let c = i.caller; if (c == this) then TransferSet([ScriptTransfer(addressFromStringValue(recipient), 1, unit)]) else throw()
+			"send": callable("send", []string{"recipient"}, let("c", caller, ast.NewIf(call("0", ref("c"), ref("this")),
+				call("TransferSet", call("1100", call("ScriptTransfer", call("addressFromStringValue", ref("recipient")), ast.NewLong(1), ref("unit")), ref("nil"))), throw))),
+			// @Callable(i) func setConfig(key: String) = WriteSet([config(key)])
+			"setConfig": callable("setConfig", []string{"key"}, call("WriteSet", call("1100", call("config", ref("key")), ref("nil")))),
+			// @Callable(i) func putEntry(key: String, value: String) = WriteSet([entry(key, value)])
+			"putEntry": callable("putEntry", []string{"key", "value"}, call("WriteSet", call("1100", call("entry", ref("key"), ref("value")), ref("nil")))),
+		},
+	}
+	l := newTestLinter(t)
+	l.lintDApp(dApp)
+	assert.Equal(t, []Warning{
+		{Rule: UncheckedDataKey, Function: "put", Message: "key of DataEntry depends on argument 'key' that is never checked"},
+		{Rule: UncheckedDataKey, Function: "putEntry", Message: "key of DataEntry depends on argument 'key' that is never checked"},
+		{Rule: MissingCallerCheck, Function: "withdraw", Message: "function makes transfers without checking the caller"},
+	}, l.warnings)
+}
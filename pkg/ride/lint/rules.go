@@ -0,0 +1,465 @@
+package lint
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+)
+
+const (
+	instanceOfFunction = "1"
+	transferFunction   = "ScriptTransfer"
+	invocationSource   = "$invocation"
+	callerSource       = "$caller"
+)
+
+var (
+	callerFields = map[string]bool{
+		"caller":                true,
+		"callerPublicKey":       true,
+		"originCaller":          true,
+		"originCallerPublicKey": true,
+	}
+	dataEntryConstructors = map[string]bool{
+		"DataEntry":    true,
+		"IntegerEntry": true,
+		"BooleanEntry": true,
+		"BinaryEntry":  true,
+		"StringEntry":  true,
+		"DeleteEntry":  true,
+	}
+)
+
+func children(e ast.Expr) []ast.Expr {
+	switch v := e.(type) {
+	case *ast.FuncCallExpr:
+		return []ast.Expr{v.Func}
+	case *ast.FunctionCall:
+		return v.Argv
+	case *ast.IfExpr:
+		return []ast.Expr{v.Condition, v.True, v.False}
+	case *ast.GetterExpr:
+		return []ast.Expr{v.Object}
+	case *ast.ArrayExpr:
+		return v.Items
+	case *ast.Block:
+		return []ast.Expr{v.Let.Value, v.Body}
+	case *ast.BlockV2:
+		return []ast.Expr{v.Decl, v.Body}
+	case *ast.LetExpr:
+		return []ast.Expr{v.Value}
+	case *ast.FuncDeclaration:
+		return []ast.Expr{v.Body}
+	default:
+		return nil
+	}
+}
+
+func walk(e ast.Expr, visit func(ast.Expr)) {
+	visit(e)
+	for _, c := range children(e) {
+		walk(c, visit)
+	}
+}
+
+func functionCall(e ast.Expr) (*ast.FunctionCall, bool) {
+	if fc, ok := e.(*ast.FuncCallExpr); ok {
+		e = fc.Func
+	}
+	call, ok := e.(*ast.FunctionCall)
+	return call, ok
+}
+
+// generated reports whether the name was produced by compiler, like the names of match variables or FOLD macros.
+func generated(name string) bool {
+	return strings.HasPrefix(name, "$") || strings.HasPrefix(name, "@")
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
+
+// uses reports whether the expression refers the variable or calls the function with the given name.
+// Nested declarations of the same name hide the outer one.
+func uses(e ast.Expr, name string, function bool) bool {
+	switch v := e.(type) {
+	case *ast.RefExpr:
+		return !function && v.Name == name
+	case *ast.FunctionCall:
+		if function && v.Name == name {
+			return true
+		}
+	case *ast.Block:
+		return uses(v.Let.Value, name, function) || (function || v.Let.Name != name) && uses(v.Body, name, function)
+	case *ast.BlockV2:
+		switch d := v.Decl.(type) {
+		case *ast.LetExpr:
+			return uses(d.Value, name, function) || (function || d.Name != name) && uses(v.Body, name, function)
+		case *ast.FuncDeclaration:
+			return usesInFunction(d, name, function) || (!function || d.Name != name) && uses(v.Body, name, function)
+		}
+	case *ast.LetExpr:
+		return uses(v.Value, name, function)
+	case *ast.FuncDeclaration:
+		return usesInFunction(v, name, function)
+	}
+	for _, c := range children(e) {
+		if uses(c, name, function) {
+			return true
+		}
+	}
+	return false
+}
+
+func usesInFunction(fn *ast.FuncDeclaration, name string, function bool) bool {
+	if !function && contains(fn.Args, name) {
+		return false
+	}
+	return uses(fn.Body, name, function)
+}
+
+func declaration(e ast.Expr) (string, bool, bool) {
+	switch d := e.(type) {
+	case *ast.LetExpr:
+		return d.Name, false, true
+	case *ast.FuncDeclaration:
+		return d.Name, true, true
+	default:
+		return "", false, false
+	}
+}
+
+func (l *Linter) warnUnused(function, name string, isFunction bool) {
+	if isFunction {
+		l.warn(UnusedDeclaration, function, "function '%s' is never used", name)
+		return
+	}
+	l.warn(UnusedDeclaration, function, "let '%s' is never used", name)
+}
+
+func (l *Linter) checkUnusedGlobals(dApp *ast.DApp) {
+	callables := make([]*ast.DappCallableFunc, 0, len(dApp.CallableFuncs)+1)
+	for _, n := range callableNames(dApp) {
+		callables = append(callables, dApp.CallableFuncs[n])
+	}
+	if dApp.Verifier != nil {
+		callables = append(callables, dApp.Verifier)
+	}
+	for i, d := range dApp.Declarations {
+		name, isFunction, ok := declaration(d)
+		if !ok || generated(name) {
+			continue
+		}
+		used := false
+		for _, next := range dApp.Declarations[i+1:] {
+			if uses(next, name, isFunction) {
+				used = true
+				break
+			}
+		}
+		for _, c := range callables {
+			if used {
+				break
+			}
+			if !isFunction && c.AnnotationInvokeName == name {
+				continue
+			}
+			used = usesInFunction(c.FuncDecl, name, isFunction)
+		}
+		if !used {
+			l.warnUnused("", name, isFunction)
+		}
+	}
+}
+
+func (l *Linter) checkUnusedLocals(function string, e ast.Expr) {
+	walk(e, func(e ast.Expr) {
+		switch v := e.(type) {
+		case *ast.Block:
+			if !generated(v.Let.Name) && !uses(v.Body, v.Let.Name, false) {
+				l.warnUnused(function, v.Let.Name, false)
+			}
+		case *ast.BlockV2:
+			name, isFunction, ok := declaration(v.Decl)
+			if ok && !generated(name) && !uses(v.Body, name, isFunction) {
+				l.warnUnused(function, name, isFunction)
+			}
+		}
+	})
+}
+
+// instanceCheck recognizes the conditions produced by compiler for the cases of match expression.
+// It returns the name of match variable and the types checked by the condition.
+func instanceCheck(e ast.Expr) (string, []string, bool) {
+	if v, ok := e.(*ast.IfExpr); ok {
+		// Union of types `case t: A|B` is compiled to `if (_isInstanceOf(m, "A")) then true else _isInstanceOf(m, "B")`
+		if b, ok := v.True.(*ast.BooleanExpr); !ok || !b.Value {
+			return "", nil, false
+		}
+		ref, first, ok := instanceCheck(v.Condition)
+		if !ok {
+			return "", nil, false
+		}
+		other, second, ok := instanceCheck(v.False)
+		if !ok || other != ref {
+			return "", nil, false
+		}
+		return ref, append(first, second...), true
+	}
+	call, ok := functionCall(e)
+	if !ok || call.Name != instanceOfFunction || len(call.Argv) != 2 {
+		return "", nil, false
+	}
+	ref, ok := call.Argv[0].(*ast.RefExpr)
+	if !ok {
+		return "", nil, false
+	}
+	t, ok := call.Argv[1].(*ast.StringExpr)
+	if !ok {
+		return "", nil, false
+	}
+	return ref.Name, []string{t.Value}, true
+}
+
+func (l *Linter) checkMatches(function string, e ast.Expr) {
+	if v, ok := e.(*ast.IfExpr); ok {
+		if ref, _, ok := instanceCheck(v.Condition); ok {
+			matched := make(map[string]bool)
+			var rest ast.Expr = v
+			for {
+				c, ok := rest.(*ast.IfExpr)
+				if !ok {
+					break
+				}
+				r, types, ok := instanceCheck(c.Condition)
+				if !ok || r != ref {
+					break
+				}
+				reachable := false
+				for _, t := range types {
+					if !matched[t] {
+						reachable = true
+						matched[t] = true
+					}
+				}
+				if !reachable {
+					l.warn(UnreachableCase, function, "match case '%s' is unreachable, the type is matched by previous cases", strings.Join(types, "|"))
+				}
+				l.checkMatches(function, c.True)
+				rest = c.False
+			}
+			l.checkMatches(function, rest)
+			return
+		}
+	}
+	for _, c := range children(e) {
+		l.checkMatches(function, c)
+	}
+}
+
+// alwaysTrue reports whether the expression evaluates to true regardless of the input.
+// Lets holds the names of variables known to be always true.
+func alwaysTrue(e ast.Expr, lets map[string]bool) bool {
+	with := func(name string, value bool) map[string]bool {
+		r := make(map[string]bool, len(lets)+1)
+		for k, v := range lets {
+			r[k] = v
+		}
+		r[name] = value
+		return r
+	}
+	switch v := e.(type) {
+	case *ast.BooleanExpr:
+		return v.Value
+	case *ast.RefExpr:
+		return lets[v.Name]
+	case *ast.Block:
+		return alwaysTrue(v.Body, with(v.Let.Name, alwaysTrue(v.Let.Value, lets)))
+	case *ast.BlockV2:
+		if d, ok := v.Decl.(*ast.LetExpr); ok {
+			return alwaysTrue(v.Body, with(d.Name, alwaysTrue(d.Value, lets)))
+		}
+		return alwaysTrue(v.Body, lets)
+	case *ast.IfExpr:
+		if c, ok := v.Condition.(*ast.BooleanExpr); ok {
+			if c.Value {
+				return alwaysTrue(v.True, lets)
+			}
+			return alwaysTrue(v.False, lets)
+		}
+		return alwaysTrue(v.True, lets) && alwaysTrue(v.False, lets)
+	default:
+		return false
+	}
+}
+
+// sources is a set of values an expression depends on: the arguments of callable function and the caller.
+type sources map[string]bool
+
+func (s sources) union(other sources) sources {
+	if len(other) == 0 {
+		return s
+	}
+	r := make(sources, len(s)+len(other))
+	for k := range s {
+		r[k] = true
+	}
+	for k := range other {
+		r[k] = true
+	}
+	return r
+}
+
+// function is a user function together with the scope of its declaration.
+type function struct {
+	decl  *ast.FuncDeclaration
+	scope *scope
+}
+
+type scope struct {
+	vars  map[string]sources
+	funcs map[string]function
+}
+
+func newScope() *scope {
+	return &scope{vars: make(map[string]sources), funcs: make(map[string]function)}
+}
+
+func (s *scope) withVar(name string, src sources) *scope {
+	vars := make(map[string]sources, len(s.vars)+1)
+	for k, v := range s.vars {
+		vars[k] = v
+	}
+	vars[name] = src
+	return &scope{vars: vars, funcs: s.funcs}
+}
+
+// withFunc declares the function in the scope, the body of function is evaluated in the scope of its declaration.
+func (s *scope) withFunc(fn *ast.FuncDeclaration) *scope {
+	funcs := make(map[string]function, len(s.funcs)+1)
+	for k, v := range s.funcs {
+		funcs[k] = v
+	}
+	funcs[fn.Name] = function{decl: fn, scope: s}
+	return &scope{vars: s.vars, funcs: funcs}
+}
+
+type dataKey struct {
+	constructor string
+	sources     sources
+}
+
+// flow tracks how the arguments and the caller of callable function reach the conditions, data keys and transfers.
+type flow struct {
+	checked   sources
+	keys      []dataKey
+	transfers bool
+}
+
+func (f *flow) eval(e ast.Expr, s *scope) sources {
+	switch v := e.(type) {
+	case *ast.RefExpr:
+		return s.vars[v.Name]
+	case *ast.GetterExpr:
+		src := f.eval(v.Object, s)
+		if src[invocationSource] {
+			if callerFields[v.Key] {
+				return sources{callerSource: true}
+			}
+			return nil
+		}
+		return src
+	case *ast.FuncCallExpr:
+		return f.eval(v.Func, s)
+	case *ast.FunctionCall:
+		var r sources
+		args := make([]sources, len(v.Argv))
+		for i, a := range v.Argv {
+			args[i] = f.eval(a, s)
+			r = r.union(args[i])
+		}
+		if fn, ok := s.funcs[v.Name]; ok {
+			fs := fn.scope
+			for i, a := range fn.decl.Args {
+				if i < len(args) {
+					fs = fs.withVar(a, args[i])
+				}
+			}
+			return f.eval(fn.decl.Body, fs)
+		}
+		if dataEntryConstructors[v.Name] && len(args) > 0 {
+			f.keys = append(f.keys, dataKey{constructor: v.Name, sources: args[0]})
+		}
+		if v.Name == transferFunction {
+			f.transfers = true
+		}
+		return r
+	case *ast.IfExpr:
+		c := f.eval(v.Condition, s)
+		f.checked = f.checked.union(c)
+		return c.union(f.eval(v.True, s)).union(f.eval(v.False, s))
+	case *ast.Block:
+		return f.eval(v.Body, s.withVar(v.Let.Name, f.eval(v.Let.Value, s)))
+	case *ast.BlockV2:
+		switch d := v.Decl.(type) {
+		case *ast.LetExpr:
+			return f.eval(v.Body, s.withVar(d.Name, f.eval(d.Value, s)))
+		case *ast.FuncDeclaration:
+			return f.eval(v.Body, s.withFunc(d))
+		}
+		return f.eval(v.Body, s)
+	case *ast.ArrayExpr:
+		var r sources
+		for _, item := range v.Items {
+			r = r.union(f.eval(item, s))
+		}
+		return r
+	default:
+		return nil
+	}
+}
+
+func (l *Linter) checkCallable(dApp *ast.DApp, callable *ast.DappCallableFunc) {
+	fn := callable.FuncDecl
+	s := newScope()
+	for _, d := range dApp.Declarations {
+		switch decl := d.(type) {
+		case *ast.LetExpr:
+			s = s.withVar(decl.Name, (&flow{}).eval(decl.Value, s))
+		case *ast.FuncDeclaration:
+			s = s.withFunc(decl)
+		}
+	}
+	s = s.withVar(callable.AnnotationInvokeName, sources{invocationSource: true})
+	for _, a := range fn.Args {
+		s = s.withVar(a, sources{a: true})
+	}
+	f := &flow{}
+	f.eval(fn.Body, s)
+	if f.transfers && !f.checked[callerSource] {
+		l.warn(MissingCallerCheck, fn.Name, "function makes transfers without checking the caller")
+	}
+	reported := make(map[string]bool)
+	for _, k := range f.keys {
+		args := make([]string, 0, len(k.sources))
+		for src := range k.sources {
+			if contains(fn.Args, src) && !f.checked[src] {
+				args = append(args, src)
+			}
+		}
+		sort.Strings(args)
+		for _, a := range args {
+			if reported[k.constructor+a] {
+				continue
+			}
+			reported[k.constructor+a] = true
+			l.warn(UncheckedDataKey, fn.Name, "key of %s depends on argument '%s' that is never checked", k.constructor, a)
+		}
+	}
+}
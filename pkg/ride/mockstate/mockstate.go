@@ -98,6 +98,10 @@ func (a State) RetrieveNewestBinaryEntry(account proto.Recipient, key string) (*
 	return bv, nil
 }
 
+func (a State) IsStateUntouched(account proto.Recipient) (bool, error) {
+	return len(a.DataEntries) == 0, nil
+}
+
 func (a State) NewestTransactionByID(b []byte) (proto.Transaction, error) {
 	t, ok := a.TransactionsByID[base58.Encode(b)]
 	if !ok {
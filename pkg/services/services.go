@@ -2,9 +2,13 @@ package services
 
 import (
 	"github.com/wavesplatform/gowaves/pkg/libs/runner"
+	"github.com/wavesplatform/gowaves/pkg/miner/microblock"
+	"github.com/wavesplatform/gowaves/pkg/miner/utxpool"
 	"github.com/wavesplatform/gowaves/pkg/node/messages"
 	"github.com/wavesplatform/gowaves/pkg/node/peer_manager"
+	"github.com/wavesplatform/gowaves/pkg/node/state_fsm/status"
 	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/signer"
 	"github.com/wavesplatform/gowaves/pkg/state"
 	"github.com/wavesplatform/gowaves/pkg/types"
 )
@@ -29,11 +33,19 @@ type Services struct {
 	Scheduler       types.Scheduler
 	BlocksApplier   BlocksApplier
 	UtxPool         types.UtxPool
-	Scheme          proto.Scheme
-	InvRequester    types.InvRequester
-	LoggableRunner  runner.LogRunner
-	Time            types.Time
-	Wallet          types.EmbeddedWallet
-	MicroBlockCache MicroBlockCache
-	InternalChannel chan messages.InternalMessage
+	UtxMaintainer   *utxpool.Maintainer
+	PackingStrategy types.PackingStrategy
+	// zero value means default settings
+	MicroblockSettings microblock.Settings
+	MicroblockStats    *microblock.Stats
+	Scheme             proto.Scheme
+	InvRequester       types.InvRequester
+	LoggableRunner     runner.LogRunner
+	Time               types.Time
+	Wallet             types.EmbeddedWallet
+	Signer             signer.Signer
+	MicroBlockCache    MicroBlockCache
+	Voting             types.MinerVoting
+	FsmStatus          *status.Tracker
+	InternalChannel    chan messages.InternalMessage
 }
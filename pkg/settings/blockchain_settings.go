@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/rakyll/statik/fs"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	_ "github.com/wavesplatform/gowaves/pkg/settings/embedded"
 )
@@ -57,8 +58,12 @@ type FunctionalitySettings struct {
 	AddressSchemeCharacter proto.Scheme `json:"address_scheme_character"`
 
 	AverageBlockDelaySeconds uint64 `json:"average_block_delay_seconds"`
+	// Allows blocks generated without waiting for the delay given by PoS, works only on custom blockchains.
+	DevMining bool `json:"dev_mining,omitempty"`
 	// Configurable.
 	MaxBaseTarget uint64 `json:"max_base_target"`
+	// Overrides the limits of miner, zero values are replaced with the defaults for current features.
+	MiningConstraints MiningConstraints `json:"mining_constraints"`
 
 	// Block Reward
 	BlockRewardTerm         uint64 `json:"block_reward_term"`
@@ -69,6 +74,13 @@ type FunctionalitySettings struct {
 	MinUpdateAssetInfoInterval uint64 `json:"min_update_asset_info_interval"`
 }
 
+type MiningConstraints struct {
+	MaxScriptRunsInBlock        int `json:"max_script_runs_in_block,omitempty"`
+	MaxScriptsComplexityInBlock int `json:"max_scripts_complexity_in_block,omitempty"`
+	ClassicAmountOfTxsInBlock   int `json:"classic_amount_of_txs_in_block,omitempty"`
+	MaxTxsSizeInBytes           int `json:"max_txs_size_in_bytes,omitempty"`
+}
+
 func (f *FunctionalitySettings) VotesForFeatureElection(height uint64) uint64 {
 	if height > f.DoubleFeaturesPeriodsAfterHeight {
 		return f.VotesForFeatureActivation * 2
@@ -85,10 +97,35 @@ func (f *FunctionalitySettings) ActivationWindowSize(height uint64) uint64 {
 	}
 }
 
+// Checkpoint fixes the block at height, blocks conflicting with it are not accepted and
+// blocks below it can't be rolled back.
+type Checkpoint struct {
+	Height  uint64        `json:"height"`
+	BlockID proto.BlockID `json:"id"`
+}
+
 type BlockchainSettings struct {
 	FunctionalitySettings
 	Type    BlockchainType `json:"type"`
 	Genesis proto.Block    `json:"genesis"`
+	// Checkpoints known in advance.
+	Checkpoints []Checkpoint `json:"checkpoints,omitempty"`
+	// Checkpoints received from network are accepted only if they are signed with this key.
+	CheckpointsPublicKey *crypto.PublicKey `json:"checkpoints_public_key,omitempty"`
+}
+
+// DevMiningEnabled tells if blocks can be generated without PoS delays.
+// It's never true for public blockchains, even if their settings are modified.
+func (s *BlockchainSettings) DevMiningEnabled() bool {
+	if !s.DevMining || s.Type != Custom {
+		return false
+	}
+	switch s.AddressSchemeCharacter {
+	case proto.MainNetScheme, proto.TestNetScheme, proto.StageNetScheme:
+		return false
+	default:
+		return true
+	}
 }
 
 var (
@@ -0,0 +1,14 @@
+package settings
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// IDs of features are the same as in the reference node, they are voted for in block headers.
+func TestFeatureIDs(t *testing.T) {
+	assert.EqualValues(t, 14, BlockReward)
+	assert.EqualValues(t, 15, BlockV5)
+	assert.EqualValues(t, 16, SynchronousCalls)
+}
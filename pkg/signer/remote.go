@@ -0,0 +1,171 @@
+package signer
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+const (
+	unixPrefix     = "unix://"
+	requestTimeout = 10 * time.Second
+
+	keysPath = "/keys"
+	signPath = "/sign"
+)
+
+type signRequest struct {
+	Kind      Kind             `json:"kind"`
+	PublicKey crypto.PublicKey `json:"public_key"`
+	Protobuf  bool             `json:"protobuf,omitempty"`
+	Data      []byte           `json:"data"`
+}
+
+type signResponse struct {
+	Data []byte `json:"data"`
+}
+
+type keysResponse struct {
+	PublicKeys []crypto.PublicKey `json:"public_keys"`
+}
+
+// RemoteSigner sends everything to sign to the external signer process.
+type RemoteSigner struct {
+	client *http.Client
+	url    string
+	scheme proto.Scheme
+}
+
+// NewRemoteSigner creates the client of signer listening on the address.
+// The address is either HTTP URL or the path to Unix socket prefixed with "unix://".
+func NewRemoteSigner(address string, scheme proto.Scheme) (*RemoteSigner, error) {
+	if strings.HasPrefix(address, unixPrefix) {
+		path := strings.TrimPrefix(address, unixPrefix)
+		transport := &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", path)
+			},
+		}
+		return &RemoteSigner{
+			client: &http.Client{Transport: transport, Timeout: requestTimeout},
+			url:    "http://signer",
+			scheme: scheme,
+		}, nil
+	}
+	if !strings.HasPrefix(address, "http://") && !strings.HasPrefix(address, "https://") {
+		return nil, errors.Errorf("invalid signer address '%s'", address)
+	}
+	return &RemoteSigner{
+		client: &http.Client{Timeout: requestTimeout},
+		url:    strings.TrimSuffix(address, "/"),
+		scheme: scheme,
+	}, nil
+}
+
+func (a *RemoteSigner) do(req *http.Request, out interface{}) error {
+	rsp, err := a.client.Do(req)
+	if err != nil {
+		return errors.Wrap(err, "signer")
+	}
+	defer rsp.Body.Close()
+	if rsp.StatusCode != http.StatusOK {
+		msg, _ := ioutil.ReadAll(rsp.Body)
+		return errors.Errorf("signer: %s: %s", rsp.Status, strings.TrimSpace(string(msg)))
+	}
+	return json.NewDecoder(rsp.Body).Decode(out)
+}
+
+func (a *RemoteSigner) sign(kind Kind, pk crypto.PublicKey, protobuf bool, data []byte) ([]byte, error) {
+	body, err := json.Marshal(signRequest{Kind: kind, PublicKey: pk, Protobuf: protobuf, Data: data})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, a.url+signPath, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	var rsp signResponse
+	if err := a.do(req, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.Data, nil
+}
+
+func (a *RemoteSigner) PublicKeys() ([]crypto.PublicKey, error) {
+	req, err := http.NewRequest(http.MethodGet, a.url+keysPath, nil)
+	if err != nil {
+		return nil, err
+	}
+	var rsp keysResponse
+	if err := a.do(req, &rsp); err != nil {
+		return nil, err
+	}
+	return rsp.PublicKeys, nil
+}
+
+func (a *RemoteSigner) SignBlock(pk crypto.PublicKey, b *proto.Block) error {
+	data, err := b.Marshal(a.scheme)
+	if err != nil {
+		return err
+	}
+	sig, err := a.sign(BlockKind, pk, b.Version >= proto.ProtoBlockVersion, data)
+	if err != nil {
+		return err
+	}
+	b.BlockSignature, err = crypto.NewSignatureFromBytes(sig)
+	return err
+}
+
+func (a *RemoteSigner) SignMicroBlock(pk crypto.PublicKey, micro *proto.MicroBlock) error {
+	data, err := micro.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	sig, err := a.sign(MicroBlockKind, pk, false, data)
+	if err != nil {
+		return err
+	}
+	micro.Signature, err = crypto.NewSignatureFromBytes(sig)
+	return err
+}
+
+func (a *RemoteSigner) SignMicroBlockInv(pk crypto.PublicKey, inv *proto.MicroBlockInv) error {
+	data, err := inv.MarshalBinary()
+	if err != nil {
+		return err
+	}
+	sig, err := a.sign(MicroBlockInvKind, pk, false, data)
+	if err != nil {
+		return err
+	}
+	inv.Signature, err = crypto.NewSignatureFromBytes(sig)
+	return err
+}
+
+// SignTransactionWith sends the transaction to signer in JSON and replaces it with the signed one returned.
+func (a *RemoteSigner) SignTransactionWith(pk crypto.PublicKey, tx proto.Transaction) error {
+	data, err := json.Marshal(tx)
+	if err != nil {
+		return err
+	}
+	signed, err := a.sign(TransactionKind, pk, false, data)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(signed, tx)
+}
+
+func (a *RemoteSigner) GenerationSignature(pk crypto.PublicKey, msg []byte) ([]byte, error) {
+	return a.sign(GenerationSignatureKind, pk, false, msg)
+}
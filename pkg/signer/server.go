@@ -0,0 +1,220 @@
+package signer
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"go.uber.org/zap"
+)
+
+var kinds = []Kind{BlockKind, MicroBlockKind, MicroBlockInvKind, GenerationSignatureKind, TransactionKind}
+
+// MiningKinds are the kinds of objects required for mining.
+var MiningKinds = []Kind{BlockKind, MicroBlockKind, MicroBlockInvKind, GenerationSignatureKind}
+
+// Policy defines the kinds of objects the signer agrees to sign.
+type Policy map[Kind]bool
+
+func NewPolicy(allowed ...Kind) Policy {
+	p := make(Policy)
+	for _, k := range allowed {
+		p[k] = true
+	}
+	return p
+}
+
+// ParsePolicy parses the comma separated list of allowed kinds.
+// Words "mining" and "all" stand for all kinds required for mining and all known kinds respectively.
+func ParsePolicy(s string) (Policy, error) {
+	p := make(Policy)
+	for _, w := range strings.Split(s, ",") {
+		switch w = strings.TrimSpace(w); w {
+		case "":
+			continue
+		case "mining":
+			for _, k := range MiningKinds {
+				p[k] = true
+			}
+		case "all":
+			for _, k := range kinds {
+				p[k] = true
+			}
+		default:
+			known := false
+			for _, k := range kinds {
+				if Kind(w) == k {
+					p[k] = true
+					known = true
+				}
+			}
+			if !known {
+				return nil, errors.Errorf("unknown kind '%s' in signer policy", w)
+			}
+		}
+	}
+	return p, nil
+}
+
+type badRequestError struct {
+	error
+}
+
+// Server serves the requests of RemoteSigner, it signs with underlying signer the objects allowed by policy.
+// Every object is decoded and checked to belong to the requested account before signing.
+type Server struct {
+	signer Signer
+	scheme proto.Scheme
+	policy Policy
+}
+
+func NewServer(signer Signer, scheme proto.Scheme, policy Policy) *Server {
+	return &Server{signer: signer, scheme: scheme, policy: policy}
+}
+
+func (a *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	switch {
+	case r.URL.Path == keysPath && r.Method == http.MethodGet:
+		a.keys(w)
+	case r.URL.Path == signPath && r.Method == http.MethodPost:
+		a.sign(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (a *Server) keys(w http.ResponseWriter) {
+	pks, err := a.signer.PublicKeys()
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	if pks == nil {
+		pks = make([]crypto.PublicKey, 0)
+	}
+	sendJSON(w, keysResponse{PublicKeys: pks})
+}
+
+func (a *Server) sign(w http.ResponseWriter, r *http.Request) {
+	var req signRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	if !a.policy[req.Kind] {
+		zap.S().Warnf("Signer: refused to sign %s with key %s", req.Kind, req.PublicKey.String())
+		http.Error(w, "signing of "+string(req.Kind)+" is not allowed", http.StatusForbidden)
+		return
+	}
+	data, err := a.signData(req)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch err.(type) {
+		case badRequestError:
+			status = http.StatusBadRequest
+		default:
+			if err == PublicKeyNotFound {
+				status = http.StatusNotFound
+			}
+		}
+		http.Error(w, err.Error(), status)
+		return
+	}
+	zap.S().Debugf("Signer: signed %s with key %s", req.Kind, req.PublicKey.String())
+	sendJSON(w, signResponse{Data: data})
+}
+
+func checkOwner(kind Kind, owner, pk crypto.PublicKey) error {
+	if owner != pk {
+		return badRequestError{errors.Errorf("%s belongs to %s, not to %s", kind, owner.String(), pk.String())}
+	}
+	return nil
+}
+
+func (a *Server) signData(req signRequest) ([]byte, error) {
+	switch req.Kind {
+	case BlockKind:
+		b := &proto.Block{}
+		var err error
+		if req.Protobuf {
+			err = b.UnmarshalFromProtobuf(req.Data)
+		} else {
+			err = b.UnmarshalBinary(req.Data, a.scheme)
+		}
+		if err != nil {
+			return nil, badRequestError{err}
+		}
+		if err := checkOwner(req.Kind, b.GenPublicKey, req.PublicKey); err != nil {
+			return nil, err
+		}
+		if err := a.signer.SignBlock(req.PublicKey, b); err != nil {
+			return nil, err
+		}
+		return b.BlockSignature.Bytes(), nil
+	case MicroBlockKind:
+		micro := &proto.MicroBlock{}
+		if err := micro.UnmarshalBinary(req.Data, a.scheme); err != nil {
+			return nil, badRequestError{err}
+		}
+		if err := checkOwner(req.Kind, micro.SenderPK, req.PublicKey); err != nil {
+			return nil, err
+		}
+		if err := a.signer.SignMicroBlock(req.PublicKey, micro); err != nil {
+			return nil, err
+		}
+		return micro.Signature.Bytes(), nil
+	case MicroBlockInvKind:
+		inv := &proto.MicroBlockInv{}
+		if err := inv.UnmarshalBinary(req.Data); err != nil {
+			return nil, badRequestError{err}
+		}
+		if err := checkOwner(req.Kind, inv.PublicKey, req.PublicKey); err != nil {
+			return nil, err
+		}
+		if err := a.signer.SignMicroBlockInv(req.PublicKey, inv); err != nil {
+			return nil, err
+		}
+		return inv.Signature.Bytes(), nil
+	case TransactionKind:
+		tx, err := unmarshalTransaction(req.Data)
+		if err != nil {
+			return nil, badRequestError{err}
+		}
+		if err := checkOwner(req.Kind, tx.GetSenderPK(), req.PublicKey); err != nil {
+			return nil, err
+		}
+		if err := a.signer.SignTransactionWith(req.PublicKey, tx); err != nil {
+			return nil, err
+		}
+		return json.Marshal(tx)
+	case GenerationSignatureKind:
+		return a.signer.GenerationSignature(req.PublicKey, req.Data)
+	default:
+		return nil, badRequestError{errors.Errorf("unknown kind '%s'", req.Kind)}
+	}
+}
+
+func unmarshalTransaction(data []byte) (proto.Transaction, error) {
+	tt := proto.TransactionTypeVersion{}
+	if err := json.Unmarshal(data, &tt); err != nil {
+		return nil, err
+	}
+	tx, err := proto.GuessTransactionType(&tt)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, tx); err != nil {
+		return nil, err
+	}
+	return tx, nil
+}
+
+func sendJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		zap.S().Errorf("Signer: failed to send response: %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package signer
+
+import (
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+var PublicKeyNotFound = errors.New("public key not found")
+
+// Kind is the kind of object to sign, policies of signer are defined in terms of kinds.
+type Kind string
+
+const (
+	BlockKind               Kind = "block"
+	MicroBlockKind          Kind = "microblock"
+	MicroBlockInvKind       Kind = "microblock-inv"
+	GenerationSignatureKind Kind = "generation-signature"
+	TransactionKind         Kind = "transaction"
+)
+
+// Signer holds the secret keys of node's accounts and signs everything on behalf of them.
+type Signer interface {
+	// PublicKeys returns the public keys of all accounts available for signing.
+	PublicKeys() ([]crypto.PublicKey, error)
+	SignBlock(pk crypto.PublicKey, b *proto.Block) error
+	SignMicroBlock(pk crypto.PublicKey, micro *proto.MicroBlock) error
+	SignMicroBlockInv(pk crypto.PublicKey, inv *proto.MicroBlockInv) error
+	SignTransactionWith(pk crypto.PublicKey, tx proto.Transaction) error
+	// GenerationSignature calculates VRF proof of the message, used as generation signature of blocks after BlockV5.
+	GenerationSignature(pk crypto.PublicKey, msg []byte) ([]byte, error)
+}
+
+type seeder interface {
+	Seeds() [][]byte
+}
+
+// LocalSigner signs with the keys generated from seeds of wallet in the node process.
+type LocalSigner struct {
+	seeder seeder
+	scheme proto.Scheme
+}
+
+func NewLocalSigner(seeder seeder, scheme proto.Scheme) *LocalSigner {
+	return &LocalSigner{seeder: seeder, scheme: scheme}
+}
+
+func (a *LocalSigner) PublicKeys() ([]crypto.PublicKey, error) {
+	var out []crypto.PublicKey
+	for _, s := range a.seeder.Seeds() {
+		_, pk, err := crypto.GenerateKeyPair(s)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, pk)
+	}
+	return out, nil
+}
+
+func (a *LocalSigner) secret(pk crypto.PublicKey) (crypto.SecretKey, error) {
+	for _, s := range a.seeder.Seeds() {
+		sk, public, err := crypto.GenerateKeyPair(s)
+		if err != nil {
+			return crypto.SecretKey{}, err
+		}
+		if public == pk {
+			return sk, nil
+		}
+	}
+	return crypto.SecretKey{}, PublicKeyNotFound
+}
+
+func (a *LocalSigner) SignBlock(pk crypto.PublicKey, b *proto.Block) error {
+	sk, err := a.secret(pk)
+	if err != nil {
+		return err
+	}
+	return b.Sign(a.scheme, sk)
+}
+
+func (a *LocalSigner) SignMicroBlock(pk crypto.PublicKey, micro *proto.MicroBlock) error {
+	sk, err := a.secret(pk)
+	if err != nil {
+		return err
+	}
+	return micro.Sign(sk)
+}
+
+func (a *LocalSigner) SignMicroBlockInv(pk crypto.PublicKey, inv *proto.MicroBlockInv) error {
+	sk, err := a.secret(pk)
+	if err != nil {
+		return err
+	}
+	return inv.Sign(sk, a.scheme)
+}
+
+func (a *LocalSigner) SignTransactionWith(pk crypto.PublicKey, tx proto.Transaction) error {
+	sk, err := a.secret(pk)
+	if err != nil {
+		return err
+	}
+	return tx.Sign(a.scheme, sk)
+}
+
+func (a *LocalSigner) GenerationSignature(pk crypto.PublicKey, msg []byte) ([]byte, error) {
+	sk, err := a.secret(pk)
+	if err != nil {
+		return nil, err
+	}
+	return crypto.SignVRF(sk, msg)
+}
@@ -0,0 +1,162 @@
+package signer
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+)
+
+type seeds [][]byte
+
+func (a seeds) Seeds() [][]byte {
+	return a
+}
+
+func newLocal(t *testing.T) (*LocalSigner, crypto.PublicKey) {
+	_, pk, err := crypto.GenerateKeyPair([]byte("seed"))
+	require.NoError(t, err)
+	return NewLocalSigner(seeds{[]byte("seed")}, proto.TestNetScheme), pk
+}
+
+func newRemote(t *testing.T, policy Policy) (*RemoteSigner, crypto.PublicKey, func()) {
+	local, pk := newLocal(t)
+	srv := httptest.NewServer(NewServer(local, proto.TestNetScheme, policy))
+	remote, err := NewRemoteSigner(srv.URL, proto.TestNetScheme)
+	require.NoError(t, err)
+	return remote, pk, srv.Close
+}
+
+func testBlockVersion(t *testing.T, pk crypto.PublicKey, version proto.BlockVersion) *proto.Block {
+	nxt := proto.NxtConsensus{BaseTarget: 153722867, GenSignature: make([]byte, crypto.DigestSize)}
+	b, err := proto.CreateBlock(proto.Transactions(nil), 1000, proto.NewBlockIDFromSignature(crypto.Signature{}), pk, nxt, version, nil, -1, proto.TestNetScheme)
+	require.NoError(t, err)
+	return b
+}
+
+func testBlock(t *testing.T, pk crypto.PublicKey) *proto.Block {
+	return testBlockVersion(t, pk, proto.NgBlockVersion)
+}
+
+func testSigner(t *testing.T, s Signer, pk crypto.PublicKey) {
+	pks, err := s.PublicKeys()
+	require.NoError(t, err)
+	assert.Equal(t, []crypto.PublicKey{pk}, pks)
+
+	b := testBlock(t, pk)
+	require.NoError(t, s.SignBlock(pk, b))
+	ok, err := b.VerifySignature(proto.TestNetScheme)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	pb := testBlockVersion(t, pk, proto.ProtoBlockVersion)
+	require.NoError(t, pb.SetTransactionsRootIfPossible(proto.TestNetScheme))
+	require.NoError(t, s.SignBlock(pk, pb))
+	ok, err = pb.VerifySignature(proto.TestNetScheme)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	micro := &proto.MicroBlock{
+		VersionField:          3,
+		SenderPK:              pk,
+		Transactions:          proto.Transactions(nil),
+		Reference:             b.BlockID(),
+		TotalResBlockSigField: b.BlockSignature,
+	}
+	require.NoError(t, s.SignMicroBlock(pk, micro))
+	ok, err = micro.VerifySignature()
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	inv := proto.NewUnsignedMicroblockInv(pk, b.BlockID(), b.BlockID())
+	require.NoError(t, s.SignMicroBlockInv(pk, inv))
+	ok, err = inv.Verify(proto.TestNetScheme)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	tx := proto.NewUnsignedTransferWithSig(pk, proto.OptionalAsset{}, proto.OptionalAsset{}, 1000, 1, 100000, proto.NewRecipientFromAddress(proto.Address{}), &proto.LegacyAttachment{})
+	require.NoError(t, s.SignTransactionWith(pk, tx))
+	ok, err = tx.Verify(proto.TestNetScheme, pk)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	proof, err := s.GenerationSignature(pk, []byte("message"))
+	require.NoError(t, err)
+	ok, _, err = crypto.VerifyVRF(pk, []byte("message"), proof)
+	require.NoError(t, err)
+	assert.True(t, ok)
+
+	_, other, err := crypto.GenerateKeyPair([]byte("other"))
+	require.NoError(t, err)
+	_, err = s.GenerationSignature(other, []byte("message"))
+	assert.Error(t, err)
+}
+
+func TestLocalSigner(t *testing.T) {
+	s, pk := newLocal(t)
+	testSigner(t, s, pk)
+}
+
+func TestRemoteSigner(t *testing.T) {
+	s, pk, stop := newRemote(t, NewPolicy(kinds...))
+	defer stop()
+	testSigner(t, s, pk)
+}
+
+func TestRemoteSigner_UnixSocket(t *testing.T) {
+	dir, err := ioutil.TempDir("", "signer")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "signer.sock")
+	l, err := net.Listen("unix", path)
+	require.NoError(t, err)
+	local, pk := newLocal(t)
+	srv := &http.Server{Handler: NewServer(local, proto.TestNetScheme, NewPolicy(kinds...))}
+	go func() {
+		_ = srv.Serve(l)
+	}()
+	defer srv.Close()
+
+	s, err := NewRemoteSigner(unixPrefix+path, proto.TestNetScheme)
+	require.NoError(t, err)
+	testSigner(t, s, pk)
+}
+
+func TestServer_Policy(t *testing.T) {
+	policy, err := ParsePolicy("mining")
+	require.NoError(t, err)
+	s, pk, stop := newRemote(t, policy)
+	defer stop()
+
+	require.NoError(t, s.SignBlock(pk, testBlock(t, pk)))
+	tx := proto.NewUnsignedTransferWithSig(pk, proto.OptionalAsset{}, proto.OptionalAsset{}, 1000, 1, 100000, proto.NewRecipientFromAddress(proto.Address{}), &proto.LegacyAttachment{})
+	err = s.SignTransactionWith(pk, tx)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "403")
+
+	// block of other generator is not signed
+	_, other, err := crypto.GenerateKeyPair([]byte("other"))
+	require.NoError(t, err)
+	err = s.SignBlock(pk, testBlock(t, other))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "400")
+}
+
+func TestParsePolicy(t *testing.T) {
+	p, err := ParsePolicy("block, transaction")
+	require.NoError(t, err)
+	assert.Equal(t, NewPolicy(BlockKind, TransactionKind), p)
+	p, err = ParsePolicy("all")
+	require.NoError(t, err)
+	assert.Len(t, p, len(kinds))
+	_, err = ParsePolicy("block,everything")
+	assert.Error(t, err)
+}
@@ -275,6 +275,38 @@ func (s *accountsDataStorage) retrieveEntries(addr proto.Address, filter bool) (
 	return entries, nil
 }
 
+// newestIsUntouched checks that no data entries were ever written to the account, including the uncertain ones.
+func (s *accountsDataStorage) newestIsUntouched(addr proto.Address, filter bool) (bool, error) {
+	for id := range s.uncertainEntries {
+		if id.addr == addr {
+			return false, nil
+		}
+	}
+	if _, ok := s.addrToNumMem[addr]; ok {
+		return false, nil
+	}
+	addrNum, err := s.addrToNum(addr)
+	if err == keyvalue.ErrNotFound {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	key := accountsDataStorKey{addrNum: addrNum}
+	iter, err := s.db.NewKeyIterator(key.accountPrefix())
+	if err != nil {
+		return false, err
+	}
+	defer iter.Release()
+
+	for iter.Next() {
+		if _, err := s.hs.latestEntryData(keyvalue.SafeKey(iter), filter); err == nil {
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 func (s *accountsDataStorage) retrieveNewestEntry(addr proto.Address, key string, filter bool) (proto.DataEntry, error) {
 	id := entryId{addr, key}
 	if entry, ok := s.uncertainEntries[id]; ok {
@@ -58,6 +58,8 @@ type StateInfo interface {
 
 	// Retrieve current blockchain settings.
 	BlockchainSettings() (*settings.BlockchainSettings, error)
+	// Checkpoints from settings and network, sorted by height.
+	Checkpoints() []settings.Checkpoint
 
 	Peers() ([]proto.TCPAddr, error)
 
@@ -94,6 +96,8 @@ type StateInfo interface {
 
 	// Asset fee sponsorship.
 	AssetIsSponsored(assetID crypto.Digest) (bool, error)
+	// SponsoredAssetToWaves converts the amount of sponsored asset to WAVES using current sponsorship rate.
+	SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error)
 	AssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error)
 	FullAssetInfo(assetID crypto.Digest) (*proto.FullAssetInfo, error)
 
@@ -152,6 +156,9 @@ type StateModifier interface {
 	// Rollback functionality.
 	RollbackToHeight(height proto.Height) error
 	RollbackTo(removalEdge proto.BlockID) error
+	// AddCheckpoints saves checkpoints received from network,
+	// blocks conflicting with them are rolled back.
+	AddCheckpoints(checkpoints []settings.Checkpoint) error
 
 	// -------------------------
 	// Validation functionality (for UTX).
@@ -164,6 +171,9 @@ type StateModifier interface {
 	ValidateNextTx(tx proto.Transaction, currentTimestamp, parentTimestamp uint64, blockVersion proto.BlockVersion, checkScripts bool) error
 	// ResetValidationList() resets the validation list, so you can ValidateNextTx() from scratch after calling it.
 	ResetValidationList()
+	// ScriptsUsage() returns the number of script runs and the total complexity of scripts of the transactions
+	// validated since the last ResetValidationList(), including account, DApp and asset scripts.
+	ScriptsUsage() (uint64, uint64)
 
 	// Func internally calls ResetValidationList.
 	TxValidation(func(validation TxValidation) error) error
@@ -187,6 +197,7 @@ type NonThreadSafeState = State
 
 type TxValidation interface {
 	ValidateNextTx(tx proto.Transaction, currentTimestamp, parentTimestamp uint64, blockVersion proto.BlockVersion, checkScripts bool) error
+	ScriptsUsage() (uint64, uint64)
 }
 
 type State interface {
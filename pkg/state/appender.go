@@ -472,8 +472,40 @@ func (a *txAppender) checkUtxTxSig(tx proto.Transaction, scripted bool) error {
 		return err
 	}
 	if err := checkTx(tx, !scripted, checkOrder1, checkOrder2, a.settings.AddressSchemeCharacter); err != nil {
+		return NewStateError(TxDataError, err)
+	}
+	return nil
+}
+
+// checkInvokeArguments checks that the function invoked by the transaction exists in DApp and the arguments match
+// the function's signature from DApp's meta. The check is performed only for transactions admitted to UTX,
+// missing recipient or script are reported later by invoke applier.
+func (a *txAppender) checkInvokeArguments(tx proto.Transaction) error {
+	invokeTx, ok := tx.(*proto.InvokeScriptWithProofs)
+	if !ok {
+		return nil
+	}
+	scriptAddr, err := recipientToAddress(invokeTx.ScriptRecipient, a.stor.aliases, true)
+	if err != nil {
+		return nil
+	}
+	hasScript, err := a.stor.scriptsStorage.newestAccountHasScript(*scriptAddr, true)
+	if err != nil {
 		return err
 	}
+	if !hasScript {
+		return nil
+	}
+	script, err := a.stor.scriptsStorage.newestScriptByAddr(*scriptAddr, true)
+	if err != nil {
+		return errors.Wrapf(err, "failed to instantiate script on address '%s'", scriptAddr.String())
+	}
+	if !script.IsDapp() {
+		return nil
+	}
+	if err := script.CheckInvocation(invokeTx.FunctionCall); err != nil {
+		return errors.Wrapf(err, "invalid invocation of DApp '%s'", scriptAddr.String())
+	}
 	return nil
 }
 
@@ -683,6 +715,9 @@ func (a *txAppender) validateNextTx(tx proto.Transaction, currentTimestamp, pare
 	var changes txBalanceChanges
 	switch tx.GetTypeInfo().Type {
 	case proto.InvokeScriptTransaction, proto.ExchangeTransaction:
+		if err := a.checkInvokeArguments(tx); err != nil {
+			return err
+		}
 		fallibleInfo := &fallibleValidationParams{
 			checkerInfo:    checkerInfo,
 			blockInfo:      blockInfo,
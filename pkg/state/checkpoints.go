@@ -0,0 +1,130 @@
+package state
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/keyvalue"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/settings"
+)
+
+const checkpointKeySize = 1 + 8
+
+func checkpointKey(height uint64) []byte {
+	key := make([]byte, checkpointKeySize)
+	key[0] = checkpointKeyPrefix
+	binary.BigEndian.PutUint64(key[1:], height)
+	return key
+}
+
+// checkpoints stores checkpoints from settings and the ones received from network.
+// Only the latter are saved to database.
+type checkpoints struct {
+	db       keyvalue.IterableKeyVal
+	byHeight map[uint64]proto.BlockID
+}
+
+func newCheckpoints(db keyvalue.IterableKeyVal, fromSettings []settings.Checkpoint) (*checkpoints, error) {
+	c := &checkpoints{
+		db:       db,
+		byHeight: make(map[uint64]proto.BlockID),
+	}
+	for _, cp := range fromSettings {
+		c.byHeight[cp.Height] = cp.BlockID
+	}
+	iter, err := db.NewKeyIterator([]byte{checkpointKeyPrefix})
+	if err != nil {
+		return nil, err
+	}
+	defer iter.Release()
+	for iter.Next() {
+		if len(iter.Key()) != checkpointKeySize {
+			return nil, errInvalidDataSize
+		}
+		id, err := proto.NewBlockIDFromBytes(iter.Value())
+		if err != nil {
+			return nil, err
+		}
+		height := binary.BigEndian.Uint64(iter.Key()[1:])
+		if _, ok := c.byHeight[height]; !ok {
+			c.byHeight[height] = id
+		}
+	}
+	if err := iter.Error(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+func (c *checkpoints) blockID(height uint64) (proto.BlockID, bool) {
+	id, ok := c.byHeight[height]
+	return id, ok
+}
+
+// check returns error if block conflicts with checkpoint.
+func (c *checkpoints) check(blockID proto.BlockID, height uint64) error {
+	id, ok := c.byHeight[height]
+	if ok && id != blockID {
+		return errors.Errorf("block '%s' at height %d conflicts with checkpoint '%s'", blockID.String(), height, id.String())
+	}
+	return nil
+}
+
+// highest returns the height of highest checkpoint not above the height.
+func (c *checkpoints) highest(height uint64) (uint64, bool) {
+	var out uint64
+	found := false
+	for h := range c.byHeight {
+		if h <= height && h > out {
+			out, found = h, true
+		}
+	}
+	return out, found
+}
+
+// validate returns error if any of new checkpoints is at zero height or conflicts with already known checkpoint.
+func (c *checkpoints) validate(cps []settings.Checkpoint) error {
+	for _, cp := range cps {
+		if cp.Height == 0 {
+			return errors.New("checkpoint at zero height")
+		}
+		if err := c.check(cp.BlockID, cp.Height); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// add saves new checkpoints, the ones conflicting with already known checkpoints are rejected.
+func (c *checkpoints) add(cps []settings.Checkpoint) error {
+	if err := c.validate(cps); err != nil {
+		return err
+	}
+	batch, err := c.db.NewBatch()
+	if err != nil {
+		return err
+	}
+	for _, cp := range cps {
+		batch.Put(checkpointKey(cp.Height), cp.BlockID.Bytes())
+	}
+	if err := c.db.Flush(batch); err != nil {
+		return err
+	}
+	for _, cp := range cps {
+		c.byHeight[cp.Height] = cp.BlockID
+	}
+	return nil
+}
+
+func (c *checkpoints) list() []settings.Checkpoint {
+	out := make([]settings.Checkpoint, 0, len(c.byHeight))
+	for h, id := range c.byHeight {
+		out = append(out, settings.Checkpoint{Height: h, BlockID: id})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		return out[i].Height < out[j].Height
+	})
+	return out
+}
@@ -191,11 +191,10 @@ func TestMain(m *testing.M) {
 		log.Fatalf("Failed to decode script from base64: %v\n", err)
 	}
 	testGlobal.scriptBytes = scriptBytes
-	scriptAst, err := ast.BuildScript(reader.NewBytesReader(testGlobal.scriptBytes))
+	testGlobal.scriptAst, err = scriptBytesToAst(testGlobal.scriptBytes)
 	if err != nil {
 		log.Fatalf("BuildAst: %v\n", err)
 	}
-	testGlobal.scriptAst = *scriptAst
 	os.Exit(m.Run())
 }
 
@@ -0,0 +1,248 @@
+package state
+
+import (
+	"github.com/pkg/errors"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+)
+
+const (
+	// maxNestedInvocations is the maximum number of DApp invocations made by the scripts of one transaction.
+	maxNestedInvocations = 100
+	// maxTotalInvocationComplexity is the maximum total complexity of all the functions invoked by one transaction.
+	maxTotalInvocationComplexity = 26000
+)
+
+type invocationFrame struct {
+	addr      proto.Address
+	reentrant bool
+	// results of invocations made by the function of frame
+	invocations []*proto.InvocationScriptResult
+}
+
+// dAppInvoker performs synchronous invocations of DApps from the scripts of RIDE V5.
+// The actions of invoked function are applied immediately, the balance changes are saved to intermediate storage,
+// the rest of the changes are uncertain until the whole transaction succeeds.
+type dAppInvoker struct {
+	ia   *invokeApplier
+	tx   *proto.InvokeScriptWithProofs
+	info *fallibleValidationParams
+
+	origin   proto.Address
+	originPK crypto.PublicKey
+	stack    []invocationFrame
+
+	invocations  int
+	complexity   uint64
+	scriptRuns   uint64
+	issuedAssets uint64
+	changes      txBalanceChanges
+}
+
+func newDAppInvoker(ia *invokeApplier, tx *proto.InvokeScriptWithProofs, info *fallibleValidationParams, scriptAddr proto.Address, complexity uint64) (*dAppInvoker, error) {
+	origin, err := proto.NewAddressFromPublicKey(ia.settings.AddressSchemeCharacter, tx.SenderPK)
+	if err != nil {
+		return nil, err
+	}
+	return &dAppInvoker{
+		ia:         ia,
+		tx:         tx,
+		info:       info,
+		origin:     origin,
+		originPK:   tx.SenderPK,
+		stack:      []invocationFrame{{addr: scriptAddr}},
+		complexity: complexity,
+		changes:    newTxBalanceChanges(nil, newTxDiff()),
+	}, nil
+}
+
+// callableName returns the name of function as it's stored in complexities of DApp, default function has empty name in call.
+func callableName(call proto.FunctionCall) string {
+	if call.Name == "" && call.Default {
+		return "default"
+	}
+	return call.Name
+}
+
+// checkReentrancy forbids the invocation of DApp that is already in the call stack, unless one of the DApps
+// invoked after it was called with reentrantInvoke.
+func (d *dAppInvoker) checkReentrancy(addr proto.Address) error {
+	for i := len(d.stack) - 1; i >= 0; i-- {
+		if d.stack[i].addr == addr {
+			return errors.Errorf("reentrant invocation of DApp %s is not allowed", addr.String())
+		}
+		if d.stack[i].reentrant {
+			return nil
+		}
+	}
+	return nil
+}
+
+func (d *dAppInvoker) saveChanges(diff txDiff) error {
+	if err := d.ia.saveIntermediateDiff(diff); err != nil {
+		return err
+	}
+	for key, balanceDiff := range diff {
+		if err := d.changes.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *dAppInvoker) applyPayments(callerPK crypto.PublicKey, from, to proto.Address, payments proto.ScriptPayments) error {
+	if len(payments) > 0 && from == to {
+		return errors.New("paying to DApp itself is forbidden")
+	}
+	for _, p := range payments {
+		if !d.ia.stor.assets.newestAssetExists(p.Asset, !d.info.initialisation) {
+			return errors.Errorf("invalid asset %s in payment", p.Asset.String())
+		}
+		tr := &proto.TransferScriptAction{Recipient: proto.NewRecipientFromAddress(to), Amount: int64(p.Amount), Asset: p.Asset}
+		if p.Asset.Present {
+			ok, res, err := d.ia.validateActionSmartAsset(p.Asset.ID, tr, callerPK, d.info.blockInfo, *d.tx.ID, d.tx.Timestamp, d.info.initialisation, d.info.acceptFailed)
+			if err != nil {
+				return err
+			}
+			if !ok {
+				return errorForSmartAsset(res, p.Asset.ID)
+			}
+			if d.ia.stor.scriptsStorage.newestIsSmartAsset(p.Asset.ID, !d.info.initialisation) {
+				d.scriptRuns++
+			}
+		}
+		diff, err := d.ia.newTxDiffFromPayment(&payment{sender: from, receiver: to, amount: p.Amount, asset: p.Asset}, false, d.info)
+		if err != nil {
+			return err
+		}
+		if err := d.saveChanges(diff); err != nil {
+			return err
+		}
+	}
+	d.changes.appendAddr(to)
+	return nil
+}
+
+// Invoke implements ast.Invoker interface.
+func (d *dAppInvoker) Invoke(dApp proto.Recipient, call proto.FunctionCall, payments proto.ScriptPayments, reentrant bool) (ast.Expr, error) {
+	d.invocations++
+	if d.invocations > maxNestedInvocations {
+		return nil, errors.Errorf("number of DApp invocations exceeds the limit of %d", maxNestedInvocations)
+	}
+	initialisation := d.info.initialisation
+	addr, err := recipientToAddress(dApp, d.ia.stor.aliases, !initialisation)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to resolve invoked DApp address")
+	}
+	if err := d.checkReentrancy(*addr); err != nil {
+		return nil, err
+	}
+	caller := d.stack[len(d.stack)-1].addr
+	callerPK, err := d.ia.stor.scriptsStorage.newestScriptPKByAddr(caller, !initialisation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get public key of DApp %s", caller.String())
+	}
+	script, err := d.ia.stor.scriptsStorage.newestScriptByAddr(*addr, !initialisation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get script of DApp %s", addr.String())
+	}
+	if !script.IsDapp() {
+		return nil, errors.Errorf("script on address %s is not a DApp", addr.String())
+	}
+	if script.Version < 5 {
+		return nil, errors.Errorf("DApp %s has script version %d, only DApps of version 5 and above can be invoked", addr.String(), script.Version)
+	}
+	if err := script.CheckInvocation(call); err != nil {
+		return nil, errors.Wrapf(err, "invalid invocation of DApp %s", addr.String())
+	}
+	scriptPK, err := d.ia.stor.scriptsStorage.newestScriptPKByAddr(*addr, !initialisation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get public key of DApp %s", addr.String())
+	}
+	complexityRecord, err := d.ia.stor.scriptsComplexity.newestScriptComplexityByAddr(*addr, !initialisation)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to get complexity of DApp %s", addr.String())
+	}
+	name := callableName(call)
+	d.complexity += complexityRecord.byFuncs[name]
+	if d.complexity > maxTotalInvocationComplexity {
+		return nil, errors.Errorf("total complexity of invocations exceeds the limit of %d", maxTotalInvocationComplexity)
+	}
+	d.ia.sc.totalComplexity += complexityRecord.byFuncs[name]
+
+	if err := d.applyPayments(callerPK, caller, *addr, payments); err != nil {
+		return nil, err
+	}
+	invocation := &ast.Invocation{
+		Caller:                caller,
+		CallerPublicKey:       callerPK,
+		OriginCaller:          d.origin,
+		OriginCallerPublicKey: d.originPK,
+		Call:                  call,
+		Payments:              payments,
+	}
+	this := ast.NewAddressFromProtoAddress(*addr)
+	lastBlock := ast.NewObjectFromBlockInfo(*d.info.blockInfo)
+	d.stack = append(d.stack, invocationFrame{addr: *addr, reentrant: reentrant})
+	_, actions, value, err := script.InvokeFunction(d.ia.settings.AddressSchemeCharacter, d.ia.state, d.tx, invocation, this, lastBlock, d)
+	frame := d.stack[len(d.stack)-1]
+	d.stack = d.stack[:len(d.stack)-1]
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to invoke DApp %s", addr.String())
+	}
+	if err := d.applyActions(actions, addr, scriptPK); err != nil {
+		return nil, errors.Wrapf(err, "failed to apply actions of DApp %s", addr.String())
+	}
+	changes, err := proto.NewScriptResult(actions, proto.ScriptErrorMessage{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to record result of DApp %s", addr.String())
+	}
+	changes.Invocations = frame.invocations
+	top := &d.stack[len(d.stack)-1]
+	top.invocations = append(top.invocations, &proto.InvocationScriptResult{
+		DApp:         *addr,
+		Call:         call,
+		Payments:     payments,
+		StateChanges: changes,
+	})
+	return value, nil
+}
+
+// results returns the results of invocations made by the function invoked by transaction.
+func (d *dAppInvoker) results() []*proto.InvocationScriptResult {
+	return d.stack[0].invocations
+}
+
+func (d *dAppInvoker) applyActions(actions []proto.ScriptAction, addr *proto.Address, scriptPK crypto.PublicKey) error {
+	if err := d.ia.resolveAliases(actions, d.info.initialisation); err != nil {
+		return errors.New("failed to resolve aliases")
+	}
+	restrictions := proto.ActionsValidationRestrictions{DisableSelfTransfers: true, ScriptAddress: *addr}
+	if err := proto.ValidateActions(actions, restrictions); err != nil {
+		return err
+	}
+	issued, err := d.ia.countIssuedAssets(actions)
+	if err != nil {
+		return err
+	}
+	d.issuedAssets += issued
+	d.scriptRuns += d.ia.countActionScriptRuns(actions, d.info.initialisation)
+	if _, err := d.ia.applyActions(d.tx, actions, addr, scriptPK, d.info, d.changes); err != nil {
+		return err
+	}
+	return nil
+}
+
+// appendChanges appends the balance changes of all nested invocations to the changes of transaction.
+func (d *dAppInvoker) appendChanges(changes txBalanceChanges) error {
+	for addr := range d.changes.addrs {
+		changes.appendAddr(addr)
+	}
+	for key, balanceDiff := range d.changes.diff {
+		if err := changes.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,270 @@
+package state
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
+)
+
+func TestDAppInvokerReentrancy(t *testing.T) {
+	a := testGlobal.senderInfo.addr
+	b := testGlobal.recipientInfo.addr
+	c := testGlobal.minerInfo.addr
+
+	d := &dAppInvoker{stack: []invocationFrame{{addr: a}}}
+	assert.NoError(t, d.checkReentrancy(b))
+	assert.Error(t, d.checkReentrancy(a))
+
+	// A invokes B, then B can't invoke A.
+	d.stack = []invocationFrame{{addr: a}, {addr: b}}
+	assert.Error(t, d.checkReentrancy(a))
+	assert.Error(t, d.checkReentrancy(b))
+	assert.NoError(t, d.checkReentrancy(c))
+
+	// A invokes B with reentrantInvoke, then B can invoke A.
+	d.stack = []invocationFrame{{addr: a}, {addr: b, reentrant: true}}
+	assert.NoError(t, d.checkReentrancy(a))
+
+	// B invokes C, then C can't invoke B, but can invoke A.
+	d.stack = []invocationFrame{{addr: a}, {addr: b, reentrant: true}, {addr: c}}
+	assert.Error(t, d.checkReentrancy(b))
+	assert.NoError(t, d.checkReentrancy(a))
+}
+
+func TestCallableName(t *testing.T) {
+	assert.Equal(t, "default", callableName(proto.FunctionCall{Default: true}))
+	assert.Equal(t, "foo", callableName(proto.FunctionCall{Name: "foo"}))
+}
+
+// The scripts of DApps below are built from bytes, functions are called by their internal names,
+// 1100 is list constructor, 1020 and 1021 are invoke and reentrantInvoke, 0 is equality and 2 is throw.
+
+func rideString(s string) []byte {
+	out := make([]byte, 4, 4+len(s))
+	binary.BigEndian.PutUint32(out, uint32(len(s)))
+	return append(out, s...)
+}
+
+func rideLong(v int64) []byte {
+	out := make([]byte, 9)
+	out[0] = reader.E_LONG
+	binary.BigEndian.PutUint64(out[1:], uint64(v))
+	return out
+}
+
+func rideStr(s string) []byte {
+	return append([]byte{reader.E_STRING}, rideString(s)...)
+}
+
+func rideRef(name string) []byte {
+	return append([]byte{reader.E_REF}, rideString(name)...)
+}
+
+func rideCall(native bool, name string, args ...[]byte) []byte {
+	out := []byte{reader.E_FUNCALL}
+	if native {
+		id, _ := strconv.Atoi(name)
+		out = append(out, reader.FH_NATIVE, byte(id>>8), byte(id))
+	} else {
+		out = append(out, reader.FH_USER)
+		out = append(out, rideString(name)...)
+	}
+	out = append(out, 0, 0, 0, byte(len(args)))
+	for _, a := range args {
+		out = append(out, a...)
+	}
+	return out
+}
+
+func rideList(items ...[]byte) []byte {
+	out := rideRef("nil")
+	for i := len(items) - 1; i >= 0; i-- {
+		out = rideCall(true, "1100", items[i], out)
+	}
+	return out
+}
+
+func rideIf(cond, ifTrue, ifFalse []byte) []byte {
+	out := append([]byte{reader.E_IF}, cond...)
+	return append(append(out, ifTrue...), ifFalse...)
+}
+
+func rideAddress(addr proto.Address) []byte {
+	b := append([]byte{reader.E_BYTES, 0, 0, 0, byte(len(addr))}, addr[:]...)
+	return rideCall(false, "Address", b)
+}
+
+func rideInvoke(reentrant bool, dApp proto.Address, function string, payments ...[]byte) []byte {
+	id := "1020"
+	if reentrant {
+		id = "1021"
+	}
+	return rideCall(true, id, rideAddress(dApp), rideStr(function), rideList(), rideList(payments...))
+}
+
+// rideDAppV5 returns the script of DApp of version 5 without meta, callables are the pairs of name and body
+// of callable functions without arguments.
+func rideDAppV5(callables ...[]byte) proto.Script {
+	out := []byte{0, 1, 5, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, byte(len(callables) / 2)}
+	for i := 0; i < len(callables); i += 2 {
+		out = append(out, rideString("i")...)
+		out = append(out, reader.DEC_FUNC)
+		out = append(out, callables[i]...)
+		out = append(out, 0, 0, 0, 0)
+		out = append(out, callables[i+1]...)
+	}
+	return append(out, 0, 0, 0, 0)
+}
+
+type dAppInvokerTestObjects struct {
+	*invokeApplierTestObjects
+	a, b, c *testAddrData
+}
+
+// createDAppInvokerTestObjects sets DApps A and B of version 5, functions of DApp A call DApp B.
+// Function pay of DApp B receives the payment of 100 from A, transfers 10 to C and returns 42.
+func createDAppInvokerTestObjects(t *testing.T) (*dAppInvokerTestObjects, string) {
+	to, path := createInvokeApplierTestObjects(t)
+	a, b, c := testGlobal.recipientInfo, testGlobal.issuerInfo, testGlobal.matcherInfo
+	payment := rideCall(false, "AttachedPayment", rideRef("unit"), rideLong(100))
+	callB := rideInvoke(false, b.addr, "pay", payment)
+	to.setScript(t, a.addr, a.pk, rideDAppV5(
+		rideString("callB"), rideList(rideCall(false, "IntegerEntry", rideStr("a"), callB)),
+		rideString("callBThenFail"), rideIf(rideCall(true, "0", callB, rideLong(42)), rideCall(true, "2", rideStr("fail")), rideList()),
+		rideString("callFailing"), rideList(rideCall(false, "IntegerEntry", rideStr("a"), rideInvoke(false, b.addr, "fail", payment))),
+		rideString("ping"), rideIf(rideCall(true, "0", rideInvoke(true, b.addr, "ping"), rideRef("unit")), rideList(), rideList()),
+	))
+	to.setScript(t, b.addr, b.pk, rideDAppV5(
+		rideString("pay"), rideCall(false, "Tuple2", rideList(
+			rideCall(false, "IntegerEntry", rideStr("b"), rideLong(1)),
+			rideCall(false, "ScriptTransfer", rideAddress(c.addr), rideLong(10), rideRef("unit")),
+		), rideLong(42)),
+		rideString("fail"), rideCall(true, "2", rideStr("fail")),
+		rideString("ping"), rideIf(rideCall(true, "0", rideInvoke(true, a.addr, "ping"), rideRef("unit")), rideList(), rideList()),
+	))
+	to.setAndCheckInitialWavesBalance(t, testGlobal.senderInfo.addr, invokeFee*10)
+	to.setAndCheckInitialWavesBalance(t, a.addr, 1000)
+	return &dAppInvokerTestObjects{invokeApplierTestObjects: to, a: a, b: b, c: c}, path
+}
+
+func (to *dAppInvokerTestObjects) setComplexity(t *testing.T, addr proto.Address, byFuncs map[string]uint64) {
+	r := &accountScriptComplexityRecord{byFuncs: byFuncs, estimator: 1}
+	err := to.state.stor.scriptsComplexity.saveComplexityForAddr(addr, r, blockID0)
+	require.NoError(t, err)
+}
+
+func (to *dAppInvokerTestObjects) checkBalances(t *testing.T, a, b, c uint64) {
+	for addr, expected := range map[proto.Address]uint64{to.a.addr: a, to.b.addr: b, to.c.addr: c} {
+		balance, err := to.state.NewestAccountBalance(proto.NewRecipientFromAddress(addr), nil)
+		require.NoError(t, err)
+		assert.Equal(t, expected, balance, "balance of %s", addr.String())
+	}
+}
+
+func TestDAppInvokerNestedPaymentsAndActions(t *testing.T) {
+	to, path := createDAppInvokerTestObjects(t)
+
+	defer func() {
+		err := to.state.Close()
+		assert.NoError(t, err, "state.Close() failed")
+		err = os.RemoveAll(path)
+		assert.NoError(t, err, "failed to remove test data dir")
+	}()
+
+	to.state.appender.ia.buildApiData = true
+	info := to.fallibleValidationParams(t)
+	tx := createInvokeScriptWithProofs(t, nil, proto.FunctionCall{Name: "callB"}, feeAsset, invokeFee)
+	res := to.applyAndSaveInvoke(t, tx, info)
+	assert.True(t, res.status)
+	assert.Subset(t, res.changes.addresses(), []proto.Address{to.a.addr, to.b.addr, to.c.addr})
+	to.checkBalances(t, 900, 90, 10)
+	entry, err := to.state.RetrieveNewestEntry(proto.NewRecipientFromAddress(to.a.addr), "a")
+	require.NoError(t, err)
+	assert.Equal(t, &proto.IntegerDataEntry{Key: "a", Value: 42}, entry)
+	entry, err = to.state.RetrieveNewestEntry(proto.NewRecipientFromAddress(to.b.addr), "b")
+	require.NoError(t, err)
+	assert.Equal(t, &proto.IntegerDataEntry{Key: "b", Value: 1}, entry)
+	// Nested invocation and its actions are recorded in the result of the transaction.
+	err = to.state.flush(true)
+	require.NoError(t, err)
+	sr, err := to.state.stor.invokeResults.invokeResult('W', *tx.ID, false)
+	require.NoError(t, err)
+	require.Len(t, sr.Invocations, 1)
+	inv := sr.Invocations[0]
+	assert.Equal(t, to.b.addr, inv.DApp)
+	assert.Equal(t, "pay", inv.Call.Name)
+	require.Len(t, inv.Payments, 1)
+	assert.Equal(t, uint64(100), inv.Payments[0].Amount)
+	assert.Equal(t, []*proto.DataEntryScriptAction{{Entry: &proto.IntegerDataEntry{Key: "b", Value: 1}}}, inv.StateChanges.DataEntries)
+	require.Len(t, inv.StateChanges.Transfers, 1)
+	assert.Equal(t, int64(10), inv.StateChanges.Transfers[0].Amount)
+	assert.Empty(t, inv.StateChanges.Invocations)
+}
+
+func TestDAppInvokerFailedNestedCall(t *testing.T) {
+	for _, function := range []string{"callFailing", "callBThenFail"} {
+		t.Run(function, func(t *testing.T) {
+			to, path := createDAppInvokerTestObjects(t)
+
+			defer func() {
+				err := to.state.Close()
+				assert.NoError(t, err, "state.Close() failed")
+				err = os.RemoveAll(path)
+				assert.NoError(t, err, "failed to remove test data dir")
+			}()
+
+			info := to.fallibleValidationParams(t)
+			info.acceptFailed = true
+			tx := createInvokeScriptWithProofs(t, nil, proto.FunctionCall{Name: function}, feeAsset, invokeFee)
+			res := to.applyAndSaveInvoke(t, tx, info)
+			assert.False(t, res.status)
+			// Only the fee is taken, changes of nested invocation are rolled back.
+			to.checkBalances(t, 1000, 0, 0)
+			balance, err := to.state.NewestAccountBalance(proto.NewRecipientFromAddress(testGlobal.senderInfo.addr), nil)
+			require.NoError(t, err)
+			assert.Equal(t, invokeFee*9, balance)
+			_, err = to.state.RetrieveNewestEntry(proto.NewRecipientFromAddress(to.b.addr), "b")
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestDAppInvokerLimits(t *testing.T) {
+	to, path := createDAppInvokerTestObjects(t)
+
+	defer func() {
+		err := to.state.Close()
+		assert.NoError(t, err, "state.Close() failed")
+		err = os.RemoveAll(path)
+		assert.NoError(t, err, "failed to remove test data dir")
+	}()
+
+	info := to.fallibleValidationParams(t)
+
+	// Complexity of invoked function is added to the complexity of transaction.
+	to.setComplexity(t, to.a.addr, map[string]uint64{"callB": 100, "ping": 1})
+	to.setComplexity(t, to.b.addr, map[string]uint64{"pay": maxTotalInvocationComplexity - 100, "ping": 1})
+	tx := createInvokeScriptWithProofs(t, nil, proto.FunctionCall{Name: "callB"}, feeAsset, invokeFee)
+	_, err := to.state.appender.ia.applyInvokeScript(tx, info)
+	assert.NoError(t, err)
+	to.state.stor.dropUncertain()
+
+	to.setComplexity(t, to.b.addr, map[string]uint64{"pay": maxTotalInvocationComplexity - 99, "ping": 1})
+	tx = createInvokeScriptWithProofs(t, nil, proto.FunctionCall{Name: "callB"}, feeAsset, invokeFee)
+	_, err = to.state.appender.ia.applyInvokeScript(tx, info)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("total complexity of invocations exceeds the limit of %d", maxTotalInvocationComplexity))
+
+	// DApps A and B call each other with reentrantInvoke until the number of invocations exceeds the limit.
+	tx = createInvokeScriptWithProofs(t, nil, proto.FunctionCall{Name: "ping"}, feeAsset, invokeFee)
+	_, err = to.state.appender.ia.applyInvokeScript(tx, info)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), fmt.Sprintf("number of DApp invocations exceeds the limit of %d", maxNestedInvocations))
+}
@@ -1,6 +1,7 @@
 package state
 
 import (
+	"github.com/pkg/errors"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 )
 
@@ -12,6 +13,8 @@ const (
 	NotFoundError
 	SerializationError
 	TxValidationError
+	// Transaction has invalid signature or data, so it's invalid regardless of state.
+	TxDataError
 	ValidationError
 	RollbackError
 	// Errors occurring while getting data from database.
@@ -53,3 +56,21 @@ func IsNotFound(err error) bool {
 	}
 	return (se.errorType == NotFoundError) || (se.errorType == RetrievalError)
 }
+
+// IsValidationError tells whether the error is caused by invalid block or transaction.
+func IsValidationError(err error) bool {
+	se, ok := errors.Cause(err).(StateError)
+	if !ok {
+		return false
+	}
+	return (se.errorType == ValidationError) || (se.errorType == TxValidationError) || (se.errorType == TxDataError)
+}
+
+// IsTxDataError tells whether the transaction is invalid by itself, not because of the current state.
+func IsTxDataError(err error) bool {
+	se, ok := errors.Cause(err).(StateError)
+	if !ok {
+		return false
+	}
+	return se.errorType == TxDataError
+}
@@ -130,22 +130,40 @@ func (ia *invokeApplier) newTxDiffFromScriptBurn(scriptAddr *proto.Address, acti
 	return diff, nil
 }
 
+func (ia *invokeApplier) newTxDiffFromScriptLease(scriptAddr *proto.Address, recipient proto.Address, amount int64) (txDiff, error) {
+	diff := newTxDiff()
+	senderKey := wavesBalanceKey{address: *scriptAddr}
+	if err := diff.appendBalanceDiff(senderKey.bytes(), newBalanceDiff(0, 0, amount, false)); err != nil {
+		return nil, err
+	}
+	receiverKey := wavesBalanceKey{address: recipient}
+	if err := diff.appendBalanceDiff(receiverKey.bytes(), newBalanceDiff(0, amount, 0, false)); err != nil {
+		return nil, err
+	}
+	return diff, nil
+}
+
 func (ia *invokeApplier) saveIntermediateDiff(diff txDiff) error {
 	return ia.invokeDiffStor.saveTxDiff(diff)
 }
 
 func (ia *invokeApplier) resolveAliases(actions []proto.ScriptAction, initialisation bool) error {
 	for i, a := range actions {
-		tr, ok := a.(proto.TransferScriptAction)
-		if !ok {
-			continue
-		}
-		addr, err := recipientToAddress(tr.Recipient, ia.stor.aliases, !initialisation)
-		if err != nil {
-			return err
+		switch ta := a.(type) {
+		case proto.TransferScriptAction:
+			addr, err := recipientToAddress(ta.Recipient, ia.stor.aliases, !initialisation)
+			if err != nil {
+				return err
+			}
+			ta.Recipient = proto.NewRecipientFromAddress(*addr)
+			actions[i] = ta
+		case *proto.LeaseScriptAction:
+			addr, err := recipientToAddress(ta.Recipient, ia.stor.aliases, !initialisation)
+			if err != nil {
+				return err
+			}
+			ta.Recipient = proto.NewRecipientFromAddress(*addr)
 		}
-		tr.Recipient = proto.NewRecipientFromAddress(*addr)
-		actions[i] = tr
 	}
 	return nil
 }
@@ -210,6 +228,7 @@ type addlInvokeInfo struct {
 	actions              []proto.ScriptAction
 	paymentSmartAssets   []crypto.Digest
 	disableSelfTransfers bool
+	invoker              *dAppInvoker
 }
 
 func (ia *invokeApplier) fallibleValidation(tx *proto.InvokeScriptWithProofs, info *addlInvokeInfo) (proto.TxFailureReason, txBalanceChanges, error) {
@@ -238,6 +257,9 @@ func (ia *invokeApplier) fallibleValidation(tx *proto.InvokeScriptWithProofs, in
 	if err != nil {
 		return proto.DAppError, info.failedChanges, err
 	}
+	if info.invoker != nil {
+		issuedAssetsCount += info.invoker.issuedAssets
+	}
 	if err := ia.checkFullFee(tx, info.scriptRuns, issuedAssetsCount); err != nil {
 		return proto.InsufficientActionsFee, info.failedChanges, err
 	}
@@ -251,55 +273,87 @@ func (ia *invokeApplier) fallibleValidation(tx *proto.InvokeScriptWithProofs, in
 	if err := ia.saveIntermediateDiff(totalChanges.diff); err != nil {
 		return proto.DAppError, info.failedChanges, err
 	}
+	if info.invoker != nil {
+		// Changes of nested invocations were saved to storage during the invocation, only append them to common diff.
+		if err := info.invoker.appendChanges(totalChanges); err != nil {
+			return proto.DAppError, info.failedChanges, err
+		}
+	}
 	// Perform actions.
-	for _, action := range info.actions {
+	if code, err := ia.applyActions(tx, info.actions, info.scriptAddr, info.scriptPK, info.fallibleValidationParams, totalChanges); err != nil {
+		return code, info.failedChanges, err
+	}
+	if info.acceptFailed {
+		// Validate total balance changes.
+		if err := ia.diffApplier.validateTxDiff(totalChanges.diff, ia.invokeDiffStor.diffStorage, !info.initialisation); err != nil {
+			// Total balance changes lead to negative balance, hence invoke has failed.
+			// TODO: use different code for negative balances after it is introduced; use better error text here (addr + amount + asset).
+			return proto.DAppError, info.failedChanges, err
+		}
+	}
+	// If we are here, invoke succeeded.
+	ia.blockDiffer.appendBlockInfoToTxDiff(totalChanges.diff, info.block)
+	return 0, totalChanges, nil
+}
+
+// applyActions performs the actions produced by DApp on the address scriptAddr. Balance changes of actions are saved
+// to the intermediate storage and appended to totalChanges.
+func (ia *invokeApplier) applyActions(
+	tx *proto.InvokeScriptWithProofs,
+	actions []proto.ScriptAction,
+	scriptAddr *proto.Address,
+	scriptPK crypto.PublicKey,
+	info *fallibleValidationParams,
+	totalChanges txBalanceChanges,
+) (proto.TxFailureReason, error) {
+	for _, action := range actions {
 		switch a := action.(type) {
 		case *proto.DataEntryScriptAction:
 			// Perform data storage writes.
-			ia.stor.accountsDataStor.appendEntryUncertain(*info.scriptAddr, a.Entry)
+			ia.stor.accountsDataStor.appendEntryUncertain(*scriptAddr, a.Entry)
 		case *proto.TransferScriptAction:
 			// Perform transfers.
 			addr := a.Recipient.Address
 			totalChanges.appendAddr(*addr)
 			assetExists := ia.stor.assets.newestAssetExists(a.Asset, !info.initialisation)
 			if !assetExists {
-				return proto.DAppError, info.failedChanges, errors.New("invalid asset in transfer")
+				return proto.DAppError, errors.New("invalid asset in transfer")
 			}
 			isSmartAsset := ia.stor.scriptsStorage.newestIsSmartAsset(a.Asset.ID, !info.initialisation)
 			if isSmartAsset {
 				fullTr, err := proto.NewFullScriptTransfer(a, tx)
 				if err != nil {
-					return proto.DAppError, info.failedChanges, errors.Wrap(err, "failed to convert transfer to full script transfer")
+					return proto.DAppError, errors.Wrap(err, "failed to convert transfer to full script transfer")
 				}
 				// Call asset script if transferring smart asset.
 				res, err := ia.sc.callAssetScriptWithScriptTransfer(fullTr, a.Asset.ID, info.blockInfo, info.initialisation, info.acceptFailed)
 				if err != nil {
-					return proto.DAppError, info.failedChanges, errors.Wrap(err, "failed to call asset script on transfer set")
+					return proto.DAppError, errors.Wrap(err, "failed to call asset script on transfer set")
 				}
 				if res.Failed() {
-					return proto.SmartAssetOnActionFailure, info.failedChanges, errorForSmartAsset(res, a.Asset.ID)
+					return proto.SmartAssetOnActionFailure, errorForSmartAsset(res, a.Asset.ID)
 				}
 			}
-			txDiff, err := ia.newTxDiffFromScriptTransfer(info.scriptAddr, a, info.fallibleValidationParams)
+			txDiff, err := ia.newTxDiffFromScriptTransfer(scriptAddr, a, info)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// diff must be saved to storage, because further asset scripts must take
 			// recent balance changes into account.
 			if err := ia.saveIntermediateDiff(txDiff); err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// Append intermediate diff to common diff.
 			for key, balanceDiff := range txDiff {
 				if err := totalChanges.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
-					return proto.DAppError, info.failedChanges, err
+					return proto.DAppError, err
 				}
 			}
 		case *proto.IssueScriptAction:
 			// Create asset's info.
 			assetInfo := &assetInfo{
 				assetConstInfo: assetConstInfo{
-					issuer:   info.scriptPK,
+					issuer:   scriptPK,
 					decimals: int8(a.Decimals),
 				},
 				assetChangeableInfo: assetChangeableInfo{
@@ -313,43 +367,43 @@ func (ia *invokeApplier) fallibleValidation(tx *proto.InvokeScriptWithProofs, in
 			// Currently asset script is always empty.
 			// TODO: if this script is ever set, don't forget to
 			// also save complexity for it here using saveComplexityForAsset().
-			ia.stor.scriptsStorage.setAssetScriptUncertain(a.ID, proto.Script{}, info.scriptPK)
-			txDiff, err := ia.newTxDiffFromScriptIssue(info.scriptAddr, a)
+			ia.stor.scriptsStorage.setAssetScriptUncertain(a.ID, proto.Script{}, scriptPK)
+			txDiff, err := ia.newTxDiffFromScriptIssue(scriptAddr, a)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// diff must be saved to storage, because further asset scripts must take
 			// recent balance changes into account.
 			if err := ia.saveIntermediateDiff(txDiff); err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// Append intermediate diff to common diff.
 			for key, balanceDiff := range txDiff {
 				if err := totalChanges.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
-					return proto.DAppError, info.failedChanges, err
+					return proto.DAppError, err
 				}
 			}
 		case *proto.ReissueScriptAction:
 			// Check validity of reissue.
 			assetInfo, err := ia.stor.assets.newestAssetInfo(a.AssetID, !info.initialisation)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
-			if assetInfo.issuer != info.scriptPK {
-				return proto.DAppError, info.failedChanges, errors.New("asset was issued by other address")
+			if assetInfo.issuer != scriptPK {
+				return proto.DAppError, errors.New("asset was issued by other address")
 			}
 			if !assetInfo.reissuable {
-				return proto.DAppError, info.failedChanges, errors.New("attempt to reissue asset which is not reissuable")
+				return proto.DAppError, errors.New("attempt to reissue asset which is not reissuable")
 			}
 			if math.MaxInt64-a.Quantity < assetInfo.quantity.Int64() && info.block.Timestamp >= ia.settings.ReissueBugWindowTimeEnd {
-				return proto.DAppError, info.failedChanges, errors.New("asset total value overflow")
+				return proto.DAppError, errors.New("asset total value overflow")
 			}
-			ok, res, err := ia.validateActionSmartAsset(a.AssetID, a, info.scriptPK, info.blockInfo, *tx.ID, tx.Timestamp, info.initialisation, info.acceptFailed)
+			ok, res, err := ia.validateActionSmartAsset(a.AssetID, a, scriptPK, info.blockInfo, *tx.ID, tx.Timestamp, info.initialisation, info.acceptFailed)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			if !ok {
-				return proto.SmartAssetOnActionFailure, info.failedChanges, errorForSmartAsset(res, a.AssetID)
+				return proto.SmartAssetOnActionFailure, errorForSmartAsset(res, a.AssetID)
 			}
 			// Update asset's info.
 			change := &assetReissueChange{
@@ -357,42 +411,42 @@ func (ia *invokeApplier) fallibleValidation(tx *proto.InvokeScriptWithProofs, in
 				diff:       a.Quantity,
 			}
 			if err := ia.stor.assets.reissueAssetUncertain(a.AssetID, change, !info.initialisation); err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
-			txDiff, err := ia.newTxDiffFromScriptReissue(info.scriptAddr, a)
+			txDiff, err := ia.newTxDiffFromScriptReissue(scriptAddr, a)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// diff must be saved to storage, because further asset scripts must take
 			// recent balance changes into account.
 			if err := ia.saveIntermediateDiff(txDiff); err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// Append intermediate diff to common diff.
 			for key, balanceDiff := range txDiff {
 				if err := totalChanges.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
-					return proto.DAppError, info.failedChanges, err
+					return proto.DAppError, err
 				}
 			}
 		case *proto.BurnScriptAction:
 			// Check burn.
 			assetInfo, err := ia.stor.assets.newestAssetInfo(a.AssetID, !info.initialisation)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			burnAnyTokensEnabled, err := ia.stor.features.isActivated(int16(settings.BurnAnyTokens))
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
-			if !burnAnyTokensEnabled && assetInfo.issuer != info.scriptPK {
-				return proto.DAppError, info.failedChanges, errors.New("asset was issued by other address")
+			if !burnAnyTokensEnabled && assetInfo.issuer != scriptPK {
+				return proto.DAppError, errors.New("asset was issued by other address")
 			}
-			ok, res, err := ia.validateActionSmartAsset(a.AssetID, a, info.scriptPK, info.blockInfo, *tx.ID, tx.Timestamp, info.initialisation, info.acceptFailed)
+			ok, res, err := ia.validateActionSmartAsset(a.AssetID, a, scriptPK, info.blockInfo, *tx.ID, tx.Timestamp, info.initialisation, info.acceptFailed)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			if !ok {
-				return proto.SmartAssetOnActionFailure, info.failedChanges, errorForSmartAsset(res, a.AssetID)
+				return proto.SmartAssetOnActionFailure, errorForSmartAsset(res, a.AssetID)
 			}
 			// Update asset's info
 			// Modify asset.
@@ -400,58 +454,102 @@ func (ia *invokeApplier) fallibleValidation(tx *proto.InvokeScriptWithProofs, in
 				diff: int64(a.Quantity),
 			}
 			if err := ia.stor.assets.burnAssetUncertain(a.AssetID, change, !info.initialisation); err != nil {
-				return proto.DAppError, info.failedChanges, errors.Wrap(err, "failed to burn asset")
+				return proto.DAppError, errors.Wrap(err, "failed to burn asset")
 			}
-			txDiff, err := ia.newTxDiffFromScriptBurn(info.scriptAddr, a)
+			txDiff, err := ia.newTxDiffFromScriptBurn(scriptAddr, a)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// diff must be saved to storage, because further asset scripts must take
 			// recent balance changes into account.
 			if err := ia.saveIntermediateDiff(txDiff); err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			// Append intermediate diff to common diff.
 			for key, balanceDiff := range txDiff {
 				if err := totalChanges.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
-					return proto.DAppError, info.failedChanges, err
+					return proto.DAppError, err
 				}
 			}
 		case *proto.SponsorshipScriptAction:
 			assetInfo, err := ia.stor.assets.newestAssetInfo(a.AssetID, !info.initialisation)
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			sponsorshipActivated, err := ia.stor.features.isActivated(int16(settings.FeeSponsorship))
 			if err != nil {
-				return proto.DAppError, info.failedChanges, err
+				return proto.DAppError, err
 			}
 			if !sponsorshipActivated {
-				return proto.DAppError, info.failedChanges, errors.New("sponsorship has not been activated yet")
+				return proto.DAppError, errors.New("sponsorship has not been activated yet")
 			}
-			if assetInfo.issuer != info.scriptPK {
-				return proto.DAppError, info.failedChanges, errors.Errorf("asset %s was not issued by this DApp", a.AssetID.String())
+			if assetInfo.issuer != scriptPK {
+				return proto.DAppError, errors.Errorf("asset %s was not issued by this DApp", a.AssetID.String())
 			}
 			isSmart := ia.stor.scriptsStorage.newestIsSmartAsset(a.AssetID, !info.initialisation)
 			if isSmart {
-				return proto.DAppError, info.failedChanges, errors.Errorf("can not sponsor smart asset %s", a.AssetID.String())
+				return proto.DAppError, errors.Errorf("can not sponsor smart asset %s", a.AssetID.String())
 			}
 			ia.stor.sponsoredAssets.sponsorAssetUncertain(a.AssetID, uint64(a.MinFee))
+		case *proto.LeaseScriptAction:
+			if a.Recipient.Address == nil {
+				return proto.DAppError, errors.New("lease has unresolved aliases")
+			}
+			if _, err := ia.stor.leases.newestLeasingInfo(a.ID, !info.initialisation); err == nil {
+				return proto.DAppError, errors.Errorf("lease %s already exists", a.ID.String())
+			}
+			totalChanges.appendAddr(*a.Recipient.Address)
+			l := &leasing{
+				isActive:    true,
+				leaseAmount: uint64(a.Amount),
+				recipient:   *a.Recipient.Address,
+				sender:      *scriptAddr,
+			}
+			ia.stor.leases.addLeasingUncertain(a.ID, l)
+			txDiff, err := ia.newTxDiffFromScriptLease(scriptAddr, *a.Recipient.Address, a.Amount)
+			if err != nil {
+				return proto.DAppError, err
+			}
+			if err := ia.saveIntermediateDiff(txDiff); err != nil {
+				return proto.DAppError, err
+			}
+			for key, balanceDiff := range txDiff {
+				if err := totalChanges.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
+					return proto.DAppError, err
+				}
+			}
+		case *proto.LeaseCancelScriptAction:
+			l, err := ia.stor.leases.newestLeasingInfo(a.LeaseID, !info.initialisation)
+			if err != nil {
+				return proto.DAppError, errors.Errorf("lease %s not found", a.LeaseID.String())
+			}
+			if !l.isActive {
+				return proto.DAppError, errors.Errorf("lease %s is not active", a.LeaseID.String())
+			}
+			if l.sender != *scriptAddr {
+				return proto.DAppError, errors.Errorf("lease %s was created by other address", a.LeaseID.String())
+			}
+			totalChanges.appendAddr(l.recipient)
+			if err := ia.stor.leases.cancelLeasingUncertain(a.LeaseID, !info.initialisation); err != nil {
+				return proto.DAppError, err
+			}
+			txDiff, err := ia.newTxDiffFromScriptLease(scriptAddr, l.recipient, -int64(l.leaseAmount))
+			if err != nil {
+				return proto.DAppError, err
+			}
+			if err := ia.saveIntermediateDiff(txDiff); err != nil {
+				return proto.DAppError, err
+			}
+			for key, balanceDiff := range txDiff {
+				if err := totalChanges.diff.appendBalanceDiffStr(key, balanceDiff); err != nil {
+					return proto.DAppError, err
+				}
+			}
 		default:
-			return proto.DAppError, info.failedChanges, errors.Errorf("unsupported script action '%T'", a)
+			return proto.DAppError, errors.Errorf("unsupported script action '%T'", a)
 		}
 	}
-	if info.acceptFailed {
-		// Validate total balance changes.
-		if err := ia.diffApplier.validateTxDiff(totalChanges.diff, ia.invokeDiffStor.diffStorage, !info.initialisation); err != nil {
-			// Total balance changes lead to negative balance, hence invoke has failed.
-			// TODO: use different code for negative balances after it is introduced; use better error text here (addr + amount + asset).
-			return proto.DAppError, info.failedChanges, err
-		}
-	}
-	// If we are here, invoke succeeded.
-	ia.blockDiffer.appendBlockInfoToTxDiff(totalChanges.diff, info.block)
-	return 0, totalChanges, nil
+	return 0, nil
 }
 
 // For InvokeScript transactions there is no performer function.
@@ -521,8 +619,20 @@ func (ia *invokeApplier) applyInvokeScript(tx *proto.InvokeScriptWithProofs, inf
 		res := &invocationResult{failed: true, changes: failedChanges}
 		return ia.handleInvocationResult(tx, info, res)
 	}
+	// Starting from RIDE V5 the script can invoke other DApps.
+	var invoker *dAppInvoker
+	if script.Version >= 5 {
+		complexityRecord, err := ia.stor.scriptsComplexity.newestScriptComplexityByAddr(*scriptAddr, !info.initialisation)
+		if err != nil {
+			return nil, errors.Wrap(err, "newestScriptComplexityByAddr()")
+		}
+		invoker, err = newDAppInvoker(ia, tx, info, *scriptAddr, complexityRecord.byFuncs[callableName(tx.FunctionCall)])
+		if err != nil {
+			return nil, err
+		}
+	}
 	// Call script function.
-	ok, scriptActions, err := ia.sc.invokeFunction(script, tx, info.blockInfo, *scriptAddr, info.initialisation)
+	ok, scriptActions, err := ia.sc.invokeFunction(script, tx, info.blockInfo, *scriptAddr, info.initialisation, invoker)
 	if !ok {
 		// When ok is false, it means that we could not even start invocation.
 		// We just return error in such case.
@@ -533,10 +643,16 @@ func (ia *invokeApplier) applyInvokeScript(tx *proto.InvokeScriptWithProofs, inf
 			return nil, errors.Wrap(err, "invokeFunction() failed")
 		}
 		res := &invocationResult{failed: true, code: proto.DAppError, text: err.Error(), actions: scriptActions, changes: failedChanges}
+		if invoker != nil {
+			res.invocations = invoker.results()
+		}
 		return ia.handleInvocationResult(tx, info, res)
 	}
 	actionScriptRuns := ia.countActionScriptRuns(scriptActions, info.initialisation)
 	scriptRuns := uint64(len(paymentSmartAssets)) + actionScriptRuns
+	if invoker != nil {
+		scriptRuns += invoker.scriptRuns
+	}
 	var res invocationResult
 	code, changes, err := ia.fallibleValidation(tx, &addlInvokeInfo{
 		fallibleValidationParams: info,
@@ -547,6 +663,7 @@ func (ia *invokeApplier) applyInvokeScript(tx *proto.InvokeScriptWithProofs, inf
 		actions:                  scriptActions,
 		paymentSmartAssets:       paymentSmartAssets,
 		disableSelfTransfers:     disableSelfTransfers,
+		invoker:                  invoker,
 	})
 	if err != nil {
 		// If fallibleValidation fails, we should save transaction to blockchain when acceptFailed is true.
@@ -569,6 +686,9 @@ func (ia *invokeApplier) applyInvokeScript(tx *proto.InvokeScriptWithProofs, inf
 			changes:    changes,
 		}
 	}
+	if invoker != nil {
+		res.invocations = invoker.results()
+	}
 	return ia.handleInvocationResult(tx, info, &res)
 }
 
@@ -580,6 +700,8 @@ type invocationResult struct {
 	scriptRuns uint64
 	actions    []proto.ScriptAction
 	changes    txBalanceChanges
+	// results of DApps invoked by the script of RIDE V5
+	invocations []*proto.InvocationScriptResult
 }
 
 func toScriptResult(ir *invocationResult) (*proto.ScriptResult, error) {
@@ -587,7 +709,12 @@ func toScriptResult(ir *invocationResult) (*proto.ScriptResult, error) {
 	if ir.failed {
 		errorMsg = proto.ScriptErrorMessage{Code: ir.code, Text: ir.text}
 	}
-	return proto.NewScriptResult(ir.actions, errorMsg)
+	res, err := proto.NewScriptResult(ir.actions, errorMsg)
+	if err != nil {
+		return nil, err
+	}
+	res.Invocations = ir.invocations
+	return res, nil
 }
 
 func (ia *invokeApplier) handleInvocationResult(tx *proto.InvokeScriptWithProofs, info *fallibleValidationParams, res *invocationResult) (*applicationResult, error) {
@@ -13,6 +13,7 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/estimation"
 	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
 	"github.com/wavesplatform/gowaves/pkg/settings"
 )
@@ -70,7 +71,7 @@ func (to *invokeApplierTestObjects) setAndCheckInitialWavesBalance(t *testing.T,
 func (to *invokeApplierTestObjects) setScript(t *testing.T, addr proto.Address, pk crypto.PublicKey, script proto.Script) {
 	scriptAst, err := ast.BuildScript(reader.NewBytesReader(script))
 	assert.NoError(t, err)
-	estimator := estimatorByScript(scriptAst, 1)
+	estimator := estimation.NewEstimatorForScript(1, scriptAst)
 	complexity, err := estimator.Estimate(scriptAst)
 	assert.NoError(t, err)
 	r := &accountScriptComplexityRecord{
@@ -704,3 +705,93 @@ func TestFailedApplyInvokeScript(t *testing.T) {
 
 // TODO: add test on impossibility of sponsorship of smart asset using DApp: issue smart asset with simple script using
 // usual transaction and then try to set sponsorship using invoke.
+
+func TestCheckInvokeArguments(t *testing.T) {
+	to, path := createInvokeApplierTestObjects(t)
+
+	defer func() {
+		err := to.state.Close()
+		assert.NoError(t, err, "state.Close() failed")
+		err = os.RemoveAll(path)
+		assert.NoError(t, err, "failed to remove test data dir")
+	}()
+
+	to.setDApp(t, "dapp.base64", testGlobal.recipientInfo)
+
+	tests := []struct {
+		fc  proto.FunctionCall
+		err string
+	}{
+		{proto.FunctionCall{Name: "deposit"}, ""},
+		{proto.FunctionCall{Name: "withdraw", Arguments: proto.Arguments{&proto.IntegerArgument{Value: 1}}}, ""},
+		{proto.FunctionCall{Name: "transfer"}, "callable function 'transfer' not found"},
+		{proto.FunctionCall{Name: "withdraw"}, "invalid number of arguments of function 'withdraw', expected 1, got 0"},
+		{proto.FunctionCall{Name: "withdraw", Arguments: proto.Arguments{&proto.BooleanArgument{Value: true}}}, "invalid type of argument 'amount' of function 'withdraw', expected Int, got Boolean"},
+	}
+	for _, tc := range tests {
+		tx := createInvokeScriptWithProofs(t, nil, tc.fc, proto.OptionalAsset{}, invokeFee)
+		err := to.state.appender.checkInvokeArguments(tx)
+		if tc.err == "" {
+			assert.NoError(t, err)
+		} else {
+			assert.EqualError(t, err, "invalid invocation of DApp '"+testGlobal.recipientInfo.addr.String()+"': "+tc.err)
+		}
+	}
+}
+
+func TestApplyScriptLeaseActions(t *testing.T) {
+	to, path := createInvokeApplierTestObjects(t)
+
+	defer func() {
+		err := to.state.Close()
+		assert.NoError(t, err, "state.Close() failed")
+		err = os.RemoveAll(path)
+		assert.NoError(t, err, "failed to remove test data dir")
+	}()
+
+	info := to.fallibleValidationParams(t)
+	dApp := testGlobal.recipientInfo
+	recipient := testGlobal.senderInfo
+	tx := createInvokeScriptWithProofs(t, nil, proto.FunctionCall{Name: "lease"}, proto.OptionalAsset{}, invokeFee)
+	leaseID := crypto.MustFastHash([]byte("lease"))
+	dAppKey := string((&wavesBalanceKey{dApp.addr}).bytes())
+	recipientKey := string((&wavesBalanceKey{recipient.addr}).bytes())
+
+	// Lease.
+	actions := []proto.ScriptAction{&proto.LeaseScriptAction{ID: leaseID, Recipient: recipient.rcp, Amount: 100}}
+	changes := newTxBalanceChanges(nil, newTxDiff())
+	_, err := to.state.appender.ia.applyActions(tx, actions, &dApp.addr, dApp.pk, info, changes)
+	require.NoError(t, err)
+	l, err := to.state.stor.leases.newestLeasingInfo(leaseID, true)
+	require.NoError(t, err)
+	assert.Equal(t, &leasing{isActive: true, leaseAmount: 100, recipient: recipient.addr, sender: dApp.addr}, l)
+	assert.Equal(t, int64(100), changes.diff[dAppKey].leaseOut)
+	assert.Equal(t, int64(100), changes.diff[recipientKey].leaseIn)
+
+	// Lease with the same ID.
+	_, err = to.state.appender.ia.applyActions(tx, actions, &dApp.addr, dApp.pk, info, changes)
+	assert.Error(t, err)
+
+	// Cancel of lease created by other address.
+	actions = []proto.ScriptAction{&proto.LeaseCancelScriptAction{LeaseID: leaseID}}
+	_, err = to.state.appender.ia.applyActions(tx, actions, &recipient.addr, recipient.pk, info, changes)
+	assert.Error(t, err)
+
+	// Cancel.
+	_, err = to.state.appender.ia.applyActions(tx, actions, &dApp.addr, dApp.pk, info, changes)
+	require.NoError(t, err)
+	l, err = to.state.stor.leases.newestLeasingInfo(leaseID, true)
+	require.NoError(t, err)
+	assert.False(t, l.isActive)
+	assert.Equal(t, int64(0), changes.diff[dAppKey].leaseOut)
+	assert.Equal(t, int64(0), changes.diff[recipientKey].leaseIn)
+
+	// Cancel of inactive lease.
+	_, err = to.state.appender.ia.applyActions(tx, actions, &dApp.addr, dApp.pk, info, changes)
+	assert.Error(t, err)
+
+	// Uncertain leases are dropped if invocation fails.
+	to.state.stor.dropUncertain()
+	_, err = to.state.stor.leases.newestLeasingInfo(leaseID, true)
+	assert.Error(t, err)
+}
@@ -121,6 +121,9 @@ const (
 
 	// Hit source data
 	hitSourceKeyPrefix
+
+	// Checkpoints received from network.
+	checkpointKeyPrefix
 )
 
 var (
@@ -75,12 +75,20 @@ type leases struct {
 	db keyvalue.IterableKeyVal
 	hs *historyStorage
 
+	uncertainLeases map[crypto.Digest]*leasing
+
 	calculateHashes bool
 	hasher          *stateHasher
 }
 
 func newLeases(db keyvalue.IterableKeyVal, hs *historyStorage, calcHashes bool) *leases {
-	return &leases{db: db, hs: hs, calculateHashes: calcHashes, hasher: newStateHasher()}
+	return &leases{
+		db:              db,
+		hs:              hs,
+		uncertainLeases: make(map[crypto.Digest]*leasing),
+		calculateHashes: calcHashes,
+		hasher:          newStateHasher(),
+	}
 }
 
 func (l *leases) cancelLeases(bySenders map[proto.Address]struct{}, blockID proto.BlockID) error {
@@ -162,6 +170,10 @@ func (l *leases) validLeaseIns() (map[proto.Address]int64, error) {
 
 // Leasing info from DB or local storage.
 func (l *leases) newestLeasingInfo(id crypto.Digest, filter bool) (*leasing, error) {
+	if leasing, ok := l.uncertainLeases[id]; ok {
+		c := *leasing
+		return &c, nil
+	}
 	key := leaseKey{leaseID: id}
 	recordBytes, err := l.hs.freshLatestEntryData(key.bytes(), filter)
 	if err != nil {
@@ -233,6 +245,34 @@ func (l *leases) cancelLeasing(id crypto.Digest, blockID proto.BlockID, filter b
 	return l.addLeasing(id, leasing, blockID)
 }
 
+// addLeasingUncertain saves the lease created by script, it is committed to storage only if invocation succeeds.
+func (l *leases) addLeasingUncertain(id crypto.Digest, leasing *leasing) {
+	l.uncertainLeases[id] = leasing
+}
+
+func (l *leases) cancelLeasingUncertain(id crypto.Digest, filter bool) error {
+	leasing, err := l.newestLeasingInfo(id, filter)
+	if err != nil {
+		return errors.Errorf("failed to get leasing info: %v", err)
+	}
+	leasing.isActive = false
+	l.addLeasingUncertain(id, leasing)
+	return nil
+}
+
+func (l *leases) commitUncertain(blockID proto.BlockID) error {
+	for id, leasing := range l.uncertainLeases {
+		if err := l.addLeasing(id, leasing, blockID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (l *leases) dropUncertain() {
+	l.uncertainLeases = make(map[crypto.Digest]*leasing)
+}
+
 func (l *leases) prepareHashes() error {
 	return l.hasher.stop()
 }
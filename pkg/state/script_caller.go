@@ -180,10 +180,24 @@ func (a *scriptCaller) callAssetScript(
 	return a.callAssetScriptCommon(obj, assetID, lastBlockInfo, initialisation, acceptFailed)
 }
 
-func (a *scriptCaller) invokeFunction(script ast.Script, tx *proto.InvokeScriptWithProofs, lastBlockInfo *proto.BlockInfo, scriptAddress proto.Address, initialisation bool) (bool, []proto.ScriptAction, error) {
+func (a *scriptCaller) invokeFunction(script ast.Script, tx *proto.InvokeScriptWithProofs, lastBlockInfo *proto.BlockInfo, scriptAddress proto.Address, initialisation bool, invoker *dAppInvoker) (bool, []proto.ScriptAction, error) {
 	this := ast.NewAddressFromProtoAddress(scriptAddress)
 	lastBlock := ast.NewObjectFromBlockInfo(*lastBlockInfo)
-	ok, actions, err := script.CallFunction(a.settings.AddressSchemeCharacter, a.state, tx, this, lastBlock)
+	var (
+		ok      bool
+		actions []proto.ScriptAction
+		err     error
+	)
+	if invoker != nil {
+		var invocation *ast.Invocation
+		invocation, err = ast.NewInvocationFromTransaction(a.settings.AddressSchemeCharacter, tx)
+		if err != nil {
+			return false, nil, errors.Wrapf(err, "transaction ID %s", tx.ID.String())
+		}
+		ok, actions, _, err = script.InvokeFunction(a.settings.AddressSchemeCharacter, a.state, tx, invocation, this, lastBlock, invoker)
+	} else {
+		ok, actions, err = script.CallFunction(a.settings.AddressSchemeCharacter, a.state, tx, this, lastBlock)
+	}
 	if err != nil {
 		return ok, nil, errors.Wrapf(err, "transaction ID %s", tx.ID.String())
 	}
@@ -8,7 +8,7 @@ import (
 
 type element struct {
 	key        string
-	value      ast.Script
+	value      ast.Script // Script AST together with its compiled program
 	prev, next *element
 	bytes      uint64
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/wavesplatform/gowaves/pkg/proto"
 	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/ast"
 	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
+	"go.uber.org/zap"
 )
 
 const (
@@ -23,6 +24,11 @@ func scriptBytesToAst(script proto.Script) (ast.Script, error) {
 	if err != nil {
 		return ast.Script{}, err
 	}
+	// Compiled program is stored within the script, so it's cached together with AST and shared by all evaluations.
+	if err := scriptAst.Compile(); err != nil {
+		// Script that failed to compile is evaluated by walking its AST.
+		zap.S().Warnf("Failed to compile script, it is evaluated by walking AST: %v", err)
+	}
 	return *scriptAst, nil
 }
 
@@ -1,10 +1,14 @@
 package state
 
 import (
+	"io/ioutil"
+	"path/filepath"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/wavesplatform/gowaves/pkg/proto"
+	"github.com/wavesplatform/gowaves/pkg/ride/evaluator/reader"
 	"github.com/wavesplatform/gowaves/pkg/util/common"
 )
 
@@ -245,3 +249,20 @@ func TestSetAssetScript(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, testGlobal.scriptAst, scriptAst)
 }
+
+func TestScriptBytesToAstCompiles(t *testing.T) {
+	dir, err := getLocalDir()
+	require.NoError(t, err, "getLocalDir() failed")
+	files, err := filepath.Glob(filepath.Join(dir, "testdata", "scripts", "*.base64"))
+	require.NoError(t, err)
+	require.NotEmpty(t, files)
+	for _, file := range files {
+		scriptBase64, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		scriptBytes, err := reader.ScriptBytesFromBase64(scriptBase64)
+		require.NoError(t, err)
+		script, err := scriptBytesToAst(scriptBytes)
+		require.NoError(t, err, filepath.Base(file))
+		assert.True(t, script.Compiled(), "script %s is not compiled", filepath.Base(file))
+	}
+}
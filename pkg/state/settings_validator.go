@@ -10,5 +10,10 @@ func validateSettings(s *settings.BlockchainSettings) error {
 	if s.BlockRewardTerm == 0 {
 		return errors.New("invalid value `0` for settings.BlockRewardTerm, suggest value `100000`")
 	}
+	for _, cp := range s.Checkpoints {
+		if cp.Height == 0 {
+			return errors.New("invalid value `0` for height of checkpoint in settings.Checkpoints")
+		}
+	}
 	return nil
 }
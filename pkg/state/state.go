@@ -233,6 +233,9 @@ func (s *blockchainEntitiesStorage) commitUncertain(blockID proto.BlockID) error
 	if err := s.sponsoredAssets.commitUncertain(blockID); err != nil {
 		return err
 	}
+	if err := s.leases.commitUncertain(blockID); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -241,6 +244,7 @@ func (s *blockchainEntitiesStorage) dropUncertain() {
 	s.accountsDataStor.dropUncertain()
 	s.scriptsStorage.dropUncertain()
 	s.sponsoredAssets.dropUncertain()
+	s.leases.dropUncertain()
 }
 
 func (s *blockchainEntitiesStorage) reset() {
@@ -389,6 +393,8 @@ type stateManager struct {
 	stor  *blockchainEntitiesStorage
 	rw    *blockReadWriter
 	peers *peerStorage
+	// Blocks that can't be replaced by forks.
+	checkpoints *checkpoints
 
 	// BlockchainSettings: general info about the blockchain type, constants etc.
 	settings *settings.BlockchainSettings
@@ -487,8 +493,13 @@ func newStateManager(dataDir string, params StateParams, settings *settings.Bloc
 	if err != nil {
 		return nil, wrapErr(Other, errors.Errorf("failed to create address transactions storage: %v", err))
 	}
+	checkpoints, err := newCheckpoints(db, settings.Checkpoints)
+	if err != nil {
+		return nil, wrapErr(Other, errors.Errorf("failed to load checkpoints: %v", err))
+	}
 	state := &stateManager{
 		mu:                        &sync.RWMutex{},
+		checkpoints:               checkpoints,
 		stateDB:                   stateDB,
 		stor:                      stor,
 		rw:                        rw,
@@ -1418,6 +1429,9 @@ func (s *stateManager) addBlocks(initialisation bool) (*proto.Block, error) {
 		curBlockHeight = curHeight + 1
 		nextHeight := curHeight + 1
 		breakerInfo.blockID = block.BlockID()
+		if err := s.checkpoints.check(block.BlockID(), curBlockHeight); err != nil {
+			return nil, wrapErr(ValidationError, err)
+		}
 		// Send block for signature verification, which works in separate goroutine.
 		task := &verifyTask{
 			taskType: verifyBlock,
@@ -1532,6 +1546,9 @@ func (s *stateManager) checkRollbackHeight(height uint64) error {
 	if height < minRollbackHeight || height > maxHeight {
 		return errors.Errorf("invalid height; valid range is: [%d, %d]", minRollbackHeight, maxHeight)
 	}
+	if cp, ok := s.checkpoints.highest(maxHeight); ok && height < cp {
+		return errors.Errorf("invalid height; can't rollback below checkpoint at height %d", cp)
+	}
 	return nil
 }
 
@@ -1614,6 +1631,45 @@ func (s *stateManager) RollbackTo(removalEdge proto.BlockID) error {
 	return nil
 }
 
+func (s *stateManager) Checkpoints() []settings.Checkpoint {
+	return s.checkpoints.list()
+}
+
+func (s *stateManager) AddCheckpoints(checkpoints []settings.Checkpoint) error {
+	// All checkpoints are checked before rollback, so conflicting ones don't roll back anything.
+	if err := s.checkpoints.validate(checkpoints); err != nil {
+		return wrapErr(InvalidInputError, err)
+	}
+	height, err := s.Height()
+	if err != nil {
+		return wrapErr(RetrievalError, err)
+	}
+	// Blocks conflicting with new checkpoints are rolled back.
+	rollbackHeight := height
+	for _, cp := range checkpoints {
+		if cp.Height > height {
+			continue
+		}
+		id, err := s.HeightToBlockID(cp.Height)
+		if err != nil {
+			return wrapErr(RetrievalError, err)
+		}
+		if id != cp.BlockID && cp.Height-1 < rollbackHeight {
+			rollbackHeight = cp.Height - 1
+		}
+	}
+	if rollbackHeight < height {
+		zap.S().Infof("Rolling back to height %d because of conflicting checkpoint", rollbackHeight)
+		if err := s.RollbackToHeight(rollbackHeight); err != nil {
+			return err
+		}
+	}
+	if err := s.checkpoints.add(checkpoints); err != nil {
+		return wrapErr(InvalidInputError, err)
+	}
+	return nil
+}
+
 func (s *stateManager) ScoreAtHeight(height uint64) (*big.Int, error) {
 	maxHeight, err := s.Height()
 	if err != nil {
@@ -1715,6 +1771,10 @@ func (s *stateManager) ResetValidationList() {
 	s.appender.resetValidationList()
 }
 
+func (s *stateManager) ScriptsUsage() (uint64, uint64) {
+	return s.appender.totalScriptsRuns, s.appender.sc.getTotalComplexity()
+}
+
 // For UTX validation.
 func (s *stateManager) ValidateNextTx(tx proto.Transaction, currentTimestamp, parentTimestamp uint64, v proto.BlockVersion, checkScripts bool) error {
 	if err := s.appender.validateNextTx(tx, currentTimestamp, parentTimestamp, v, checkScripts); err != nil {
@@ -1925,6 +1985,18 @@ func (s *stateManager) RetrieveNewestBinaryEntry(account proto.Recipient, key st
 	return entry, nil
 }
 
+func (s *stateManager) IsStateUntouched(account proto.Recipient) (bool, error) {
+	addr, err := s.newestRecipientToAddress(account)
+	if err != nil {
+		return false, wrapErr(RetrievalError, err)
+	}
+	r, err := s.stor.accountsDataStor.newestIsUntouched(*addr, true)
+	if err != nil {
+		return false, wrapErr(RetrievalError, err)
+	}
+	return r, nil
+}
+
 func (s *stateManager) RetrieveBinaryEntry(account proto.Recipient, key string) (*proto.BinaryDataEntry, error) {
 	addr, err := s.recipientToAddress(account)
 	if err != nil {
@@ -2008,6 +2080,14 @@ func (s *stateManager) AssetIsSponsored(assetID crypto.Digest) (bool, error) {
 	return sponsored, nil
 }
 
+func (s *stateManager) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	amount, err := s.stor.sponsoredAssets.sponsoredAssetToWaves(assetID, assetAmount)
+	if err != nil {
+		return 0, wrapErr(RetrievalError, err)
+	}
+	return amount, nil
+}
+
 func (s *stateManager) NewestAssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error) {
 	info, err := s.stor.assets.newestAssetInfo(assetID, true)
 	if err != nil {
@@ -11,6 +11,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"github.com/wavesplatform/gowaves/pkg/crypto"
 	"github.com/wavesplatform/gowaves/pkg/importer"
 	"github.com/wavesplatform/gowaves/pkg/keyvalue"
 	"github.com/wavesplatform/gowaves/pkg/proto"
@@ -112,6 +113,8 @@ func TestValidationWithoutBlocks(t *testing.T) {
 	invalidTx.Amount = 19999999500000000
 	err = manager.ValidateNextTx(invalidTx, defaultTimestamp, defaultTimestamp, 3, true)
 	assert.Error(t, err, "ValidateNextTx did not fail with invalid tx")
+	// Amount was changed after signing, so the signature is invalid.
+	assert.True(t, IsTxDataError(err))
 	// Now set some balance for sender.
 	validTx := createPayment(t)
 	err = manager.stateDB.addBlock(blockID0)
@@ -382,6 +385,8 @@ func TestDisallowDuplicateTxIds(t *testing.T) {
 	err = manager.ValidateNextTx(tx, 1460678400000, 1460678400000, 3, true)
 	assert.Error(t, err, "duplicate transacton ID was accepted by state")
 	assert.EqualError(t, err, expectedErrStr)
+	assert.True(t, IsValidationError(err))
+	assert.False(t, IsTxDataError(err), "duplicate is not the fault of transaction itself")
 }
 
 func TestTransactionByID(t *testing.T) {
@@ -527,3 +532,46 @@ func TestStateHashAtHeight(t *testing.T) {
 	assert.NoError(t, err, "failed to unmarshal correct hash JSON")
 	assert.Equal(t, correctHash, *stateHash)
 }
+
+func TestStateManager_Checkpoints(t *testing.T) {
+	blocksPath, err := blocksPath()
+	require.NoError(t, err)
+	dataDir, err := ioutil.TempDir(os.TempDir(), "dataDir")
+	require.NoError(t, err)
+	defer os.RemoveAll(dataDir)
+	manager, err := newStateManager(dataDir, DefaultTestingStateParams(), settings.MainNetSettings)
+	require.NoError(t, err)
+	defer manager.Close()
+
+	err = importer.ApplyFromFile(manager, blocksPath, 99, 1, false)
+	require.NoError(t, err)
+
+	id50, err := manager.HeightToBlockID(50)
+	require.NoError(t, err)
+	require.NoError(t, manager.AddCheckpoints([]settings.Checkpoint{{Height: 50, BlockID: id50}}))
+	require.Error(t, manager.RollbackToHeight(40), "rollback below checkpoint")
+	require.NoError(t, manager.RollbackToHeight(60))
+
+	// conflicting checkpoint rolls back the blocks
+	wrong := proto.NewBlockIDFromSignature(crypto.Signature{1})
+	require.NoError(t, manager.AddCheckpoints([]settings.Checkpoint{{Height: 55, BlockID: wrong}}))
+	height, err := manager.Height()
+	require.NoError(t, err)
+	require.EqualValues(t, 54, height)
+	err = importer.ApplyFromFile(manager, blocksPath, 54, 54, false)
+	require.Error(t, err, "block conflicting with checkpoint")
+
+	require.Error(t, manager.AddCheckpoints([]settings.Checkpoint{{Height: 50, BlockID: wrong}}))
+	// nothing is rolled back if any of checkpoints is rejected
+	require.Error(t, manager.AddCheckpoints([]settings.Checkpoint{{Height: 52, BlockID: wrong}, {Height: 50, BlockID: wrong}}))
+	height, err = manager.Height()
+	require.NoError(t, err)
+	require.EqualValues(t, 54, height)
+	expected := []settings.Checkpoint{{Height: 50, BlockID: id50}, {Height: 55, BlockID: wrong}}
+	require.Equal(t, expected, manager.Checkpoints())
+
+	require.NoError(t, manager.Close())
+	manager, err = newStateManager(dataDir, DefaultTestingStateParams(), settings.MainNetSettings)
+	require.NoError(t, err)
+	require.Equal(t, expected, manager.Checkpoints())
+}
@@ -121,6 +121,12 @@ func (a *ThreadSafeReadWrapper) BlockchainSettings() (*settings.BlockchainSettin
 	return a.s.BlockchainSettings()
 }
 
+func (a *ThreadSafeReadWrapper) Checkpoints() []settings.Checkpoint {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.s.Checkpoints()
+}
+
 func (a *ThreadSafeReadWrapper) Peers() ([]proto.TCPAddr, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
@@ -253,6 +259,12 @@ func (a *ThreadSafeReadWrapper) AssetIsSponsored(assetID crypto.Digest) (bool, e
 	return a.s.AssetIsSponsored(assetID)
 }
 
+func (a *ThreadSafeReadWrapper) SponsoredAssetToWaves(assetID crypto.Digest, assetAmount uint64) (uint64, error) {
+	a.mu.RLock()
+	defer a.mu.RUnlock()
+	return a.s.SponsoredAssetToWaves(assetID, assetAmount)
+}
+
 func (a *ThreadSafeReadWrapper) AssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error) {
 	a.mu.RLock()
 	defer a.mu.RUnlock()
@@ -346,6 +358,10 @@ func (a *ThreadSafeWriteWrapper) ResetValidationList() {
 	panic("invalid ResetValidationList usage")
 }
 
+func (a *ThreadSafeWriteWrapper) ScriptsUsage() (uint64, uint64) {
+	panic("invalid ScriptsUsage usage")
+}
+
 func (a *ThreadSafeWriteWrapper) AddBlock(block []byte) (*proto.Block, error) {
 	a.lock()
 	defer a.unlock()
@@ -401,6 +417,12 @@ func (a *ThreadSafeWriteWrapper) TxValidation(f func(validation TxValidation) er
 	return f(a.s)
 }
 
+func (a *ThreadSafeWriteWrapper) AddCheckpoints(checkpoints []settings.Checkpoint) error {
+	a.lock()
+	defer a.unlock()
+	return a.s.AddCheckpoints(checkpoints)
+}
+
 func (a *ThreadSafeWriteWrapper) SavePeers(peers []proto.TCPAddr) error {
 	a.lock()
 	defer a.unlock()
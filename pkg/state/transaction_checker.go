@@ -67,45 +67,32 @@ func (tc *transactionChecker) scriptActivation(script *ast.Script) error {
 	if script.Version == 4 && !multiPaymentsActivated {
 		return errors.New("MultiPaymentInvokeScript feature must be activated for scripts version 4")
 	}
+	synchronousCallsActivated, err := tc.stor.features.isActivated(int16(settings.SynchronousCalls))
+	if err != nil {
+		return err
+	}
+	if script.Version == 5 && !synchronousCallsActivated {
+		return errors.New("SynchronousCalls feature must be activated for scripts version 5")
+	}
 	return nil
 }
 
-func (tc *transactionChecker) checkScriptComplexity(script *ast.Script, complexity estimation.Costs) error {
-	var maxComplexity uint64
-	switch script.Version {
-	case 1, 2:
-		maxComplexity = 2000
-	case 3, 4:
-		maxComplexity = 4000
-	}
+func (tc *transactionChecker) checkScriptComplexity(script *ast.Script, complexity estimation.Costs, estimatorVersion int) error {
+	maxComplexity := estimation.MaxComplexity(script.Version)
 	complexityVal := complexity.Verifier
 	if script.IsDapp() {
 		complexityVal = complexity.DApp
 	}
 	if complexityVal > maxComplexity {
-		// TODO: fix estimator and enable this check later.
+		if estimatorVersion >= 3 {
+			return errors.Errorf("script complexity %d exceeds maximum allowed complexity of %d", complexityVal, maxComplexity)
+		}
+		// Previous versions of estimator are not precise, so complexity is only reported for them.
 		zap.S().Warnf("ERROR: script complexity %d exceeds maximum allowed complexity of %d", complexityVal, maxComplexity)
 	}
 	return nil
 }
 
-func estimatorByScript(script *ast.Script, version int) *estimation.Estimator {
-	var variables map[string]ast.Expr
-	var cat *estimation.Catalogue
-	switch script.Version {
-	case 1, 2:
-		variables = ast.VariablesV2()
-		cat = estimation.NewCatalogueV2()
-	case 3:
-		variables = ast.VariablesV3()
-		cat = estimation.NewCatalogueV3()
-	case 4:
-		variables = ast.VariablesV4()
-		cat = estimation.NewCatalogueV4()
-	}
-	return estimation.NewEstimator(version, cat, variables)
-}
-
 type scriptInfo struct {
 	complexity       estimation.Costs
 	estimatorVersion byte
@@ -127,12 +114,12 @@ func (tc *transactionChecker) checkScript(scriptBytes proto.Script, estimatorVer
 	if err := tc.scriptActivation(script); err != nil {
 		return nil, errors.Wrap(err, "script activation check failed")
 	}
-	estimator := estimatorByScript(script, estimatorVersion)
+	estimator := estimation.NewEstimatorForScript(estimatorVersion, script)
 	complexity, err := estimator.Estimate(script)
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to estimate script complexity")
 	}
-	if err := tc.checkScriptComplexity(script, complexity); err != nil {
+	if err := tc.checkScriptComplexity(script, complexity, estimator.Version); err != nil {
 		return nil, errors.Errorf("checkScriptComplexity(): %v", err)
 	}
 	return &scriptInfo{complexity, byte(estimator.Version), script.IsDapp()}, nil
@@ -370,12 +357,20 @@ func (tc *transactionChecker) checkIssueWithSig(transaction proto.Transaction, i
 	return nil, nil
 }
 
-func (tc *transactionChecker) estimatorVersion(info *checkerInfo) int {
+func (tc *transactionChecker) estimatorVersion(info *checkerInfo) (int, error) {
+	// Estimator V3 is used since activation of BlockV5 feature.
+	blockV5Activated, err := tc.stor.features.isActivated(int16(settings.BlockV5))
+	if err != nil {
+		return 0, err
+	}
+	if blockV5Activated {
+		return 3, nil
+	}
 	switch info.blockVersion {
 	case 4:
-		return 2
+		return 2, nil
 	default:
-		return 1
+		return 1, nil
 	}
 }
 
@@ -395,7 +390,11 @@ func (tc *transactionChecker) checkIssueWithProofs(transaction proto.Transaction
 		// No script checks / actions are needed.
 		return nil, nil
 	}
-	scriptInf, err := tc.checkScript(tx.Script, tc.estimatorVersion(info))
+	estimatorVersion, err := tc.estimatorVersion(info)
+	if err != nil {
+		return nil, err
+	}
+	scriptInf, err := tc.checkScript(tx.Script, estimatorVersion)
 	if err != nil {
 		return nil, errors.Errorf("checkScript() tx %s: %v", tx.ID.String(), err)
 	}
@@ -1006,7 +1005,11 @@ func (tc *transactionChecker) checkSetScriptWithProofs(transaction proto.Transac
 		// No script checks / actions are needed.
 		return nil, nil
 	}
-	scriptInf, err := tc.checkScript(tx.Script, tc.estimatorVersion(info))
+	estimatorVersion, err := tc.estimatorVersion(info)
+	if err != nil {
+		return nil, err
+	}
+	scriptInf, err := tc.checkScript(tx.Script, estimatorVersion)
 	if err != nil {
 		return nil, errors.Errorf("checkScript() tx %s: %v", tx.ID.String(), err)
 	}
@@ -1046,7 +1049,11 @@ func (tc *transactionChecker) checkSetAssetScriptWithProofs(transaction proto.Tr
 		// No script checks / actions are needed.
 		return nil, nil
 	}
-	scriptInf, err := tc.checkScript(tx.Script, tc.estimatorVersion(info))
+	estimatorVersion, err := tc.estimatorVersion(info)
+	if err != nil {
+		return nil, err
+	}
+	scriptInf, err := tc.checkScript(tx.Script, estimatorVersion)
 	if err != nil {
 		return nil, errors.Errorf("checkScript() tx %s: %v", tx.ID.String(), err)
 	}
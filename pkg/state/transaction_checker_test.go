@@ -960,6 +960,30 @@ func TestCheckSponsorshipWithProofs(t *testing.T) {
 	assert.Error(t, err, "checkSponsorshipWithProofs did not fail with invalid timestamp")
 }
 
+func TestEstimatorVersion(t *testing.T) {
+	to, path := createCheckerTestObjects(t)
+
+	defer func() {
+		to.stor.close(t)
+
+		err := common.CleanTemporaryDirs(path)
+		assert.NoError(t, err, "failed to clean test data dirs")
+	}()
+
+	info := defaultCheckerInfo(t)
+	v, err := to.tc.estimatorVersion(info)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, v)
+	info.blockVersion = proto.RewardBlockVersion
+	v, err = to.tc.estimatorVersion(info)
+	assert.NoError(t, err)
+	assert.Equal(t, 2, v)
+	to.stor.activateFeature(t, int16(settings.BlockV5))
+	v, err = to.tc.estimatorVersion(info)
+	assert.NoError(t, err)
+	assert.Equal(t, 3, v)
+}
+
 func TestCheckSetScriptWithProofs(t *testing.T) {
 	to, path := createCheckerTestObjects(t)
 
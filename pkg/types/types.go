@@ -21,6 +21,8 @@ type Handler interface {
 type UtxPool interface {
 	Add(t proto.Transaction) error
 	AddWithBytes(t proto.Transaction, b []byte) error
+	// Return puts back the transaction taken from the pool by Pop, keeping the time it was received.
+	Return(t *TransactionWithBytes) error
 	Exists(t proto.Transaction) bool
 	Pop() *TransactionWithBytes
 	AllTransactions() []*TransactionWithBytes
@@ -31,6 +33,13 @@ type UtxPool interface {
 type TransactionWithBytes struct {
 	T proto.Transaction
 	B []byte
+	// Time the transaction was added to the pool
+	Received time.Time
+}
+
+// PackingStrategy defines the order in which miner tries to pack transactions taken from UTX into block.
+type PackingStrategy interface {
+	Order(transactions []*TransactionWithBytes) []*TransactionWithBytes
 }
 
 // state for smart contracts
@@ -48,6 +57,8 @@ type SmartState interface {
 	RetrieveNewestBooleanEntry(account proto.Recipient, key string) (*proto.BooleanDataEntry, error)
 	RetrieveNewestStringEntry(account proto.Recipient, key string) (*proto.StringDataEntry, error)
 	RetrieveNewestBinaryEntry(account proto.Recipient, key string) (*proto.BinaryDataEntry, error)
+	// IsStateUntouched returns true if no data entries were ever written to the account.
+	IsStateUntouched(account proto.Recipient) (bool, error)
 	NewestAssetIsSponsored(assetID crypto.Digest) (bool, error)
 	NewestAssetInfo(assetID crypto.Digest) (*proto.AssetInfo, error)
 	NewestFullAssetInfo(assetID crypto.Digest) (*proto.FullAssetInfo, error)
@@ -84,7 +95,7 @@ type InvRequester interface {
 type BaseTarget = uint64
 
 type Miner interface {
-	MineKeyBlock(ctx context.Context, t proto.Timestamp, k proto.KeyPair, parent proto.BlockID, baseTarget BaseTarget, gs []byte, vrf []byte) (*proto.Block, proto.MiningLimits, error)
+	MineKeyBlock(ctx context.Context, t proto.Timestamp, pk crypto.PublicKey, parent proto.BlockID, baseTarget BaseTarget, gs []byte, vrf []byte) (*proto.Block, proto.MiningLimits, error)
 }
 
 type Time interface {
@@ -105,3 +116,17 @@ type EmbeddedWallet interface {
 	Load(password []byte) error
 	Seeds() [][]byte
 }
+
+// MinerVotes are the desired block reward and the features miner votes for.
+type MinerVotes struct {
+	Reward   int64   `json:"reward"`
+	Features []int16 `json:"features"`
+}
+
+type MinerVoting interface {
+	Votes() MinerVotes
+	SetReward(reward int64) error
+	SetFeatures(features []int16) error
+	// SetVotes changes both votes at once if they are valid, nil vote is left unchanged.
+	SetVotes(features *[]int16, reward *int64) error
+}